@@ -1,8 +1,11 @@
 package instana_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
@@ -56,6 +59,56 @@ func createMockHttpServerForResource(resourcePath string, responseTemplate strin
 	return httpServer
 }
 
+//createInMemoryMockHttpServerForResource creates a mock server for resources which are created via POST on the resource
+//path and updated via PUT on the resource path with the ID. The objects are stored in memory by the value of the given ID field
+func createInMemoryMockHttpServerForResource(resourcePath string, idFieldName string) testutils.TestHTTPServer {
+	var lock sync.Mutex
+	objects := make(map[string][]byte)
+	pathTemplate := resourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	store := func(w http.ResponseWriter, r *http.Request, expectedID *string) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		object := make(map[string]interface{})
+		if err := json.Unmarshal(body, &object); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id, ok := object[idFieldName].(string)
+		if !ok || len(id) == 0 || (expectedID != nil && *expectedID != id) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		objects[id] = body
+		httpServer.WriteJSONResponse(w, body)
+	}
+	httpServer.AddRoute(http.MethodPost, resourcePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, nil)
+	})
+	httpServer.AddRoute(http.MethodPut, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		store(w, r, &id)
+	})
+	httpServer.AddRoute(http.MethodGet, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		object, ok := objects[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, object)
+	})
+	httpServer.AddRoute(http.MethodDelete, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(objects, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
 func formatResponseTemplate(template string, id string, iteration int, vars ...interface{}) string {
 	allVars := make([]interface{}, len(vars)+2)
 	allVars[0] = id
@@ -1,9 +1,11 @@
 package instana
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -37,7 +39,7 @@ type builtInEventDataSource struct{}
 //CreateResource creates the terraform Resource for the data source for Instana builtin events
 func (ds *builtInEventDataSource) CreateResource() *schema.Resource {
 	return &schema.Resource{
-		Read: ds.read,
+		ReadContext: ds.read,
 		Schema: map[string]*schema.Schema{
 			BuiltinEventSpecificationFieldName: {
 				Type:        schema.TypeString,
@@ -78,25 +80,25 @@ func (ds *builtInEventDataSource) CreateResource() *schema.Resource {
 	}
 }
 
-func (ds *builtInEventDataSource) read(d *schema.ResourceData, meta interface{}) error {
+func (ds *builtInEventDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	name := d.Get(BuiltinEventSpecificationFieldName).(string)
 	shortPluginID := d.Get(BuiltinEventSpecificationFieldShortPluginID).(string)
 
-	data, err := instanaAPI.BuiltinEventSpecifications().GetAll()
+	data, err := instanaAPI.BuiltinEventSpecifications().GetAll(ctx)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	builtInEvent, err := ds.findBuiltInEventByNameAndPluginID(name, shortPluginID, data)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return ds.updateState(d, builtInEvent)
+	return diag.FromErr(ds.updateState(d, builtInEvent))
 }
 
 func (ds *builtInEventDataSource) findBuiltInEventByNameAndPluginID(name string, shortPluginID string, data *[]restapi.InstanaDataObject) (*restapi.BuiltinEventSpecification, error) {
@@ -1,6 +1,7 @@
 package instana_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -121,16 +122,16 @@ func executeReadWithTenGeneratedObjectsAsResponse(requestedName string, requeste
 
 	response := createBuiltinEventSpecifications(10)
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource(ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(response, nil)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(response, nil)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
 	meta := &ProviderMeta{InstanaAPI: mockInstanaAPI}
 	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{BuiltinEventSpecificationFieldName: requestedName, BuiltinEventSpecificationFieldShortPluginID: requestedPluginId})
 
-	err := sut.Read(resourceData, meta)
-	if err != nil {
-		return nil, err
+	diags := sut.ReadContext(context.Background(), resourceData, meta)
+	if diags.HasError() {
+		return nil, errors.New(diags[0].Summary)
 	}
 	return resourceData, nil
 }
@@ -146,17 +147,17 @@ func TestShouldFailtToReadBuiltInEventWhenAPIRequestFails(t *testing.T) {
 	requestedPluginId := "plugin-id-1"
 
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource(ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(nil, expectedError)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(nil, expectedError)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
 	meta := &ProviderMeta{InstanaAPI: mockInstanaAPI}
 	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{BuiltinEventSpecificationFieldName: requestedName, BuiltinEventSpecificationFieldShortPluginID: requestedPluginId})
 
-	err := sut.Read(resourceData, meta)
+	diags := sut.ReadContext(context.Background(), resourceData, meta)
 
-	require.Error(t, err)
-	require.Equal(t, expectedError, err)
+	require.True(t, diags.HasError())
+	require.Equal(t, expectedError.Error(), diags[0].Summary)
 }
 
 func TestShouldFailtToReadBuiltInEventWhenSeverityCannotBeConvertedFromItsCodeRepresentation(t *testing.T) {
@@ -172,17 +173,17 @@ func TestShouldFailtToReadBuiltInEventWhenSeverityCannotBeConvertedFromItsCodeRe
 	builtinEvent.Severity = 100
 	response := []restapi.InstanaDataObject{builtinEvent}
 	builtInEventSpecificationAPI := mocks.NewMockReadOnlyRestResource(ctrl)
-	builtInEventSpecificationAPI.EXPECT().GetAll().Times(1).Return(&response, nil)
+	builtInEventSpecificationAPI.EXPECT().GetAll(gomock.Any()).Times(1).Return(&response, nil)
 	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
 	mockInstanaAPI.EXPECT().BuiltinEventSpecifications().Times(1).Return(builtInEventSpecificationAPI)
 
 	meta := &ProviderMeta{InstanaAPI: mockInstanaAPI}
 	resourceData := schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{BuiltinEventSpecificationFieldName: requestedName, BuiltinEventSpecificationFieldShortPluginID: requestedPluginId})
 
-	err := sut.Read(resourceData, meta)
+	diags := sut.ReadContext(context.Background(), resourceData, meta)
 
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "100 is not a valid severity")
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "100 is not a valid severity")
 }
 
 func createBuiltinEventSpecifications(count int) *[]restapi.InstanaDataObject {
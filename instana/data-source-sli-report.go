@@ -0,0 +1,142 @@
+package instana
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//NewSliReportDataSource creates a new DataSource for SLI reports
+func NewSliReportDataSource() DataSource {
+	return &sliReportDataSource{}
+}
+
+const (
+	//SliReportFieldSliID constant value for the schema field sli_id
+	SliReportFieldSliID = "sli_id"
+	//SliReportFieldSlo constant value for the schema field slo
+	SliReportFieldSlo = "slo"
+	//SliReportFieldFrom constant value for the schema field from
+	SliReportFieldFrom = "from"
+	//SliReportFieldTo constant value for the schema field to
+	SliReportFieldTo = "to"
+	//SliReportFieldSli constant value for the schema field sli
+	SliReportFieldSli = "sli"
+	//SliReportFieldTotalErrorBudget constant value for the schema field total_error_budget
+	SliReportFieldTotalErrorBudget = "total_error_budget"
+	//SliReportFieldErrorBudgetRemaining constant value for the schema field error_budget_remaining
+	SliReportFieldErrorBudgetRemaining = "error_budget_remaining"
+	//SliReportFieldFromTimestamp constant value for the schema field from_timestamp
+	SliReportFieldFromTimestamp = "from_timestamp"
+	//SliReportFieldToTimestamp constant value for the schema field to_timestamp
+	SliReportFieldToTimestamp = "to_timestamp"
+	//SliReportFieldViolationDistribution constant value for the schema field violation_distribution
+	SliReportFieldViolationDistribution = "violation_distribution"
+
+	//DataSourceSliReport the name of the terraform-provider-instana data source for SLI reports
+	DataSourceSliReport = "instana_sli_report"
+)
+
+type sliReportDataSource struct{}
+
+//CreateResource creates the terraform Resource for the data source for Instana SLI reports
+func (ds *sliReportDataSource) CreateResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ds.read,
+		Schema: map[string]*schema.Schema{
+			SliReportFieldSliID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "The ID of the SLI configuration the report is requested for",
+			},
+			SliReportFieldSlo: {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0.000001, 0.999999),
+				Description:  "The service level objective (error budget target) as value between 0 and 1, e.g. 0.99 for 99 %",
+			},
+			SliReportFieldFrom: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The start of the time window of the report as unix timestamp in milliseconds",
+			},
+			SliReportFieldTo: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The end of the time window of the report as unix timestamp in milliseconds",
+			},
+			SliReportFieldSli: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The SLI value reached within the time window",
+			},
+			SliReportFieldTotalErrorBudget: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total error budget of the time window",
+			},
+			SliReportFieldErrorBudgetRemaining: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The remaining error budget of the time window",
+			},
+			SliReportFieldFromTimestamp: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The start of the time window as reported by Instana",
+			},
+			SliReportFieldToTimestamp: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The end of the time window as reported by Instana",
+			},
+			SliReportFieldViolationDistribution: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The distribution of violations within the time window",
+			},
+		},
+	}
+}
+
+func (ds *sliReportDataSource) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerMeta := meta.(*ProviderMeta)
+	instanaAPI := providerMeta.InstanaAPI
+
+	query := restapi.SliReportQuery{
+		SliID: d.Get(SliReportFieldSliID).(string),
+		Slo:   d.Get(SliReportFieldSlo).(float64),
+		From:  int64(d.Get(SliReportFieldFrom).(int)),
+		To:    int64(d.Get(SliReportFieldTo).(int)),
+	}
+
+	report, err := instanaAPI.SliReport(ctx, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(ds.updateState(d, query, report))
+}
+
+func (ds *sliReportDataSource) updateState(d *schema.ResourceData, query restapi.SliReportQuery, report *restapi.SliReport) error {
+	violationDistribution := make(map[string]interface{}, len(report.ViolationDistribution))
+	for k, v := range report.ViolationDistribution {
+		violationDistribution[k] = int(v)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d:%d", query.SliID, query.From, query.To))
+	d.Set(SliReportFieldSli, report.Sli)
+	d.Set(SliReportFieldTotalErrorBudget, int(report.TotalErrorBudget))
+	d.Set(SliReportFieldErrorBudgetRemaining, int(report.ErrorBudgetRemaining))
+	d.Set(SliReportFieldFromTimestamp, int(report.FromTimestamp))
+	d.Set(SliReportFieldToTimestamp, int(report.ToTimestamp))
+	return d.Set(SliReportFieldViolationDistribution, violationDistribution)
+}
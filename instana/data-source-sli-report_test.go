@@ -0,0 +1,147 @@
+package instana_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const testSliReportDataSource = "data.instana_sli_report.test"
+
+const dataSourceSliReportDefinitionTemplate = `
+data "instana_sli_report" "test" {
+  sli_id = "sli-id"
+  slo    = 0.99
+  from   = 1000
+  to     = 2000
+}
+`
+
+func TestDatasourceSliReportEndToEnd(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.SliReportResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("slo") != "0.99" || query.Get("from") != "1000" || query.Get("to") != "2000" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		httpServer.WriteJSONResponse(w, []byte(`[{"sli":0.995,"slo":0.99,"totalErrorBudget":100,"errorBudgetRemaining":50,"fromTimestamp":1000,"toTimestamp":2000,"violationDistribution":{"1000":2,"1500":3}}]`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resourceDefinition := appendProviderConfig(dataSourceSliReportDefinitionTemplate, httpServer.GetPort())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDefinition,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testSliReportDataSource, "id", "sli-id:1000:2000"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldSli, "0.995"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldTotalErrorBudget, "100"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldErrorBudgetRemaining, "50"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldFromTimestamp, "1000"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldToTimestamp, "2000"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldViolationDistribution+".%", "2"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldViolationDistribution+".1000", "2"),
+					resource.TestCheckResourceAttr(testSliReportDataSource, SliReportFieldViolationDistribution+".1500", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSliReportDefinition(t *testing.T) {
+	sut := NewSliReportDataSource().CreateResource()
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(sut.Schema, t)
+
+	require.Equal(t, 0, sut.SchemaVersion)
+	require.Equal(t, 10, len(sut.Schema))
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliReportFieldSliID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeFloat(SliReportFieldSlo)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(SliReportFieldFrom)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeInt(SliReportFieldTo)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeFloat(SliReportFieldSli)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SliReportFieldTotalErrorBudget)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SliReportFieldErrorBudgetRemaining)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SliReportFieldFromTimestamp)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeInt(SliReportFieldToTimestamp)
+	require.Equal(t, schema.TypeMap, sut.Schema[SliReportFieldViolationDistribution].Type)
+	require.True(t, sut.Schema[SliReportFieldViolationDistribution].Computed)
+}
+
+func TestShouldSuccessfullyReadSliReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewSliReportDataSource().CreateResource()
+
+	expectedQuery := restapi.SliReportQuery{SliID: "sli-id", Slo: 0.99, From: 1000, To: 2000}
+	report := &restapi.SliReport{
+		Sli:                   0.995,
+		Slo:                   0.99,
+		TotalErrorBudget:      100,
+		ErrorBudgetRemaining:  50,
+		FromTimestamp:         1000,
+		ToTimestamp:           2000,
+		ViolationDistribution: map[string]int32{"1000": 2},
+	}
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().SliReport(gomock.Any(), gomock.Eq(expectedQuery)).Times(1).Return(report, nil)
+
+	meta := &ProviderMeta{InstanaAPI: mockInstanaAPI}
+	resourceData := createSliReportTestResourceData(sut, t)
+
+	diags := sut.ReadContext(context.Background(), resourceData, meta)
+
+	require.False(t, diags.HasError())
+	require.Equal(t, "sli-id:1000:2000", resourceData.Id())
+	require.Equal(t, 0.995, resourceData.Get(SliReportFieldSli))
+	require.Equal(t, 100, resourceData.Get(SliReportFieldTotalErrorBudget))
+	require.Equal(t, 50, resourceData.Get(SliReportFieldErrorBudgetRemaining))
+	require.Equal(t, 1000, resourceData.Get(SliReportFieldFromTimestamp))
+	require.Equal(t, 2000, resourceData.Get(SliReportFieldToTimestamp))
+	require.Equal(t, map[string]interface{}{"1000": 2}, resourceData.Get(SliReportFieldViolationDistribution))
+}
+
+func TestShouldFailToReadSliReportWhenAPIRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sut := NewSliReportDataSource().CreateResource()
+
+	expectedError := errors.New("test")
+	mockInstanaAPI := mocks.NewMockInstanaAPI(ctrl)
+	mockInstanaAPI.EXPECT().SliReport(gomock.Any(), gomock.Any()).Times(1).Return(nil, expectedError)
+
+	meta := &ProviderMeta{InstanaAPI: mockInstanaAPI}
+	resourceData := createSliReportTestResourceData(sut, t)
+
+	diags := sut.ReadContext(context.Background(), resourceData, meta)
+
+	require.True(t, diags.HasError())
+	require.Equal(t, expectedError.Error(), diags[0].Summary)
+}
+
+func createSliReportTestResourceData(sut *schema.Resource, t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, sut.Schema, map[string]interface{}{
+		SliReportFieldSliID: "sli-id",
+		SliReportFieldSlo:   0.99,
+		SliReportFieldFrom:  1000,
+		SliReportFieldTo:    2000,
+	})
+}
@@ -1,15 +1,32 @@
 package instana
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"strings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 //SchemaFieldAPIToken the name of the provider configuration option for the api token
 const SchemaFieldAPIToken = "api_token"
 
+//SchemaFieldAPITokenFile the name of the provider configuration option for the file containing the api token
+const SchemaFieldAPITokenFile = "api_token_file"
+
+//SchemaFieldAPITokenCommand the name of the provider configuration option for the command which prints the api token
+const SchemaFieldAPITokenCommand = "api_token_command"
+
 //SchemaFieldEndpoint the name of the provider configuration option for the instana endpoint
 const SchemaFieldEndpoint = "endpoint"
 
@@ -22,19 +39,69 @@ const SchemaFieldDefaultNameSuffix = "default_name_suffix"
 //SchemaFieldTlsSkipVerify flag to deactivate skip tls verification
 const SchemaFieldTlsSkipVerify = "tls_skip_verify"
 
+//SchemaFieldCACertificateFile the path to a file containing PEM encoded certificates of additional trusted certificate authorities
+const SchemaFieldCACertificateFile = "ca_certificate_file"
+
+//SchemaFieldCACertificatePEM PEM encoded certificates of additional trusted certificate authorities
+const SchemaFieldCACertificatePEM = "ca_certificate_pem"
+
+//SchemaFieldClientCertificate the PEM encoded client certificate used for mutual TLS authentication
+const SchemaFieldClientCertificate = "client_certificate"
+
+//SchemaFieldClientKey the PEM encoded private key of the client certificate
+const SchemaFieldClientKey = "client_key"
+
+//SchemaFieldHTTPProxy the URL of the proxy used to access the Instana API
+const SchemaFieldHTTPProxy = "http_proxy"
+
+//SchemaFieldNoProxy the hosts which are accessed without the configured proxy
+const SchemaFieldNoProxy = "no_proxy"
+
+//SchemaFieldMaxRetries the maximum number of retries of throttled (HTTP 429) or failed (HTTP 5xx) requests
+const SchemaFieldMaxRetries = "max_retries"
+
+//SchemaFieldRetryMinBackoff the backoff before the first retry of a request
+const SchemaFieldRetryMinBackoff = "retry_min_backoff"
+
+//SchemaFieldRetryMaxBackoff the upper limit of the backoff between two retries of a request
+const SchemaFieldRetryMaxBackoff = "retry_max_backoff"
+
+//SchemaFieldWriteRequestsPerSecond the maximum number of write requests per second sent to the Instana API
+const SchemaFieldWriteRequestsPerSecond = "write_requests_per_second"
+
+//SchemaFieldReadRequestsPerSecond the maximum number of read requests per second sent to the Instana API
+const SchemaFieldReadRequestsPerSecond = "read_requests_per_second"
+
+//SchemaFieldRequestQueueSize the maximum number of throttled requests waiting for execution
+const SchemaFieldRequestQueueSize = "request_queue_size"
+
+//SchemaFieldRequestTimeout the maximum duration of a single request to the Instana API
+const SchemaFieldRequestTimeout = "request_timeout"
+
+//EnvVarAPIToken the environment variable which is used as fallback for the api token
+const EnvVarAPIToken = "INSTANA_API_TOKEN"
+
+//EnvVarEndpoint the environment variable which is used as fallback for the instana endpoint
+const EnvVarEndpoint = "INSTANA_ENDPOINT"
+
+//EnvVarTlsSkipVerify the environment variable which is used as fallback for the tls skip verification flag
+const EnvVarTlsSkipVerify = "INSTANA_TLS_SKIP_VERIFY"
+
 //ProviderMeta data structure for the meta data which is configured and provided to the resources by this provider
 type ProviderMeta struct {
 	InstanaAPI            restapi.InstanaAPI
 	ResourceNameFormatter utils.ResourceNameFormatter
+	//InstanaVersion the version of the Instana backend. Nil when the version could not be detected
+	InstanaVersion *version.Version
 }
 
 //Provider interface implementation of hashicorp terraform provider
 func Provider() *schema.Provider {
 	return &schema.Provider{
-		Schema:         providerSchema(),
-		ResourcesMap:   providerResources(),
-		DataSourcesMap: providerDataSources(),
-		ConfigureFunc:  providerConfigure,
+		Schema:               providerSchema(),
+		ResourcesMap:         providerResources(),
+		DataSourcesMap:       providerDataSources(),
+		ConfigureContextFunc: providerConfigure,
 	}
 }
 
@@ -43,13 +110,27 @@ func providerSchema() map[string]*schema.Schema {
 		SchemaFieldAPIToken: {
 			Type:        schema.TypeString,
 			Sensitive:   true,
-			Required:    true,
-			Description: "API token used to authenticate with the Instana Backend",
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarAPIToken, nil),
+			Description: "API token used to authenticate with the Instana Backend. Falls back to the environment variable " + EnvVarAPIToken,
+		},
+		SchemaFieldAPITokenFile: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldAPIToken, SchemaFieldAPITokenCommand},
+			Description:   "Path to a file containing the API token used to authenticate with the Instana Backend",
+		},
+		SchemaFieldAPITokenCommand: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{SchemaFieldAPIToken, SchemaFieldAPITokenFile},
+			Description:   "Command which prints the API token used to authenticate with the Instana Backend to stdout (e.g. the CLI of a secret manager). The command is executed without a shell",
 		},
 		SchemaFieldEndpoint: {
 			Type:        schema.TypeString,
 			Required:    true,
-			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io)",
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarEndpoint, nil),
+			Description: "The DNS Name of the Instana Endpoint (eg. saas-eu-west-1.instana.io) or the full URL including scheme, port and path prefix (eg. https://instana.example.com:8443/instana). Falls back to the environment variable " + EnvVarEndpoint,
 		},
 		SchemaFieldDefaultNamePrefix: {
 			Type:        schema.TypeString,
@@ -66,16 +147,111 @@ func providerSchema() map[string]*schema.Schema {
 		SchemaFieldTlsSkipVerify: {
 			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     false,
-			Description: "If set to true, TLS verification will be skipped when calling Instana API",
+			DefaultFunc: schema.EnvDefaultFunc(EnvVarTlsSkipVerify, false),
+			Description: "If set to true, TLS verification will be skipped when calling Instana API. Falls back to the environment variable " + EnvVarTlsSkipVerify + " - default false",
+		},
+		SchemaFieldCACertificateFile: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to a file containing PEM encoded certificates of certificate authorities which are trusted in addition to the system certificate authorities when verifying the TLS certificate of the Instana API",
+		},
+		SchemaFieldCACertificatePEM: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "PEM encoded certificates of certificate authorities which are trusted in addition to the system certificate authorities when verifying the TLS certificate of the Instana API",
+		},
+		SchemaFieldClientCertificate: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{SchemaFieldClientKey},
+			Description:  "PEM encoded client certificate presented to the Instana API for mutual TLS authentication",
+		},
+		SchemaFieldClientKey: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{SchemaFieldClientCertificate},
+			Description:  "PEM encoded private key of the client certificate",
+		},
+		SchemaFieldHTTPProxy: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			Description:  "The URL of the proxy used for all requests to the Instana API (e.g. http://proxy.example.com:3128). When not set the proxy settings of the environment (HTTPS_PROXY, NO_PROXY) are used",
+		},
+		SchemaFieldNoProxy: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{SchemaFieldHTTPProxy},
+			Description:  "Comma separated list of hosts, domains, IP addresses or CIDRs which are accessed without the configured http_proxy",
+		},
+		SchemaFieldMaxRetries: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultRetryPolicy.MaxRetries,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of retries of requests which are throttled (HTTP 429) or failed with a server side error (HTTP 5xx). Server side errors are only retried for idempotent requests. 0 deactivates retries - default 3",
+		},
+		SchemaFieldRetryMinBackoff: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      restapi.DefaultRetryPolicy.MinBackoff.String(),
+			ValidateFunc: validateDuration,
+			Description:  "The backoff before the first retry of a request (e.g. 500ms, 1s). The backoff is doubled for every further retry - default 1s",
+		},
+		SchemaFieldRetryMaxBackoff: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      restapi.DefaultRetryPolicy.MaxBackoff.String(),
+			ValidateFunc: validateDuration,
+			Description:  "The upper limit of the backoff between two retries of a request (e.g. 10s, 1m). The limit also applies to delays requested by the Instana API via the Retry-After header - default 10s",
+		},
+		SchemaFieldWriteRequestsPerSecond: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultThrottlingPolicy.WriteRequestsPerSecond,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of write requests (POST, PUT, DELETE) per second sent to the Instana API - default 5",
 		},
+		SchemaFieldReadRequestsPerSecond: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultThrottlingPolicy.ReadRequestsPerSecond,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of read requests (GET) per second sent to the Instana API. 0 deactivates the throttling of read requests - default 0",
+		},
+		SchemaFieldRequestQueueSize: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      restapi.DefaultThrottlingPolicy.QueueSize,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of throttled requests waiting for execution. Further requests are blocked until a slot is available or the request times out - default 1000",
+		},
+		SchemaFieldRequestTimeout: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      restapi.DefaultClientConfig.RequestTimeout.String(),
+			ValidateFunc: validateDuration,
+			Description:  "The maximum duration of a single request to the Instana API (e.g. 30s, 2m) including the time waiting for execution and all retries - default 30s",
+		},
+	}
+}
+
+func validateDuration(val interface{}, key string) (warns []string, errs []error) {
+	if d, err := time.ParseDuration(val.(string)); err != nil || d < 0 {
+		errs = append(errs, fmt.Errorf("%q must be a positive duration like 500ms, 10s or 1m; got %s", key, val))
 	}
+	return
 }
 
 func providerResources() map[string]*schema.Resource {
 	resources := make(map[string]*schema.Resource)
 	bindResourceHandle(resources, NewAPITokenResourceHandle())
 	bindResourceHandle(resources, NewApplicationConfigResourceHandle())
+	bindResourceHandle(resources, NewApplicationHTTPEndpointConfigResourceHandle())
+	bindResourceHandle(resources, NewApplicationServiceConfigResourceHandle())
+	bindResourceHandle(resources, NewApplicationServiceConfigOrderResourceHandle())
+	bindResourceHandle(resources, NewManualServiceResourceHandle())
 	bindResourceHandle(resources, NewApplicationAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewGlobalApplicationAlertConfigResourceHandle())
 	bindResourceHandle(resources, NewCustomEventSpecificationWithSystemRuleResourceHandle())
@@ -91,11 +267,27 @@ func providerResources() map[string]*schema.Resource {
 	bindResourceHandle(resources, NewAlertingChannelVictorOpsResourceHandle())
 	bindResourceHandle(resources, NewAlertingChannelWebhookResourceHandle())
 	bindResourceHandle(resources, NewAlertingConfigResourceHandle())
+	bindResourceHandle(resources, NewGlobalCustomPayloadConfigurationResourceHandle())
 	bindResourceHandle(resources, NewSliConfigResourceHandle())
+	bindResourceHandle(resources, NewSliConfigV2ResourceHandle())
 	bindResourceHandle(resources, NewWebsiteMonitoringConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteGeoLocationConfigResourceHandle())
+	bindResourceHandle(resources, NewWebsiteGeoMappingRulesResourceHandle())
+	bindResourceHandle(resources, NewWebsiteIPMaskingConfigResourceHandle())
 	bindResourceHandle(resources, NewWebsiteAlertConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppGeoLocationConfigResourceHandle())
+	bindResourceHandle(resources, NewMobileAppGeoMappingRulesResourceHandle())
+	bindResourceHandle(resources, NewMobileAppIPMaskingConfigResourceHandle())
 	bindResourceHandle(resources, NewGroupResourceHandle())
+	bindResourceHandle(resources, NewGroupMappingResourceHandle())
+	bindResourceHandle(resources, NewGroupMembershipResourceHandle())
+	bindResourceHandle(resources, NewUserInvitationResourceHandle())
+	bindResourceHandle(resources, NewSessionSettingsResourceHandle())
+	bindResourceHandle(resources, NewSyntheticCallsSettingsResourceHandle())
 	bindResourceHandle(resources, NewCustomDashboardResourceHandle())
+	bindResourceHandle(resources, NewMaintenanceWindowResourceHandle())
+	bindResourceHandle(resources, NewReleaseResourceHandle())
 	return resources
 }
 
@@ -103,22 +295,167 @@ func bindResourceHandle(resources map[string]*schema.Resource, resourceHandle Re
 	resources[resourceHandle.MetaData().ResourceName] = NewTerraformResource(resourceHandle).ToSchemaResource()
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	apiToken := strings.TrimSpace(d.Get(SchemaFieldAPIToken).(string))
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	apiToken, err := readAPIToken(ctx, d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	endpoint := strings.TrimSpace(d.Get(SchemaFieldEndpoint).(string))
 	defaultNamePrefix := d.Get(SchemaFieldDefaultNamePrefix).(string)
 	defaultNameSuffix := d.Get(SchemaFieldDefaultNameSuffix).(string)
-	skipTlsVerify := d.Get(SchemaFieldTlsSkipVerify).(bool)
-	instanaAPI := restapi.NewInstanaAPI(apiToken, endpoint, skipTlsVerify)
+	clientConfig, err := readClientConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	instanaAPI, err := restapi.NewInstanaAPI(apiToken, endpoint, clientConfig)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	formatter := utils.NewResourceNameFormatter(defaultNamePrefix, defaultNameSuffix)
+	instanaVersion, diags := readInstanaVersion(ctx, instanaAPI)
 	return &ProviderMeta{
 		InstanaAPI:            instanaAPI,
 		ResourceNameFormatter: formatter,
+		InstanaVersion:        instanaVersion,
+	}, diags
+}
+
+//readInstanaVersion detects the version of the Instana backend. The detection is best effort; when the version cannot be
+//detected a warning is returned and version specific features are not verified
+func readInstanaVersion(ctx context.Context, instanaAPI restapi.InstanaAPI) (*version.Version, diag.Diagnostics) {
+	versionInfo, err := instanaAPI.InstanaVersion(ctx)
+	if err == nil {
+		instanaVersion, parseErr := versionInfo.Version()
+		if parseErr == nil {
+			return instanaVersion, nil
+		}
+		err = parseErr
+	}
+	return nil, diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "failed to detect version of the Instana backend",
+			Detail:   fmt.Sprintf("The minimum Instana backend version of resources is not verified; %s", err),
+		},
+	}
+}
+
+//readAPIToken reads the api token from the configured api token file or api token command. The api token file and the api token command
+//take precedence over the api token provided by the environment variable INSTANA_API_TOKEN.
+func readAPIToken(ctx context.Context, d *schema.ResourceData) (string, error) {
+	var apiToken string
+	if tokenFile, ok := d.GetOk(SchemaFieldAPITokenFile); ok {
+		data, err := os.ReadFile(tokenFile.(string))
+		if err != nil {
+			return "", fmt.Errorf("failed to read api token from %s; %s", SchemaFieldAPITokenFile, err)
+		}
+		apiToken = strings.TrimSpace(string(data))
+	} else if tokenCommand, ok := d.GetOk(SchemaFieldAPITokenCommand); ok {
+		token, err := executeAPITokenCommand(ctx, tokenCommand.(string))
+		if err != nil {
+			return "", err
+		}
+		apiToken = token
+	} else {
+		apiToken = strings.TrimSpace(d.Get(SchemaFieldAPIToken).(string))
+	}
+	if len(apiToken) == 0 {
+		return "", fmt.Errorf("no api token provided; configure one of %s, %s or %s or set the environment variable %s", SchemaFieldAPIToken, SchemaFieldAPITokenFile, SchemaFieldAPITokenCommand, EnvVarAPIToken)
+	}
+	return apiToken, nil
+}
+
+func executeAPITokenCommand(ctx context.Context, command string) (string, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return "", fmt.Errorf("%s must not be empty", SchemaFieldAPITokenCommand)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...) //nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("failed to read api token from %s; %s; %s", SchemaFieldAPITokenCommand, err, strings.TrimSpace(stderr.String()))
+		}
+		return "", fmt.Errorf("failed to read api token from %s; %s", SchemaFieldAPITokenCommand, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func readClientConfig(d *schema.ResourceData) (restapi.ClientConfig, error) {
+	retryPolicy, err := readRetryPolicy(d)
+	if err != nil {
+		return restapi.ClientConfig{}, err
+	}
+	requestTimeout, err := time.ParseDuration(d.Get(SchemaFieldRequestTimeout).(string))
+	if err != nil {
+		return restapi.ClientConfig{}, fmt.Errorf("invalid %s; %s", SchemaFieldRequestTimeout, err)
+	}
+	if requestTimeout <= 0 {
+		return restapi.ClientConfig{}, fmt.Errorf("%s must be greater than 0", SchemaFieldRequestTimeout)
+	}
+	caCertificates, err := readCACertificates(d)
+	if err != nil {
+		return restapi.ClientConfig{}, err
+	}
+	return restapi.ClientConfig{
+		SkipTlsVerification: d.Get(SchemaFieldTlsSkipVerify).(bool),
+		CACertificates:      caCertificates,
+		ClientCertificate:   []byte(d.Get(SchemaFieldClientCertificate).(string)),
+		ClientKey:           []byte(d.Get(SchemaFieldClientKey).(string)),
+		HTTPProxy:           d.Get(SchemaFieldHTTPProxy).(string),
+		NoProxy:             d.Get(SchemaFieldNoProxy).(string),
+		RetryPolicy:         retryPolicy,
+		Throttling: restapi.ThrottlingPolicy{
+			WriteRequestsPerSecond: d.Get(SchemaFieldWriteRequestsPerSecond).(int),
+			ReadRequestsPerSecond:  d.Get(SchemaFieldReadRequestsPerSecond).(int),
+			QueueSize:              d.Get(SchemaFieldRequestQueueSize).(int),
+		},
+		RequestTimeout: requestTimeout,
+	}, nil
+}
+
+//readCACertificates reads the certificates of the configured CA certificate file and appends the configured PEM encoded CA certificates
+func readCACertificates(d *schema.ResourceData) ([]byte, error) {
+	var caCertificates []byte
+	if caCertificateFile, ok := d.GetOk(SchemaFieldCACertificateFile); ok {
+		data, err := os.ReadFile(caCertificateFile.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s; %s", SchemaFieldCACertificateFile, err)
+		}
+		caCertificates = append(caCertificates, data...)
+		caCertificates = append(caCertificates, '\n')
+	}
+	if caCertificatePEM, ok := d.GetOk(SchemaFieldCACertificatePEM); ok {
+		caCertificates = append(caCertificates, []byte(caCertificatePEM.(string))...)
+	}
+	return caCertificates, nil
+}
+
+func readRetryPolicy(d *schema.ResourceData) (restapi.RetryPolicy, error) {
+	minBackoff, err := time.ParseDuration(d.Get(SchemaFieldRetryMinBackoff).(string))
+	if err != nil {
+		return restapi.RetryPolicy{}, fmt.Errorf("invalid %s; %s", SchemaFieldRetryMinBackoff, err)
+	}
+	maxBackoff, err := time.ParseDuration(d.Get(SchemaFieldRetryMaxBackoff).(string))
+	if err != nil {
+		return restapi.RetryPolicy{}, fmt.Errorf("invalid %s; %s", SchemaFieldRetryMaxBackoff, err)
+	}
+	if maxBackoff < minBackoff {
+		return restapi.RetryPolicy{}, fmt.Errorf("%s must not be lower than %s", SchemaFieldRetryMaxBackoff, SchemaFieldRetryMinBackoff)
+	}
+	return restapi.RetryPolicy{
+		MaxRetries: d.Get(SchemaFieldMaxRetries).(int),
+		MinBackoff: minBackoff,
+		MaxBackoff: maxBackoff,
 	}, nil
 }
 
 func providerDataSources() map[string]*schema.Resource {
 	dataSources := make(map[string]*schema.Resource)
 	dataSources[DataSourceBuiltinEvent] = NewBuiltinEventDataSource().CreateResource()
+	dataSources[DataSourceSliReport] = NewSliReportDataSource().CreateResource()
 	return dataSources
 }
@@ -1,12 +1,21 @@
 package instana_test
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProviderShouldValidateInternally(t *testing.T) {
@@ -19,30 +28,282 @@ func TestProviderShouldContainValidSchemaDefinition(t *testing.T) {
 	config := Provider()
 
 	assert.NotNil(t, config.Schema)
-	assert.Equal(t, 5, len(config.Schema))
+	assert.Equal(t, 20, len(config.Schema))
 
 	schemaAssert := testutils.NewTerraformSchemaAssert(config.Schema, t)
-	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldAPIToken)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPIToken)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPITokenFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldAPITokenCommand)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SchemaFieldEndpoint)
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldDefaultNamePrefix, "")
 	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldDefaultNameSuffix, "(TF managed)")
-	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SchemaFieldTlsSkipVerify, false)
+	assert.Equal(t, schema.TypeBool, config.Schema[SchemaFieldTlsSkipVerify].Type)
+	assert.True(t, config.Schema[SchemaFieldTlsSkipVerify].Optional)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldCACertificateFile)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldCACertificatePEM)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientCertificate)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldClientKey)
+	assert.True(t, config.Schema[SchemaFieldClientKey].Sensitive)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldHTTPProxy)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SchemaFieldNoProxy)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldMaxRetries)
+	assert.Equal(t, 3, config.Schema[SchemaFieldMaxRetries].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldRetryMinBackoff, "1s")
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldRetryMaxBackoff, "10s")
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldWriteRequestsPerSecond)
+	assert.Equal(t, 5, config.Schema[SchemaFieldWriteRequestsPerSecond].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldReadRequestsPerSecond)
+	assert.Equal(t, 0, config.Schema[SchemaFieldReadRequestsPerSecond].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SchemaFieldRequestQueueSize)
+	assert.Equal(t, 1000, config.Schema[SchemaFieldRequestQueueSize].Default)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeStringWithDefault(SchemaFieldRequestTimeout, "30s")
+}
+
+func TestProviderShouldRejectInvalidDurations(t *testing.T) {
+	config := Provider()
+
+	for _, field := range []string{SchemaFieldRetryMinBackoff, SchemaFieldRetryMaxBackoff, SchemaFieldRequestTimeout} {
+		_, errs := config.Schema[field].ValidateFunc("500ms", field)
+		assert.Empty(t, errs)
+
+		_, errs = config.Schema[field].ValidateFunc("invalid", field)
+		assert.Len(t, errs, 1)
+
+		_, errs = config.Schema[field].ValidateFunc("-1s", field)
+		assert.Len(t, errs, 1)
+	}
+}
+
+func TestProviderShouldUseEnvironmentVariablesAsFallback(t *testing.T) {
+	t.Setenv(EnvVarAPIToken, "env-api-token")
+	t.Setenv(EnvVarEndpoint, "env.instana.io")
+	t.Setenv(EnvVarTlsSkipVerify, "true")
+	config := Provider()
+
+	apiToken, err := config.Schema[SchemaFieldAPIToken].DefaultValue()
+	require.NoError(t, err)
+	require.Equal(t, "env-api-token", apiToken)
+
+	endpoint, err := config.Schema[SchemaFieldEndpoint].DefaultValue()
+	require.NoError(t, err)
+	require.Equal(t, "env.instana.io", endpoint)
+
+	tlsSkipVerify, err := config.Schema[SchemaFieldTlsSkipVerify].DefaultValue()
+	require.NoError(t, err)
+	require.Equal(t, "true", tlsSkipVerify)
+}
+
+func TestProviderShouldNotSkipTlsVerificationWhenEnvironmentVariableIsNotSet(t *testing.T) {
+	t.Setenv(EnvVarTlsSkipVerify, "")
+	require.NoError(t, os.Unsetenv(EnvVarTlsSkipVerify))
+
+	tlsSkipVerify, err := Provider().Schema[SchemaFieldTlsSkipVerify].DefaultValue()
+
+	require.NoError(t, err)
+	require.Equal(t, false, tlsSkipVerify)
+}
+
+func TestProviderShouldBeConfiguredWithApiTokenFromEnvironmentVariable(t *testing.T) {
+	t.Setenv(EnvVarAPIToken, "env-api-token")
+
+	verifyAPITokenIsSentToInstanaAPI(t, map[string]interface{}{}, "env-api-token")
+}
+
+func TestProviderShouldBeConfiguredWithApiTokenFromFile(t *testing.T) {
+	t.Setenv(EnvVarAPIToken, "env-api-token")
+	tokenFile := filepath.Join(t.TempDir(), "api-token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-api-token\n"), 0600))
+
+	verifyAPITokenIsSentToInstanaAPI(t, map[string]interface{}{SchemaFieldAPITokenFile: tokenFile}, "file-api-token")
+}
+
+func TestProviderShouldBeConfiguredWithApiTokenFromCommand(t *testing.T) {
+	t.Setenv(EnvVarAPIToken, "env-api-token")
+
+	verifyAPITokenIsSentToInstanaAPI(t, map[string]interface{}{SchemaFieldAPITokenCommand: "echo command-api-token"}, "command-api-token")
+}
+
+func verifyAPITokenIsSentToInstanaAPI(t *testing.T, providerConfig map[string]interface{}, expectedAPIToken string) {
+	var authorizationHeader string
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.BuiltinEventSpecificationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		authorizationHeader = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("[]"))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	providerConfig[SchemaFieldEndpoint] = fmt.Sprintf("localhost:%d", httpServer.GetPort())
+	providerConfig[SchemaFieldTlsSkipVerify] = true
+	config := Provider()
+	diags := config.Configure(context.Background(), terraform.NewResourceConfigRaw(providerConfig))
+	require.False(t, diags.HasError())
+
+	_, err := config.Meta().(*ProviderMeta).InstanaAPI.BuiltinEventSpecifications().GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, "apiToken "+expectedAPIToken, authorizationHeader)
+}
+
+func TestProviderShouldFailToConfigureWhenNoApiTokenIsProvided(t *testing.T) {
+	t.Setenv(EnvVarAPIToken, "")
+
+	diags := configureProvider(map[string]interface{}{})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "no api token provided")
+}
+
+func TestProviderShouldFailToConfigureWhenApiTokenFileDoesNotExist(t *testing.T) {
+	diags := configureProvider(map[string]interface{}{SchemaFieldAPITokenFile: filepath.Join(t.TempDir(), "missing")})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "failed to read api token from "+SchemaFieldAPITokenFile)
+}
+
+func TestProviderShouldFailToConfigureWhenApiTokenFileIsEmpty(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "api-token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("  \n"), 0600))
+
+	diags := configureProvider(map[string]interface{}{SchemaFieldAPITokenFile: tokenFile})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "no api token provided")
+}
+
+func TestProviderShouldFailToConfigureWhenApiTokenCommandFails(t *testing.T) {
+	diags := configureProvider(map[string]interface{}{SchemaFieldAPITokenCommand: "false"})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "failed to read api token from "+SchemaFieldAPITokenCommand)
+}
+
+func TestProviderShouldFailToConfigureWhenApiTokenCommandDoesNotExist(t *testing.T) {
+	diags := configureProvider(map[string]interface{}{SchemaFieldAPITokenCommand: "non-existing-api-token-command"})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "failed to read api token from "+SchemaFieldAPITokenCommand)
+}
+
+func TestProviderShouldRejectApiTokenFileAndApiTokenCommandInCombination(t *testing.T) {
+	diags := Provider().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldEndpoint:        "localhost",
+		SchemaFieldAPITokenFile:    "api-token",
+		SchemaFieldAPITokenCommand: "echo api-token",
+	}))
+
+	require.True(t, diags.HasError())
+}
+
+func TestProviderShouldFailToConfigureWhenCACertificateFileDoesNotExist(t *testing.T) {
+	diags := configureProvider(map[string]interface{}{
+		SchemaFieldAPIToken:          "api-token",
+		SchemaFieldCACertificateFile: filepath.Join(t.TempDir(), "missing"),
+	})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "failed to read "+SchemaFieldCACertificateFile)
+}
+
+func TestProviderShouldFailToConfigureWhenCACertificatePEMIsInvalid(t *testing.T) {
+	diags := configureProvider(map[string]interface{}{
+		SchemaFieldAPIToken:         "api-token",
+		SchemaFieldCACertificatePEM: "invalid",
+	})
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "failed to load CA certificates")
+}
+
+func TestProviderShouldRejectInvalidHTTPProxy(t *testing.T) {
+	_, errs := Provider().Schema[SchemaFieldHTTPProxy].ValidateFunc("http://proxy.example.com:3128", SchemaFieldHTTPProxy)
+	require.Empty(t, errs)
+
+	_, errs = Provider().Schema[SchemaFieldHTTPProxy].ValidateFunc("proxy.example.com", SchemaFieldHTTPProxy)
+	require.NotEmpty(t, errs)
+}
+
+func TestProviderShouldDetectVersionOfInstanaBackend(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"branch":"release-229","commit":"abc","imageTag":"3.229.318-0"}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := Provider()
+	diags := config.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:      "api-token",
+		SchemaFieldEndpoint:      fmt.Sprintf("localhost:%d", httpServer.GetPort()),
+		SchemaFieldTlsSkipVerify: true,
+	}))
+
+	require.Empty(t, diags)
+	require.Equal(t, "3.229.318", config.Meta().(*ProviderMeta).InstanaVersion.String())
+}
+
+func TestProviderShouldReturnWarningWhenVersionOfInstanaBackendCannotBeDetected(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := Provider()
+	diags := config.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		SchemaFieldAPIToken:      "api-token",
+		SchemaFieldEndpoint:      fmt.Sprintf("localhost:%d", httpServer.GetPort()),
+		SchemaFieldTlsSkipVerify: true,
+	}))
+
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "failed to detect version of the Instana backend", diags[0].Summary)
+	require.Nil(t, config.Meta().(*ProviderMeta).InstanaVersion)
+}
+
+func configureProvider(providerConfig map[string]interface{}) diag.Diagnostics {
+	providerConfig[SchemaFieldEndpoint] = "localhost"
+	return Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(providerConfig))
 }
 
 func TestProviderShouldContainValidResourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 22, len(config.ResourcesMap))
+	assert.Equal(t, 42, len(config.ResourcesMap))
 
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaAPIToken])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationHTTPEndpointConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationServiceConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationServiceConfigOrder])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaManualService])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaApplicationAlertConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalApplicationAlertConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSliConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSliConfigV2])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteMonitoringConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteGeoLocationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteGeoMappingRules])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteIPMaskingConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaWebsiteAlertConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppGeoLocationConfig])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppGeoMappingRules])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMobileAppIPMaskingConfig])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroup])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMapping])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGroupMembership])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaUserInvitation])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSessionSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaSyntheticCallsSettings])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaGlobalCustomPayloadConfiguration])
 	assert.NotNil(t, config.ResourcesMap[ResourceInstanaCustomDashboard])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaMaintenanceWindow])
+	assert.NotNil(t, config.ResourcesMap[ResourceInstanaRelease])
 
 	validateResourcesMapForCustomEvents(config.ResourcesMap, t)
 	validateResourcesMapForAlerting(config.ResourcesMap, t)
@@ -70,7 +331,8 @@ func validateResourcesMapForAlerting(resourceMap map[string]*schema.Resource, t
 func TestProviderShouldContainValidDataSourceDefinitions(t *testing.T) {
 	config := Provider()
 
-	assert.Equal(t, 1, len(config.DataSourcesMap))
+	assert.Equal(t, 2, len(config.DataSourcesMap))
 
 	assert.NotNil(t, config.DataSourcesMap[DataSourceBuiltinEvent])
+	assert.NotNil(t, config.DataSourcesMap[DataSourceSliReport])
 }
@@ -65,35 +65,12 @@ var (
 		Description:  "The boundary scope of the application config",
 	}
 	//ApplicationConfigMatchSpecification schema for the application config field match_specification
-	ApplicationConfigMatchSpecification = &schema.Schema{
-		Type:         schema.TypeString,
+	ApplicationConfigMatchSpecification = newMatchExpressionSchema(&schema.Schema{
 		Optional:     true,
 		ExactlyOneOf: []string{ApplicationConfigFieldMatchSpecification, ApplicationConfigFieldTagFilter},
 		Description:  "The match specification of the application config",
 		Deprecated:   fmt.Sprintf("%s is deprecated. Please migrate to %s", ApplicationConfigFieldMatchSpecification, ApplicationConfigFieldTagFilter),
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			normalized, err := filterexpression.Normalize(new)
-			if err == nil {
-				return normalized == old
-			}
-			return old == new
-		},
-		StateFunc: func(val interface{}) string {
-			normalized, err := filterexpression.Normalize(val.(string))
-			if err == nil {
-				return normalized
-			}
-			return val.(string)
-		},
-		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-			v := val.(string)
-			if _, err := filterexpression.NewParser().Parse(v); err != nil {
-				errs = append(errs, fmt.Errorf("%q is not a valid match expression; %s", key, err))
-			}
-
-			return
-		},
-	}
+	})
 	//ApplicationConfigNormalizedMatchSpecification schema for the application config field normalized_match_specification
 	ApplicationConfigNormalizedMatchSpecification = &schema.Schema{
 		Type:        schema.TypeString,
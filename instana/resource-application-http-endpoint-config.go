@@ -0,0 +1,271 @@
+package instana
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaApplicationHTTPEndpointConfig the name of the terraform-provider-instana resource to manage application http endpoint configs
+const ResourceInstanaApplicationHTTPEndpointConfig = "instana_application_http_endpoint_config"
+
+const (
+	//ApplicationHTTPEndpointConfigFieldServiceID constant value for the schema field service_id
+	ApplicationHTTPEndpointConfigFieldServiceID = "service_id"
+	//ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled constant value for the schema field endpoint_name_by_first_path_segment_rule_enabled
+	ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled = "endpoint_name_by_first_path_segment_rule_enabled"
+	//ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled constant value for the schema field endpoint_name_by_collected_path_template_rule_enabled
+	ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled = "endpoint_name_by_collected_path_template_rule_enabled"
+	//ApplicationHTTPEndpointConfigFieldRule constant value for the schema field rule
+	ApplicationHTTPEndpointConfigFieldRule = "rule"
+	//ApplicationHTTPEndpointConfigFieldRuleEnabled constant value for the schema field rule.enabled
+	ApplicationHTTPEndpointConfigFieldRuleEnabled = "enabled"
+	//ApplicationHTTPEndpointConfigFieldRulePathTemplate constant value for the schema field rule.path_template
+	ApplicationHTTPEndpointConfigFieldRulePathTemplate = "path_template"
+	//ApplicationHTTPEndpointConfigFieldRuleTestCases constant value for the schema field rule.test_cases
+	ApplicationHTTPEndpointConfigFieldRuleTestCases = "test_cases"
+)
+
+const (
+	httpPathTemplateSeparator = "/"
+	httpPathTemplateMatchAll  = "**"
+)
+
+var (
+	//ApplicationHTTPEndpointConfigServiceID schema field definition of instana_application_http_endpoint_config field service_id
+	ApplicationHTTPEndpointConfigServiceID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the service the HTTP endpoint config is applied to. The service ID is used as ID of the resource",
+	}
+	//ApplicationHTTPEndpointConfigEndpointNameByFirstPathSegmentRuleEnabled schema field definition of instana_application_http_endpoint_config field endpoint_name_by_first_path_segment_rule_enabled
+	ApplicationHTTPEndpointConfigEndpointNameByFirstPathSegmentRuleEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag to indicate if the endpoint name should be derived from the first path segment when no rule matches",
+	}
+	//ApplicationHTTPEndpointConfigEndpointNameByCollectedPathTemplateRuleEnabled schema field definition of instana_application_http_endpoint_config field endpoint_name_by_collected_path_template_rule_enabled
+	ApplicationHTTPEndpointConfigEndpointNameByCollectedPathTemplateRuleEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Flag to indicate if the endpoint name should be derived from the path template collected by the tracers (e.g. from the web framework) when no rule matches",
+	}
+	//ApplicationHTTPEndpointConfigRule schema field definition of instana_application_http_endpoint_config field rule
+	ApplicationHTTPEndpointConfigRule = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    restapi.MaxHTTPEndpointRules,
+		Description: "The path template rules of the HTTP endpoint config. The rules are evaluated in the given order",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ApplicationHTTPEndpointConfigFieldRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate if the rule is enabled",
+				},
+				ApplicationHTTPEndpointConfigFieldRulePathTemplate: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The path template of the rule. Path segments in curly braces (e.g. {id}) are parameters and a trailing ** matches all remaining path segments",
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						normalized, err := normalizeHTTPPathTemplate(new)
+						if err == nil {
+							return normalized == old
+						}
+						return old == new
+					},
+					StateFunc: func(val interface{}) string {
+						normalized, err := normalizeHTTPPathTemplate(val.(string))
+						if err == nil {
+							return normalized
+						}
+						return val.(string)
+					},
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						if _, err := parseHTTPPathTemplate(val.(string)); err != nil {
+							errs = append(errs, fmt.Errorf("%q is not a valid path template; %s", key, err))
+						}
+						return
+					},
+				},
+				ApplicationHTTPEndpointConfigFieldRuleTestCases: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 32,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Paths which are expected to match the path template of the rule",
+				},
+			},
+		},
+	}
+)
+
+//NewApplicationHTTPEndpointConfigResourceHandle creates a new instance of the ResourceHandle for application http endpoint configs
+func NewApplicationHTTPEndpointConfigResourceHandle() ResourceHandle {
+	serviceIDFieldName := ApplicationHTTPEndpointConfigFieldServiceID
+	return &applicationHTTPEndpointConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName:     ResourceInstanaApplicationHTTPEndpointConfig,
+			SkipIDGeneration: true,
+			ResourceIDField:  &serviceIDFieldName,
+			Schema: map[string]*schema.Schema{
+				ApplicationHTTPEndpointConfigFieldServiceID:                                      ApplicationHTTPEndpointConfigServiceID,
+				ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled:      ApplicationHTTPEndpointConfigEndpointNameByFirstPathSegmentRuleEnabled,
+				ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled: ApplicationHTTPEndpointConfigEndpointNameByCollectedPathTemplateRuleEnabled,
+				ApplicationHTTPEndpointConfigFieldRule:                                           ApplicationHTTPEndpointConfigRule,
+			},
+		},
+	}
+}
+
+type applicationHTTPEndpointConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *applicationHTTPEndpointConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *applicationHTTPEndpointConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *applicationHTTPEndpointConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.ApplicationHTTPEndpointConfigs()
+}
+
+func (r *applicationHTTPEndpointConfigResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *applicationHTTPEndpointConfigResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.ApplicationHTTPEndpointConfig)
+
+	rules, err := r.mapRulesToSchema(config.Rules)
+	if err != nil {
+		return err
+	}
+
+	d.Set(ApplicationHTTPEndpointConfigFieldServiceID, config.ServiceID)
+	d.Set(ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, config.EndpointNameByFirstPathSegmentRuleEnabled)
+	d.Set(ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, config.EndpointNameByCollectedPathTemplateRuleEnabled)
+	d.Set(ApplicationHTTPEndpointConfigFieldRule, rules)
+	d.SetId(config.ServiceID)
+	return nil
+}
+
+func (r *applicationHTTPEndpointConfigResource) mapRulesToSchema(rules []restapi.HTTPEndpointRule) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		pathTemplate, err := renderHTTPPathTemplate(rule.PathSegments)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = map[string]interface{}{
+			ApplicationHTTPEndpointConfigFieldRuleEnabled:      rule.Enabled,
+			ApplicationHTTPEndpointConfigFieldRulePathTemplate: pathTemplate,
+			ApplicationHTTPEndpointConfigFieldRuleTestCases:    rule.TestCases,
+		}
+	}
+	return result, nil
+}
+
+func (r *applicationHTTPEndpointConfigResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	rules, err := r.mapRulesFromSchema(d)
+	if err != nil {
+		return &restapi.ApplicationHTTPEndpointConfig{}, err
+	}
+
+	return &restapi.ApplicationHTTPEndpointConfig{
+		ServiceID: d.Get(ApplicationHTTPEndpointConfigFieldServiceID).(string),
+		EndpointNameByFirstPathSegmentRuleEnabled:      d.Get(ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool),
+		EndpointNameByCollectedPathTemplateRuleEnabled: d.Get(ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool),
+		Rules: rules,
+	}, nil
+}
+
+func (r *applicationHTTPEndpointConfigResource) mapRulesFromSchema(d *schema.ResourceData) ([]restapi.HTTPEndpointRule, error) {
+	rawRules := d.Get(ApplicationHTTPEndpointConfigFieldRule).([]interface{})
+	result := make([]restapi.HTTPEndpointRule, len(rawRules))
+	for i, v := range rawRules {
+		rule := v.(map[string]interface{})
+		pathSegments, err := parseHTTPPathTemplate(rule[ApplicationHTTPEndpointConfigFieldRulePathTemplate].(string))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = restapi.HTTPEndpointRule{
+			Enabled:      rule[ApplicationHTTPEndpointConfigFieldRuleEnabled].(bool),
+			PathSegments: pathSegments,
+			TestCases:    ConvertInterfaceSlice[string](rule[ApplicationHTTPEndpointConfigFieldRuleTestCases].([]interface{})),
+		}
+	}
+	return result, nil
+}
+
+//parseHTTPPathTemplate converts a path template like /api/{id}/** into the path segments of the Instana API
+func parseHTTPPathTemplate(pathTemplate string) ([]restapi.HTTPPathSegmentMatchingRule, error) {
+	trimmed := strings.Trim(strings.TrimSpace(pathTemplate), httpPathTemplateSeparator)
+	if len(trimmed) == 0 {
+		return nil, errors.New("path template must contain at least one path segment")
+	}
+	elements := strings.Split(trimmed, httpPathTemplateSeparator)
+	segments := make([]restapi.HTTPPathSegmentMatchingRule, len(elements))
+	for i, element := range elements {
+		if len(element) == 0 {
+			return nil, errors.New("path template must not contain empty path segments")
+		}
+		if element == httpPathTemplateMatchAll {
+			segments[i] = restapi.HTTPPathSegmentMatchingRule{Type: restapi.HTTPPathSegmentMatchingTypeMatchAll}
+		} else if strings.HasPrefix(element, "{") && strings.HasSuffix(element, "}") {
+			name := element[1 : len(element)-1]
+			segments[i] = restapi.HTTPPathSegmentMatchingRule{Type: restapi.HTTPPathSegmentMatchingTypeParameter, Name: &name}
+		} else {
+			name := element
+			segments[i] = restapi.HTTPPathSegmentMatchingRule{Type: restapi.HTTPPathSegmentMatchingTypeFixed, Name: &name}
+		}
+	}
+	rule := restapi.HTTPEndpointRule{PathSegments: segments}
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+//renderHTTPPathTemplate converts the path segments of the Instana API into the path template representation
+func renderHTTPPathTemplate(segments []restapi.HTTPPathSegmentMatchingRule) (string, error) {
+	elements := make([]string, len(segments))
+	for i, segment := range segments {
+		if err := segment.Validate(); err != nil {
+			return "", err
+		}
+		switch segment.Type {
+		case restapi.HTTPPathSegmentMatchingTypeMatchAll:
+			elements[i] = httpPathTemplateMatchAll
+		case restapi.HTTPPathSegmentMatchingTypeParameter:
+			elements[i] = "{" + *segment.Name + "}"
+		default:
+			elements[i] = *segment.Name
+		}
+	}
+	return httpPathTemplateSeparator + strings.Join(elements, httpPathTemplateSeparator), nil
+}
+
+func normalizeHTTPPathTemplate(pathTemplate string) (string, error) {
+	segments, err := parseHTTPPathTemplate(pathTemplate)
+	if err != nil {
+		return "", err
+	}
+	return renderHTTPPathTemplate(segments)
+}
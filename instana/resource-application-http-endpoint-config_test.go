@@ -0,0 +1,315 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceApplicationHTTPEndpointConfigDefinitionTemplate = `
+resource "instana_application_http_endpoint_config" "example" {
+  service_id = "service-id"
+  endpoint_name_by_first_path_segment_rule_enabled = true
+
+  rule {
+    path_template = "api/{id}/**"
+    test_cases = [ "/api/%d/foo" ]
+  }
+
+  rule {
+    enabled = false
+    path_template = "/health"
+  }
+}
+`
+
+const (
+	testApplicationHTTPEndpointConfigDefinition = "instana_application_http_endpoint_config.example"
+	applicationHTTPEndpointConfigServiceID      = "service-id"
+	applicationHTTPEndpointConfigPathTemplate   = "/api/{id}/**"
+)
+
+func TestCRUDOfApplicationHTTPEndpointConfigResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForApplicationHTTPEndpointConfig()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createApplicationHTTPEndpointConfigResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testApplicationHTTPEndpointConfigDefinition),
+			createApplicationHTTPEndpointConfigResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testApplicationHTTPEndpointConfigDefinition),
+		},
+	})
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, restapi.ApplicationHTTPEndpointConfigResourcePath))
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPut, restapi.ApplicationHTTPEndpointConfigResourcePath+"/"+applicationHTTPEndpointConfigServiceID))
+}
+
+//createMockHttpServerForApplicationHTTPEndpointConfig creates a mock server which stores the HTTP endpoint configs by
+//service ID in memory. Configs are created via POST on the collection and updated via PUT on the service ID
+func createMockHttpServerForApplicationHTTPEndpointConfig() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	configs := make(map[string][]byte)
+	pathTemplate := restapi.ApplicationHTTPEndpointConfigResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	store := func(w http.ResponseWriter, r *http.Request, expectedServiceID *string) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		config := restapi.ApplicationHTTPEndpointConfig{}
+		if err := json.Unmarshal(body, &config); err != nil || config.ServiceID == "" || config.Rules == nil || (expectedServiceID != nil && *expectedServiceID != config.ServiceID) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		configs[config.ServiceID] = body
+		httpServer.WriteJSONResponse(w, body)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ApplicationHTTPEndpointConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		store(w, r, nil)
+	})
+	httpServer.AddRoute(http.MethodPut, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		serviceID := mux.Vars(r)["id"]
+		store(w, r, &serviceID)
+	})
+	httpServer.AddRoute(http.MethodGet, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		config, ok := configs[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, config)
+	})
+	httpServer.AddRoute(http.MethodDelete, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
+func createApplicationHTTPEndpointConfigResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceApplicationHTTPEndpointConfigDefinitionTemplate, iteration), httpPort)
+	ruleEnabled := fmt.Sprintf("%s.%d.%s", ApplicationHTTPEndpointConfigFieldRule, 0, ApplicationHTTPEndpointConfigFieldRuleEnabled)
+	rulePathTemplate := fmt.Sprintf("%s.%d.%s", ApplicationHTTPEndpointConfigFieldRule, 0, ApplicationHTTPEndpointConfigFieldRulePathTemplate)
+	ruleTestCase := fmt.Sprintf("%s.%d.%s.%d", ApplicationHTTPEndpointConfigFieldRule, 0, ApplicationHTTPEndpointConfigFieldRuleTestCases, 0)
+	secondRuleEnabled := fmt.Sprintf("%s.%d.%s", ApplicationHTTPEndpointConfigFieldRule, 1, ApplicationHTTPEndpointConfigFieldRuleEnabled)
+	secondRulePathTemplate := fmt.Sprintf("%s.%d.%s", ApplicationHTTPEndpointConfigFieldRule, 1, ApplicationHTTPEndpointConfigFieldRulePathTemplate)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, "id", applicationHTTPEndpointConfigServiceID),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, ApplicationHTTPEndpointConfigFieldServiceID, applicationHTTPEndpointConfigServiceID),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, falseAsString),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, ruleEnabled, trueAsString),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, rulePathTemplate, applicationHTTPEndpointConfigPathTemplate),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, ruleTestCase, fmt.Sprintf("/api/%d/foo", iteration)),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, secondRuleEnabled, falseAsString),
+			resource.TestCheckResourceAttr(testApplicationHTTPEndpointConfigDefinition, secondRulePathTemplate, "/health"),
+		),
+	}
+}
+
+func TestApplicationHTTPEndpointConfigSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewApplicationHTTPEndpointConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApplicationHTTPEndpointConfigFieldServiceID)
+	require.True(t, resourceSchema[ApplicationHTTPEndpointConfigFieldServiceID].ForceNew)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled, false)
+	require.Equal(t, schema.TypeList, resourceSchema[ApplicationHTTPEndpointConfigFieldRule].Type)
+	require.True(t, resourceSchema[ApplicationHTTPEndpointConfigFieldRule].Optional)
+
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema[ApplicationHTTPEndpointConfigFieldRule].Elem.(*schema.Resource).Schema, t)
+	ruleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ApplicationHTTPEndpointConfigFieldRuleEnabled, true)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ApplicationHTTPEndpointConfigFieldRulePathTemplate)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeListOfStrings(ApplicationHTTPEndpointConfigFieldRuleTestCases)
+}
+
+func TestShouldReturnCorrectResourceNameForApplicationHTTPEndpointConfigResource(t *testing.T) {
+	name := NewApplicationHTTPEndpointConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_application_http_endpoint_config", name)
+}
+
+func TestShouldUseServiceIDAsIDOfApplicationHTTPEndpointConfigResource(t *testing.T) {
+	metaData := NewApplicationHTTPEndpointConfigResourceHandle().MetaData()
+
+	require.True(t, metaData.SkipIDGeneration)
+	require.Equal(t, ApplicationHTTPEndpointConfigFieldServiceID, *metaData.ResourceIDField)
+}
+
+func TestApplicationHTTPEndpointConfigResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewApplicationHTTPEndpointConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldNormalizePathTemplateOfApplicationHTTPEndpointConfig(t *testing.T) {
+	pathTemplateSchema := NewApplicationHTTPEndpointConfigResourceHandle().MetaData().Schema[ApplicationHTTPEndpointConfigFieldRule].Elem.(*schema.Resource).Schema[ApplicationHTTPEndpointConfigFieldRulePathTemplate]
+
+	t.Run("Should return normalized path template in state when path template is valid", func(t *testing.T) {
+		require.Equal(t, applicationHTTPEndpointConfigPathTemplate, pathTemplateSchema.StateFunc(" api/{id}/**/ "))
+	})
+	t.Run("Should return provided path template in state when path template is not valid", func(t *testing.T) {
+		require.Equal(t, "/api//foo", pathTemplateSchema.StateFunc("/api//foo"))
+	})
+	t.Run("Should suppress diff when normalized path templates are equal", func(t *testing.T) {
+		require.True(t, pathTemplateSchema.DiffSuppressFunc(ApplicationHTTPEndpointConfigFieldRulePathTemplate, applicationHTTPEndpointConfigPathTemplate, "api/{id}/**", nil))
+	})
+	t.Run("Should not suppress diff when normalized path templates are not equal", func(t *testing.T) {
+		require.False(t, pathTemplateSchema.DiffSuppressFunc(ApplicationHTTPEndpointConfigFieldRulePathTemplate, applicationHTTPEndpointConfigPathTemplate, "/api/{id}", nil))
+	})
+	t.Run("Should not suppress diff when new path template is not valid", func(t *testing.T) {
+		require.False(t, pathTemplateSchema.DiffSuppressFunc(ApplicationHTTPEndpointConfigFieldRulePathTemplate, applicationHTTPEndpointConfigPathTemplate, "/**/api", nil))
+	})
+}
+
+func TestShouldValidatePathTemplateOfApplicationHTTPEndpointConfig(t *testing.T) {
+	pathTemplateSchema := NewApplicationHTTPEndpointConfigResourceHandle().MetaData().Schema[ApplicationHTTPEndpointConfigFieldRule].Elem.(*schema.Resource).Schema[ApplicationHTTPEndpointConfigFieldRulePathTemplate]
+
+	for _, pathTemplate := range []string{"/api", "/api/{id}", "/**", "api/{id}/**"} {
+		t.Run(fmt.Sprintf("Should accept valid path template %s", pathTemplate), func(t *testing.T) {
+			warns, errs := pathTemplateSchema.ValidateFunc(pathTemplate, ApplicationHTTPEndpointConfigFieldRulePathTemplate)
+
+			require.Empty(t, warns)
+			require.Empty(t, errs)
+		})
+	}
+	for _, pathTemplate := range []string{"", "/", "/api//foo", "/**/api", "/api/{}"} {
+		t.Run(fmt.Sprintf("Should reject invalid path template '%s'", pathTemplate), func(t *testing.T) {
+			warns, errs := pathTemplateSchema.ValidateFunc(pathTemplate, ApplicationHTTPEndpointConfigFieldRulePathTemplate)
+
+			require.Empty(t, warns)
+			require.Len(t, errs, 1)
+		})
+	}
+}
+
+func TestShouldUpdateApplicationHTTPEndpointConfigTerraformResourceStateFromModel(t *testing.T) {
+	config := createTestApplicationHTTPEndpointConfigModel()
+
+	testHelper := NewTestHelper(t)
+	sut := NewApplicationHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, applicationHTTPEndpointConfigServiceID, resourceData.Id())
+	require.Equal(t, applicationHTTPEndpointConfigServiceID, resourceData.Get(ApplicationHTTPEndpointConfigFieldServiceID))
+	require.True(t, resourceData.Get(ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled).(bool))
+	require.False(t, resourceData.Get(ApplicationHTTPEndpointConfigFieldEndpointNameByCollectedPathTemplateRuleEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			ApplicationHTTPEndpointConfigFieldRuleEnabled:      true,
+			ApplicationHTTPEndpointConfigFieldRulePathTemplate: applicationHTTPEndpointConfigPathTemplate,
+			ApplicationHTTPEndpointConfigFieldRuleTestCases:    []interface{}{"/api/1234/foo"},
+		},
+	}, resourceData.Get(ApplicationHTTPEndpointConfigFieldRule))
+}
+
+func TestShouldFailToUpdateApplicationHTTPEndpointConfigTerraformResourceStateFromModelWhenPathSegmentIsNotValid(t *testing.T) {
+	config := createTestApplicationHTTPEndpointConfigModel()
+	config.Rules[0].PathSegments[0] = restapi.HTTPPathSegmentMatchingRule{Type: restapi.HTTPPathSegmentMatchingTypeFixed}
+
+	testHelper := NewTestHelper(t)
+	sut := NewApplicationHTTPEndpointConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyConvertApplicationHTTPEndpointConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewApplicationHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(applicationHTTPEndpointConfigServiceID)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldServiceID, applicationHTTPEndpointConfigServiceID)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldEndpointNameByFirstPathSegmentRuleEnabled, true)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			ApplicationHTTPEndpointConfigFieldRuleEnabled:      true,
+			ApplicationHTTPEndpointConfigFieldRulePathTemplate: "api/{id}/**",
+			ApplicationHTTPEndpointConfigFieldRuleTestCases:    []interface{}{"/api/1234/foo"},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, createTestApplicationHTTPEndpointConfigModel(), result)
+}
+
+func TestShouldConvertApplicationHTTPEndpointConfigStateWithoutRulesToDataModelWithEmptyRules(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewApplicationHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldServiceID, applicationHTTPEndpointConfigServiceID)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.NotNil(t, result.(*restapi.ApplicationHTTPEndpointConfig).Rules)
+	require.Empty(t, result.(*restapi.ApplicationHTTPEndpointConfig).Rules)
+}
+
+func TestShouldFailToConvertApplicationHTTPEndpointConfigStateToDataModelWhenPathTemplateIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewApplicationHTTPEndpointConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldServiceID, applicationHTTPEndpointConfigServiceID)
+	resourceData.Set(ApplicationHTTPEndpointConfigFieldRule, []interface{}{
+		map[string]interface{}{
+			ApplicationHTTPEndpointConfigFieldRulePathTemplate: "/**/api",
+		},
+	})
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
+
+func createTestApplicationHTTPEndpointConfigModel() *restapi.ApplicationHTTPEndpointConfig {
+	return &restapi.ApplicationHTTPEndpointConfig{
+		ServiceID: applicationHTTPEndpointConfigServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: false,
+		Rules: []restapi.HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []restapi.HTTPPathSegmentMatchingRule{
+					{Type: restapi.HTTPPathSegmentMatchingTypeFixed, Name: utils.StringPtr("api")},
+					{Type: restapi.HTTPPathSegmentMatchingTypeParameter, Name: utils.StringPtr("id")},
+					{Type: restapi.HTTPPathSegmentMatchingTypeMatchAll},
+				},
+				TestCases: []string{"/api/1234/foo"},
+			},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//ResourceInstanaApplicationServiceConfigOrder the name of the terraform-provider-instana resource to manage the order of the service configs
+const ResourceInstanaApplicationServiceConfigOrder = "instana_application_service_config_order"
+
+//ServiceConfigOrderFieldServiceConfigIDs constant value for the schema field service_config_ids
+const ServiceConfigOrderFieldServiceConfigIDs = "service_config_ids"
+
+//ServiceConfigOrderServiceConfigIDs schema field definition of instana_application_service_config_order field service_config_ids
+var ServiceConfigOrderServiceConfigIDs = &schema.Schema{
+	Type:     schema.TypeList,
+	Required: true,
+	MinItems: 1,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+	Description: "The IDs of all service configs in the order of their precedence. The first service config has the highest precedence",
+}
+
+//NewApplicationServiceConfigOrderResourceHandle creates a new instance of the ResourceHandle for the order of the service configs.
+//The order exists exactly once per tenant. Therefore, the resource is pinned to the fixed ID of the order endpoint
+func NewApplicationServiceConfigOrderResourceHandle() ResourceHandle {
+	return &applicationServiceConfigOrderResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApplicationServiceConfigOrder,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigOrderFieldServiceConfigIDs: ServiceConfigOrderServiceConfigIDs,
+			},
+			SingletonID: restapi.ServiceConfigOrderPathElement,
+		},
+	}
+}
+
+type applicationServiceConfigOrderResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *applicationServiceConfigOrderResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *applicationServiceConfigOrderResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *applicationServiceConfigOrderResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.ServiceConfigOrder()
+}
+
+func (r *applicationServiceConfigOrderResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *applicationServiceConfigOrderResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	order := obj.(*restapi.ServiceConfigOrder)
+	d.Set(ServiceConfigOrderFieldServiceConfigIDs, order.ServiceConfigIDs)
+	d.SetId(order.ID)
+	return nil
+}
+
+func (r *applicationServiceConfigOrderResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.ServiceConfigOrder{
+		ID:               d.Id(),
+		ServiceConfigIDs: ReadStringArrayParameterFromResource(d, ServiceConfigOrderFieldServiceConfigIDs),
+	}, nil
+}
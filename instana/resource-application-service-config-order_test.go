@@ -0,0 +1,137 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceApplicationServiceConfigOrderDefinitionTemplate = `
+resource "instana_application_service_config_order" "example" {
+  service_config_ids = [ %s ]
+}
+`
+
+const testApplicationServiceConfigOrderDefinition = "instana_application_service_config_order.example"
+
+func TestCRUDOfApplicationServiceConfigOrderResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForServiceConfigOrder()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createApplicationServiceConfigOrderResourceTestStep(httpServer.GetPort(), "id1", "id2", "id3"),
+			testStepImport(testApplicationServiceConfigOrderDefinition),
+			createApplicationServiceConfigOrderResourceTestStep(httpServer.GetPort(), "id3", "id1", "id2"),
+			testStepImport(testApplicationServiceConfigOrderDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForServiceConfigOrder creates a mock server which returns the service configs in the order of the last request to the order endpoint
+func createMockHttpServerForServiceConfigOrder() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	order := []string{"id1", "id2", "id3"}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, restapi.ServiceConfigOrderResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		newOrder := make([]string, 0)
+		if err := json.Unmarshal(body, &newOrder); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		order = newOrder
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.ServiceConfigsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		serviceConfigs := make([]restapi.ServiceConfig, len(order))
+		for i, id := range order {
+			serviceConfigs[i] = restapi.ServiceConfig{ID: id, Name: id, Label: id, Enabled: true}
+		}
+		data, _ := json.Marshal(serviceConfigs)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	return httpServer
+}
+
+func createApplicationServiceConfigOrderResourceTestStep(httpPort int, ids ...string) resource.TestStep {
+	quotedIDs := make([]string, len(ids))
+	checks := make([]resource.TestCheckFunc, len(ids)+1)
+	checks[0] = resource.TestCheckResourceAttr(testApplicationServiceConfigOrderDefinition, "id", restapi.ServiceConfigOrderPathElement)
+	for i, id := range ids {
+		quotedIDs[i] = fmt.Sprintf("\"%s\"", id)
+		checks[i+1] = resource.TestCheckResourceAttr(testApplicationServiceConfigOrderDefinition, fmt.Sprintf("%s.%d", ServiceConfigOrderFieldServiceConfigIDs, i), id)
+	}
+	config := appendProviderConfig(fmt.Sprintf(resourceApplicationServiceConfigOrderDefinitionTemplate, strings.Join(quotedIDs, ", ")), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check:  resource.ComposeTestCheckFunc(checks...),
+	}
+}
+
+func TestApplicationServiceConfigOrderSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewApplicationServiceConfigOrderResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeListOfStrings(ServiceConfigOrderFieldServiceConfigIDs)
+	require.Equal(t, 1, resourceSchema[ServiceConfigOrderFieldServiceConfigIDs].MinItems)
+}
+
+func TestShouldReturnCorrectResourceNameForApplicationServiceConfigOrderResource(t *testing.T) {
+	name := NewApplicationServiceConfigOrderResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_application_service_config_order", name)
+}
+
+func TestApplicationServiceConfigOrderResourceShouldBeASingletonWithSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewApplicationServiceConfigOrderResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.Equal(t, restapi.ServiceConfigOrderPathElement, resourceHandle.MetaData().SingletonID)
+}
+
+func TestShouldUpdateApplicationServiceConfigOrderTerraformResourceStateFromModel(t *testing.T) {
+	order := &restapi.ServiceConfigOrder{ID: restapi.ServiceConfigOrderPathElement, ServiceConfigIDs: []string{"id2", "id1"}}
+
+	testHelper := NewTestHelper(t)
+	sut := NewApplicationServiceConfigOrderResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, order, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.ServiceConfigOrderPathElement, resourceData.Id())
+	require.Equal(t, []interface{}{"id2", "id1"}, resourceData.Get(ServiceConfigOrderFieldServiceConfigIDs))
+}
+
+func TestShouldSuccessfullyConvertApplicationServiceConfigOrderStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewApplicationServiceConfigOrderResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.ServiceConfigOrderPathElement)
+	resourceData.Set(ServiceConfigOrderFieldServiceConfigIDs, []interface{}{"id2", "id1"})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ServiceConfigOrder{ID: restapi.ServiceConfigOrderPathElement, ServiceConfigIDs: []string{"id2", "id1"}}, result)
+}
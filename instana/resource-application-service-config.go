@@ -0,0 +1,179 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaApplicationServiceConfig the name of the terraform-provider-instana resource to manage service configs (custom service naming rules)
+const ResourceInstanaApplicationServiceConfig = "instana_application_service_config"
+
+const (
+	//ServiceConfigFieldName constant value for the schema field name
+	ServiceConfigFieldName = "name"
+	//ServiceConfigFieldFullName constant value for the schema field full_name
+	ServiceConfigFieldFullName = "full_name"
+	//ServiceConfigFieldLabel constant value for the schema field label
+	ServiceConfigFieldLabel = "label"
+	//ServiceConfigFieldComment constant value for the schema field comment
+	ServiceConfigFieldComment = "comment"
+	//ServiceConfigFieldEnabled constant value for the schema field enabled
+	ServiceConfigFieldEnabled = "enabled"
+	//ServiceConfigFieldMatchSpecification constant value for the schema field match_specification
+	ServiceConfigFieldMatchSpecification = "match_specification"
+	//ServiceConfigFieldMatchSpecificationKey constant value for the schema field match_specification.key
+	ServiceConfigFieldMatchSpecificationKey = "key"
+	//ServiceConfigFieldMatchSpecificationValue constant value for the schema field match_specification.value
+	ServiceConfigFieldMatchSpecificationValue = "value"
+)
+
+var (
+	//ServiceConfigName schema field definition of instana_application_service_config field name
+	ServiceConfigName = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+		Description:  "The name of the service config",
+	}
+	//ServiceConfigFullName schema field definition of instana_application_service_config field full_name
+	ServiceConfigFullName = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The full name of the service config. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+	}
+	//ServiceConfigLabel schema field definition of instana_application_service_config field label
+	ServiceConfigLabel = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The template of the service name. Tags can be referenced in curly braces, e.g. {docker.label.app}-{kubernetes.namespace}",
+	}
+	//ServiceConfigComment schema field definition of instana_application_service_config field comment
+	ServiceConfigComment = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(0, 2048),
+		Description:  "The comment of the service config",
+	}
+	//ServiceConfigEnabled schema field definition of instana_application_service_config field enabled
+	ServiceConfigEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Flag to indicate if the service config is enabled",
+	}
+	//ServiceConfigMatchSpecification schema field definition of instana_application_service_config field match_specification
+	ServiceConfigMatchSpecification = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    restapi.MaxServiceMatchingRules,
+		Description: "The tags which must match so that the service config is applied",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ServiceConfigFieldMatchSpecificationKey: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The key of the tag",
+				},
+				ServiceConfigFieldMatchSpecificationValue: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The value of the tag. Use * to match any value",
+				},
+			},
+		},
+	}
+)
+
+//NewApplicationServiceConfigResourceHandle creates a new instance of the ResourceHandle for service configs
+func NewApplicationServiceConfigResourceHandle() ResourceHandle {
+	return &applicationServiceConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaApplicationServiceConfig,
+			Schema: map[string]*schema.Schema{
+				ServiceConfigFieldName:               ServiceConfigName,
+				ServiceConfigFieldFullName:           ServiceConfigFullName,
+				ServiceConfigFieldLabel:              ServiceConfigLabel,
+				ServiceConfigFieldComment:            ServiceConfigComment,
+				ServiceConfigFieldEnabled:            ServiceConfigEnabled,
+				ServiceConfigFieldMatchSpecification: ServiceConfigMatchSpecification,
+			},
+		},
+	}
+}
+
+type applicationServiceConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *applicationServiceConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *applicationServiceConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *applicationServiceConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.ServiceConfigs()
+}
+
+func (r *applicationServiceConfigResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *applicationServiceConfigResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.ServiceConfig)
+
+	d.Set(ServiceConfigFieldName, formatter.UndoFormat(config.Name))
+	d.Set(ServiceConfigFieldFullName, config.Name)
+	d.Set(ServiceConfigFieldLabel, config.Label)
+	d.Set(ServiceConfigFieldComment, config.Comment)
+	d.Set(ServiceConfigFieldEnabled, config.Enabled)
+	d.Set(ServiceConfigFieldMatchSpecification, r.mapMatchSpecificationToSchema(config.MatchSpecification))
+	d.SetId(config.ID)
+	return nil
+}
+
+func (r *applicationServiceConfigResource) mapMatchSpecificationToSchema(rules []restapi.ServiceMatchingRule) []map[string]string {
+	result := make([]map[string]string, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]string{
+			ServiceConfigFieldMatchSpecificationKey:   rule.Key,
+			ServiceConfigFieldMatchSpecificationValue: rule.Value,
+		}
+	}
+	return result
+}
+
+func (r *applicationServiceConfigResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.ServiceConfig{
+		ID:                 d.Id(),
+		Name:               r.computeFullNameString(d, formatter),
+		Label:              d.Get(ServiceConfigFieldLabel).(string),
+		Comment:            GetStringPointerFromResourceData(d, ServiceConfigFieldComment),
+		Enabled:            d.Get(ServiceConfigFieldEnabled).(bool),
+		MatchSpecification: r.mapMatchSpecificationFromSchema(d),
+	}, nil
+}
+
+func (r *applicationServiceConfigResource) mapMatchSpecificationFromSchema(d *schema.ResourceData) []restapi.ServiceMatchingRule {
+	rawRules := d.Get(ServiceConfigFieldMatchSpecification).([]interface{})
+	result := make([]restapi.ServiceMatchingRule, len(rawRules))
+	for i, v := range rawRules {
+		rule := v.(map[string]interface{})
+		result[i] = restapi.ServiceMatchingRule{
+			Key:   rule[ServiceConfigFieldMatchSpecificationKey].(string),
+			Value: rule[ServiceConfigFieldMatchSpecificationValue].(string),
+		}
+	}
+	return result
+}
+
+func (r *applicationServiceConfigResource) computeFullNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(ServiceConfigFieldName) {
+		return formatter.Format(d.Get(ServiceConfigFieldName).(string))
+	}
+	return d.Get(ServiceConfigFieldFullName).(string)
+}
@@ -0,0 +1,171 @@
+package instana_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceApplicationServiceConfigDefinitionTemplate = `
+resource "instana_application_service_config" "example" {
+  name    = "name %d"
+  label   = "{docker.label.app}-{kubernetes.namespace}"
+  comment = "comment"
+
+  match_specification {
+    key   = "docker.label.app"
+    value = "*"
+  }
+
+  match_specification {
+    key   = "kubernetes.namespace"
+    value = "*"
+  }
+}
+`
+
+const (
+	testApplicationServiceConfigDefinition = "instana_application_service_config.example"
+	applicationServiceConfigID             = "service-config-id"
+	applicationServiceConfigLabel          = "{docker.label.app}-{kubernetes.namespace}"
+)
+
+func TestCRUDOfApplicationServiceConfigResourceWithMockServer(t *testing.T) {
+	httpServer := createInMemoryMockHttpServerForResource(restapi.ServiceConfigsResourcePath, "id")
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createApplicationServiceConfigResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testApplicationServiceConfigDefinition),
+			createApplicationServiceConfigResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testApplicationServiceConfigDefinition),
+		},
+	})
+	require.Equal(t, 1, httpServer.GetCallCount(http.MethodPost, restapi.ServiceConfigsResourcePath))
+}
+
+func createApplicationServiceConfigResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceApplicationServiceConfigDefinitionTemplate, iteration), httpPort)
+	matchSpecificationKey := fmt.Sprintf("%s.%d.%s", ServiceConfigFieldMatchSpecification, 1, ServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationValue := fmt.Sprintf("%s.%d.%s", ServiceConfigFieldMatchSpecification, 1, ServiceConfigFieldMatchSpecificationValue)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(testApplicationServiceConfigDefinition, "id"),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, ServiceConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, ServiceConfigFieldFullName, formatResourceFullName(iteration)),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, ServiceConfigFieldLabel, applicationServiceConfigLabel),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, ServiceConfigFieldComment, "comment"),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, ServiceConfigFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, matchSpecificationKey, "kubernetes.namespace"),
+			resource.TestCheckResourceAttr(testApplicationServiceConfigDefinition, matchSpecificationValue, "*"),
+		),
+	}
+}
+
+func TestApplicationServiceConfigSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewApplicationServiceConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(ServiceConfigFieldFullName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldLabel)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ServiceConfigFieldComment)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ServiceConfigFieldEnabled, true)
+	require.Equal(t, schema.TypeList, resourceSchema[ServiceConfigFieldMatchSpecification].Type)
+	require.True(t, resourceSchema[ServiceConfigFieldMatchSpecification].Optional)
+	require.Equal(t, 20, resourceSchema[ServiceConfigFieldMatchSpecification].MaxItems)
+
+	matchSpecificationSchemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema[ServiceConfigFieldMatchSpecification].Elem.(*schema.Resource).Schema, t)
+	matchSpecificationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecificationKey)
+	matchSpecificationSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ServiceConfigFieldMatchSpecificationValue)
+}
+
+func TestShouldReturnCorrectResourceNameForApplicationServiceConfigResource(t *testing.T) {
+	name := NewApplicationServiceConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_application_service_config", name)
+}
+
+func TestApplicationServiceConfigResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewApplicationServiceConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldUpdateApplicationServiceConfigTerraformResourceStateFromModel(t *testing.T) {
+	fullName := "prefix name suffix"
+	config := &restapi.ServiceConfig{
+		ID:      applicationServiceConfigID,
+		Name:    fullName,
+		Label:   applicationServiceConfigLabel,
+		Comment: utils.StringPtr("comment"),
+		Enabled: false,
+		MatchSpecification: []restapi.ServiceMatchingRule{
+			{Key: "docker.label.app", Value: "*"},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewApplicationServiceConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, applicationServiceConfigID, resourceData.Id())
+	require.Equal(t, "name", resourceData.Get(ServiceConfigFieldName))
+	require.Equal(t, fullName, resourceData.Get(ServiceConfigFieldFullName))
+	require.Equal(t, applicationServiceConfigLabel, resourceData.Get(ServiceConfigFieldLabel))
+	require.Equal(t, "comment", resourceData.Get(ServiceConfigFieldComment))
+	require.False(t, resourceData.Get(ServiceConfigFieldEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			ServiceConfigFieldMatchSpecificationKey:   "docker.label.app",
+			ServiceConfigFieldMatchSpecificationValue: "*",
+		},
+	}, resourceData.Get(ServiceConfigFieldMatchSpecification))
+}
+
+func TestShouldSuccessfullyConvertApplicationServiceConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewApplicationServiceConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(applicationServiceConfigID)
+	resourceData.Set(ServiceConfigFieldFullName, "prefix name suffix")
+	resourceData.Set(ServiceConfigFieldLabel, applicationServiceConfigLabel)
+	resourceData.Set(ServiceConfigFieldEnabled, true)
+	resourceData.Set(ServiceConfigFieldMatchSpecification, []interface{}{
+		map[string]interface{}{
+			ServiceConfigFieldMatchSpecificationKey:   "docker.label.app",
+			ServiceConfigFieldMatchSpecificationValue: "*",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ServiceConfig{
+		ID:      applicationServiceConfigID,
+		Name:    "prefix name suffix",
+		Label:   applicationServiceConfigLabel,
+		Enabled: true,
+		MatchSpecification: []restapi.ServiceMatchingRule{
+			{Key: "docker.label.app", Value: "*"},
+		},
+	}, result)
+}
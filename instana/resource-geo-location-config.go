@@ -0,0 +1,94 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaWebsiteGeoLocationConfig the name of the terraform-provider-instana resource to manage the geo location configuration of websites
+const ResourceInstanaWebsiteGeoLocationConfig = "instana_website_geo_location_config"
+
+//ResourceInstanaMobileAppGeoLocationConfig the name of the terraform-provider-instana resource to manage the geo location configuration of mobile apps
+const ResourceInstanaMobileAppGeoLocationConfig = "instana_mobile_app_geo_location_config"
+
+//GeoLocationConfigFieldGeoDetailRemoval constant value for the schema field geo_detail_removal
+const GeoLocationConfigFieldGeoDetailRemoval = "geo_detail_removal"
+
+//GeoLocationConfigGeoDetailRemoval schema field definition of the field geo_detail_removal of geo location configurations
+var GeoLocationConfigGeoDetailRemoval = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice(restapi.SupportedGeoDetailRemovals.ToStringSlice(), false),
+	Description:  "The geo location details which are removed from the beacons",
+}
+
+//NewWebsiteGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of websites
+func NewWebsiteGeoLocationConfigResourceHandle() ResourceHandle {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaWebsiteGeoLocationConfig, WebsiteMonitoringSettingsFieldWebsiteID, WebsiteMonitoringSettingsWebsiteID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.WebsiteGeoLocationConfiguration()
+	})
+}
+
+//NewMobileAppGeoLocationConfigResourceHandle creates the resource handle for the geo location configuration of mobile apps
+func NewMobileAppGeoLocationConfigResourceHandle() ResourceHandle {
+	return newGeoLocationConfigResourceHandle(ResourceInstanaMobileAppGeoLocationConfig, MobileAppSettingsFieldMobileAppID, MobileAppSettingsMobileAppID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.MobileAppGeoLocationConfiguration()
+	})
+}
+
+//newGeoLocationConfigResourceHandle creates a resource handle for the geo location configuration of the monitoring
+//config which is referenced by the given field (website or mobile app). The custom geo mapping rules are not managed by
+//this resource. Deleting the resource resets the configuration to the default.
+func newGeoLocationConfigResourceHandle(resourceName string, monitoringConfigIDField string, monitoringConfigIDSchema *schema.Schema, restResource func(api restapi.InstanaAPI) restapi.RestResource) ResourceHandle {
+	return &geoLocationConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: resourceName,
+			Schema: map[string]*schema.Schema{
+				monitoringConfigIDField:                monitoringConfigIDSchema,
+				GeoLocationConfigFieldGeoDetailRemoval: GeoLocationConfigGeoDetailRemoval,
+			},
+			SkipIDGeneration: true,
+		},
+		monitoringConfigIDField: monitoringConfigIDField,
+		restResource:            restResource,
+	}
+}
+
+type geoLocationConfigResource struct {
+	metaData                ResourceMetaData
+	monitoringConfigIDField string
+	restResource            func(api restapi.InstanaAPI) restapi.RestResource
+}
+
+func (r *geoLocationConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *geoLocationConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *geoLocationConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return r.restResource(api)
+}
+
+func (r *geoLocationConfigResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *geoLocationConfigResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.GeoLocationConfiguration)
+	d.Set(r.monitoringConfigIDField, config.MonitoringConfigID)
+	d.Set(GeoLocationConfigFieldGeoDetailRemoval, string(config.GeoDetailRemoval))
+	d.SetId(config.MonitoringConfigID)
+	return nil
+}
+
+func (r *geoLocationConfigResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.GeoLocationConfiguration{
+		MonitoringConfigID: d.Get(r.monitoringConfigIDField).(string),
+		GeoDetailRemoval:   restapi.GeoDetailRemoval(d.Get(GeoLocationConfigFieldGeoDetailRemoval).(string)),
+	}, nil
+}
@@ -0,0 +1,155 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceWebsiteGeoLocationConfigDefinitionTemplate = `
+resource "instana_website_geo_location_config" "example" {
+  website_id         = "%s"
+  geo_detail_removal = "%s"
+}
+`
+
+const testWebsiteGeoLocationConfigDefinition = "instana_website_geo_location_config.example"
+
+func TestCRUDOfWebsiteGeoLocationConfigResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForWebsiteGeoLocationConfig()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteGeoLocationConfigResourceTestStep(httpServer.GetPort(), restapi.GeoDetailRemovalRemoveCoordinates),
+			testStepImport(testWebsiteGeoLocationConfigDefinition),
+			createWebsiteGeoLocationConfigResourceTestStep(httpServer.GetPort(), restapi.GeoDetailRemovalRemoveAll),
+			testStepImport(testWebsiteGeoLocationConfigDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForWebsiteGeoLocationConfig creates a mock server which keeps the geo location configuration of the test website in memory
+func createMockHttpServerForWebsiteGeoLocationConfig() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	config := []byte(`{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":[]}`)
+	path := restapi.WebsiteMonitoringConfigResourcePath + "/" + testWebsiteID + "/" + restapi.GeoLocationConfigurationPathElement
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		httpServer.WriteJSONResponse(w, config)
+	})
+	httpServer.AddRoute(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if !json.Valid(body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		config = body
+		httpServer.WriteJSONResponse(w, config)
+	})
+	return httpServer
+}
+
+func createWebsiteGeoLocationConfigResourceTestStep(httpPort int, removal restapi.GeoDetailRemoval) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceWebsiteGeoLocationConfigDefinitionTemplate, testWebsiteID, removal), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testWebsiteGeoLocationConfigDefinition, "id", testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteGeoLocationConfigDefinition, WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteGeoLocationConfigDefinition, GeoLocationConfigFieldGeoDetailRemoval, string(removal)),
+		),
+	}
+}
+
+func TestWebsiteGeoLocationConfigSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewWebsiteGeoLocationConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringSettingsFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GeoLocationConfigFieldGeoDetailRemoval)
+	require.True(t, resourceSchema[WebsiteMonitoringSettingsFieldWebsiteID].ForceNew)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteGeoLocationConfigResource(t *testing.T) {
+	name := NewWebsiteGeoLocationConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_website_geo_location_config", name)
+}
+
+func TestWebsiteGeoLocationConfigResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewWebsiteGeoLocationConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateWebsiteGeoLocationConfigTerraformResourceStateFromModel(t *testing.T) {
+	config := &restapi.GeoLocationConfiguration{MonitoringConfigID: testWebsiteID, GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCity, GeoMappingRules: json.RawMessage(`[]`)}
+
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteGeoLocationConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, testWebsiteID, resourceData.Id())
+	require.Equal(t, testWebsiteID, resourceData.Get(WebsiteMonitoringSettingsFieldWebsiteID))
+	require.Equal(t, "REMOVE_CITY", resourceData.Get(GeoLocationConfigFieldGeoDetailRemoval))
+}
+
+func TestShouldSuccessfullyConvertWebsiteGeoLocationConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewWebsiteGeoLocationConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID)
+	resourceData.Set(GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_ALL")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoLocationConfiguration{MonitoringConfigID: testWebsiteID, GeoDetailRemoval: restapi.GeoDetailRemovalRemoveAll}, result)
+}
+
+func TestShouldReturnCorrectResourceNameAndSchemaForMobileAppGeoLocationConfigResource(t *testing.T) {
+	metaData := NewMobileAppGeoLocationConfigResourceHandle().MetaData()
+
+	require.Equal(t, "instana_mobile_app_geo_location_config", metaData.ResourceName)
+	schemaAssert := testutils.NewTerraformSchemaAssert(metaData.Schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppSettingsFieldMobileAppID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GeoLocationConfigFieldGeoDetailRemoval)
+	require.NotContains(t, metaData.Schema, WebsiteMonitoringSettingsFieldWebsiteID)
+}
+
+func TestShouldSuccessfullyConvertMobileAppGeoLocationConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMobileAppGeoLocationConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(MobileAppSettingsFieldMobileAppID, "mobile-app-id")
+	resourceData.Set(GeoLocationConfigFieldGeoDetailRemoval, "REMOVE_CITY")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoLocationConfiguration{MonitoringConfigID: "mobile-app-id", GeoDetailRemoval: restapi.GeoDetailRemovalRemoveCity}, result)
+}
@@ -0,0 +1,319 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaWebsiteGeoMappingRules the name of the terraform-provider-instana resource to manage the custom geo mapping rules of websites
+const ResourceInstanaWebsiteGeoMappingRules = "instana_website_geo_mapping_rules"
+
+//ResourceInstanaMobileAppGeoMappingRules the name of the terraform-provider-instana resource to manage the custom geo mapping rules of mobile apps
+const ResourceInstanaMobileAppGeoMappingRules = "instana_mobile_app_geo_mapping_rules"
+
+const (
+	//GeoMappingRulesFieldRule constant value for the schema field rule
+	GeoMappingRulesFieldRule = "rule"
+	//GeoMappingRulesFieldRuleCIDR constant value for the schema field rule.cidr
+	GeoMappingRulesFieldRuleCIDR = "cidr"
+	//GeoMappingRulesFieldRuleLatitude constant value for the schema field rule.latitude
+	GeoMappingRulesFieldRuleLatitude = "latitude"
+	//GeoMappingRulesFieldRuleLongitude constant value for the schema field rule.longitude
+	GeoMappingRulesFieldRuleLongitude = "longitude"
+	//GeoMappingRulesFieldRuleAccuracyRadius constant value for the schema field rule.accuracy_radius
+	GeoMappingRulesFieldRuleAccuracyRadius = "accuracy_radius"
+	//GeoMappingRulesFieldRuleCity constant value for the schema field rule.city
+	GeoMappingRulesFieldRuleCity = "city"
+	//GeoMappingRulesFieldRuleSubdivision constant value for the schema field rule.subdivision
+	GeoMappingRulesFieldRuleSubdivision = "subdivision"
+	//GeoMappingRulesFieldRuleSubdivisionCode constant value for the schema field rule.subdivision.code
+	GeoMappingRulesFieldRuleSubdivisionCode = "code"
+	//GeoMappingRulesFieldRuleSubdivisionName constant value for the schema field rule.subdivision.name
+	GeoMappingRulesFieldRuleSubdivisionName = "name"
+	//GeoMappingRulesFieldRuleCountryCode constant value for the schema field rule.country_code
+	GeoMappingRulesFieldRuleCountryCode = "country_code"
+	//GeoMappingRulesFieldRuleCountry constant value for the schema field rule.country
+	GeoMappingRulesFieldRuleCountry = "country"
+	//GeoMappingRulesFieldRuleContinentCode constant value for the schema field rule.continent_code
+	GeoMappingRulesFieldRuleContinentCode = "continent_code"
+	//GeoMappingRulesFieldRuleContinent constant value for the schema field rule.continent
+	GeoMappingRulesFieldRuleContinent = "continent"
+)
+
+func newOptionalGeoMappingRuleStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+//GeoMappingRulesRuleSubdivision schema field definition of the field rule.subdivision of geo mapping rules
+var GeoMappingRulesRuleSubdivision = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MinItems: 0,
+	MaxItems: restapi.MaxGeoSubdivisions,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			GeoMappingRulesFieldRuleSubdivisionCode: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 32),
+				Description:  "The code of the subdivision",
+			},
+			GeoMappingRulesFieldRuleSubdivisionName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				Description:  "The name of the subdivision",
+			},
+		},
+	},
+	Description: "The subdivisions (e.g. state or province) of the location in the order of the Instana API",
+}
+
+//GeoMappingRulesRule schema field definition of the field rule of geo mapping rules
+var GeoMappingRulesRule = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MinItems: 0,
+	MaxItems: restapi.MaxGeoMappingRules,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			GeoMappingRulesFieldRuleCIDR: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The IP range in CIDR notation (e.g. 10.0.0.0/8) which is mapped to the location",
+			},
+			GeoMappingRulesFieldRuleLatitude: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-90, 90),
+				Description:  "The latitude of the location",
+			},
+			GeoMappingRulesFieldRuleLongitude: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(-180, 180),
+				Description:  "The longitude of the location",
+			},
+			GeoMappingRulesFieldRuleAccuracyRadius: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "The accuracy radius of the location in kilometers",
+			},
+			GeoMappingRulesFieldRuleCity:          newOptionalGeoMappingRuleStringSchema("The name of the city"),
+			GeoMappingRulesFieldRuleSubdivision:   GeoMappingRulesRuleSubdivision,
+			GeoMappingRulesFieldRuleCountryCode:   newOptionalGeoMappingRuleStringSchema("The ISO code of the country"),
+			GeoMappingRulesFieldRuleCountry:       newOptionalGeoMappingRuleStringSchema("The name of the country"),
+			GeoMappingRulesFieldRuleContinentCode: newOptionalGeoMappingRuleStringSchema("The code of the continent"),
+			GeoMappingRulesFieldRuleContinent:     newOptionalGeoMappingRuleStringSchema("The name of the continent"),
+		},
+	},
+	Description: "The custom geo mapping rules in the order of their precedence. The rules map IP ranges to locations, e.g. for internal networks",
+}
+
+//NewWebsiteGeoMappingRulesResourceHandle creates the resource handle for the custom geo mapping rules of websites
+func NewWebsiteGeoMappingRulesResourceHandle() ResourceHandle {
+	return newGeoMappingRulesResourceHandle(ResourceInstanaWebsiteGeoMappingRules, WebsiteMonitoringSettingsFieldWebsiteID, WebsiteMonitoringSettingsWebsiteID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.WebsiteGeoMappingRules()
+	})
+}
+
+//NewMobileAppGeoMappingRulesResourceHandle creates the resource handle for the custom geo mapping rules of mobile apps
+func NewMobileAppGeoMappingRulesResourceHandle() ResourceHandle {
+	return newGeoMappingRulesResourceHandle(ResourceInstanaMobileAppGeoMappingRules, MobileAppSettingsFieldMobileAppID, MobileAppSettingsMobileAppID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.MobileAppGeoMappingRules()
+	})
+}
+
+//newGeoMappingRulesResourceHandle creates a resource handle for the custom geo mapping rules of the monitoring config
+//which is referenced by the given field (website or mobile app). The resource manages all rules of the monitoring
+//config. Deleting the resource removes all rules.
+func newGeoMappingRulesResourceHandle(resourceName string, monitoringConfigIDField string, monitoringConfigIDSchema *schema.Schema, restResource func(api restapi.InstanaAPI) restapi.RestResource) ResourceHandle {
+	return &geoMappingRulesResource{
+		metaData: ResourceMetaData{
+			ResourceName: resourceName,
+			Schema: map[string]*schema.Schema{
+				monitoringConfigIDField:  monitoringConfigIDSchema,
+				GeoMappingRulesFieldRule: GeoMappingRulesRule,
+			},
+			SkipIDGeneration: true,
+		},
+		monitoringConfigIDField: monitoringConfigIDField,
+		restResource:            restResource,
+	}
+}
+
+type geoMappingRulesResource struct {
+	metaData                ResourceMetaData
+	monitoringConfigIDField string
+	restResource            func(api restapi.InstanaAPI) restapi.RestResource
+}
+
+func (r *geoMappingRulesResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *geoMappingRulesResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *geoMappingRulesResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return r.restResource(api)
+}
+
+func (r *geoMappingRulesResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *geoMappingRulesResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	rules := obj.(*restapi.GeoMappingRules)
+	d.Set(r.monitoringConfigIDField, rules.MonitoringConfigID)
+	d.Set(GeoMappingRulesFieldRule, r.mapRulesToSchema(rules.Rules))
+	d.SetId(rules.MonitoringConfigID)
+	return nil
+}
+
+func (r *geoMappingRulesResource) mapRulesToSchema(rules []restapi.GeoMappingRule) []interface{} {
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:           rule.CIDR,
+			GeoMappingRulesFieldRuleLatitude:       r.dereferenceFloat(rule.Latitude),
+			GeoMappingRulesFieldRuleLongitude:      r.dereferenceFloat(rule.Longitude),
+			GeoMappingRulesFieldRuleAccuracyRadius: r.dereferenceInt(rule.AccuracyRadius),
+			GeoMappingRulesFieldRuleCity:           rule.City,
+			GeoMappingRulesFieldRuleSubdivision:    r.mapSubdivisionsToSchema(rule.Subdivisions),
+			GeoMappingRulesFieldRuleCountryCode:    rule.CountryCode,
+			GeoMappingRulesFieldRuleCountry:        rule.Country,
+			GeoMappingRulesFieldRuleContinentCode:  rule.ContinentCode,
+			GeoMappingRulesFieldRuleContinent:      rule.Continent,
+		}
+	}
+	return result
+}
+
+func (r *geoMappingRulesResource) mapSubdivisionsToSchema(subdivisions []restapi.GeoSubdivision) []interface{} {
+	result := make([]interface{}, len(subdivisions))
+	for i, subdivision := range subdivisions {
+		result[i] = map[string]interface{}{
+			GeoMappingRulesFieldRuleSubdivisionCode: subdivision.Code,
+			GeoMappingRulesFieldRuleSubdivisionName: subdivision.Name,
+		}
+	}
+	return result
+}
+
+func (r *geoMappingRulesResource) dereferenceFloat(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func (r *geoMappingRulesResource) dereferenceInt(value *int64) int {
+	if value == nil {
+		return 0
+	}
+	return int(*value)
+}
+
+func (r *geoMappingRulesResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.GeoMappingRules{
+		MonitoringConfigID: d.Get(r.monitoringConfigIDField).(string),
+		Rules:              r.mapRulesFromSchema(d.Get(GeoMappingRulesFieldRule).([]interface{}), d.GetRawConfig()),
+	}, nil
+}
+
+func (r *geoMappingRulesResource) mapRulesFromSchema(rawRules []interface{}, rawConfig cty.Value) []restapi.GeoMappingRule {
+	result := make([]restapi.GeoMappingRule, len(rawRules))
+	for i, v := range rawRules {
+		rule := v.(map[string]interface{})
+		rawRule := r.getRawRuleConfig(rawConfig, i)
+		result[i] = restapi.GeoMappingRule{
+			CIDR:           rule[GeoMappingRulesFieldRuleCIDR].(string),
+			Latitude:       r.toOptionalFloat(rule[GeoMappingRulesFieldRuleLatitude].(float64), r.isDefined(rawRule, GeoMappingRulesFieldRuleLatitude)),
+			Longitude:      r.toOptionalFloat(rule[GeoMappingRulesFieldRuleLongitude].(float64), r.isDefined(rawRule, GeoMappingRulesFieldRuleLongitude)),
+			AccuracyRadius: r.toOptionalInt(rule[GeoMappingRulesFieldRuleAccuracyRadius].(int), r.isDefined(rawRule, GeoMappingRulesFieldRuleAccuracyRadius)),
+			City:           rule[GeoMappingRulesFieldRuleCity].(string),
+			Subdivisions:   r.mapSubdivisionsFromSchema(rule[GeoMappingRulesFieldRuleSubdivision].([]interface{})),
+			CountryCode:    rule[GeoMappingRulesFieldRuleCountryCode].(string),
+			Country:        rule[GeoMappingRulesFieldRuleCountry].(string),
+			ContinentCode:  rule[GeoMappingRulesFieldRuleContinentCode].(string),
+			Continent:      rule[GeoMappingRulesFieldRuleContinent].(string),
+		}
+	}
+	return result
+}
+
+func (r *geoMappingRulesResource) mapSubdivisionsFromSchema(rawSubdivisions []interface{}) []restapi.GeoSubdivision {
+	if len(rawSubdivisions) == 0 {
+		return nil
+	}
+	result := make([]restapi.GeoSubdivision, len(rawSubdivisions))
+	for i, v := range rawSubdivisions {
+		subdivision := v.(map[string]interface{})
+		result[i] = restapi.GeoSubdivision{
+			Code: subdivision[GeoMappingRulesFieldRuleSubdivisionCode].(string),
+			Name: subdivision[GeoMappingRulesFieldRuleSubdivisionName].(string),
+		}
+	}
+	return result
+}
+
+//getRawRuleConfig returns the raw configuration of the rule with the given index. The raw configuration is required to
+//distinguish between optional numeric fields which are set to 0 and fields which are not defined. A null value is
+//returned when the raw configuration is not available (e.g. when the resource is deleted)
+func (r *geoMappingRulesResource) getRawRuleConfig(rawConfig cty.Value, index int) cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(GeoMappingRulesFieldRule) {
+		return cty.NilVal
+	}
+	rawRules := rawConfig.GetAttr(GeoMappingRulesFieldRule)
+	if rawRules.IsNull() || !rawRules.IsKnown() || !rawRules.CanIterateElements() || rawRules.LengthInt() <= index {
+		return cty.NilVal
+	}
+	return rawRules.Index(cty.NumberIntVal(int64(index)))
+}
+
+//isDefined returns true when the given field is defined in the raw configuration of the rule, false when it is not
+//defined and nil when the raw configuration is not available
+func (r *geoMappingRulesResource) isDefined(rawRule cty.Value, field string) *bool {
+	if rawRule == cty.NilVal || rawRule.IsNull() || !rawRule.IsKnown() || !rawRule.Type().IsObjectType() || !rawRule.Type().HasAttribute(field) {
+		return nil
+	}
+	defined := !rawRule.GetAttr(field).IsNull()
+	return &defined
+}
+
+//toOptionalFloat converts the given value to an optional value. When the raw configuration is not available, 0 is
+//treated as not defined
+func (r *geoMappingRulesResource) toOptionalFloat(value float64, defined *bool) *float64 {
+	if !r.isDefinedOrNotZero(defined, value == 0) {
+		return nil
+	}
+	return &value
+}
+
+//toOptionalInt converts the given value to an optional value. When the raw configuration is not available, 0 is
+//treated as not defined
+func (r *geoMappingRulesResource) toOptionalInt(value int, defined *bool) *int64 {
+	if !r.isDefinedOrNotZero(defined, value == 0) {
+		return nil
+	}
+	result := int64(value)
+	return &result
+}
+
+func (r *geoMappingRulesResource) isDefinedOrNotZero(defined *bool, isZero bool) bool {
+	if defined != nil {
+		return *defined
+	}
+	return !isZero
+}
@@ -0,0 +1,333 @@
+package instana_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceWebsiteGeoMappingRulesDefinitionTemplate = `
+resource "instana_website_geo_mapping_rules" "example" {
+  website_id = "%s"
+
+  rule {
+    cidr            = "10.0.0.0/8"
+    latitude        = 52.52
+    longitude       = 13.405
+    accuracy_radius = 10
+    city            = "city-%d"
+    country_code    = "DE"
+
+    subdivision {
+      code = "BY"
+      name = "Bavaria"
+    }
+
+    subdivision {
+      name = "Upper Bavaria"
+    }
+  }
+
+  rule {
+    cidr    = "192.168.0.0/16"
+    country = "United States, of America"
+  }
+}
+`
+
+const testWebsiteGeoMappingRulesDefinition = "instana_website_geo_mapping_rules.example"
+
+func TestCRUDOfWebsiteGeoMappingRulesResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForWebsiteGeoMappingRules()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteGeoMappingRulesResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testWebsiteGeoMappingRulesDefinition),
+			createWebsiteGeoMappingRulesResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testWebsiteGeoMappingRulesDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForWebsiteGeoMappingRules creates a mock server which keeps the CSV document of the geo mapping rules of the test website in memory
+func createMockHttpServerForWebsiteGeoMappingRules() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	rules := []byte{}
+	path := restapi.WebsiteMonitoringConfigResourcePath + "/" + testWebsiteID + "/" + restapi.GeoMappingRulesPathElement
+	writeRules := func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.WriteHeader(http.StatusOK)
+		w.Write(rules)
+	}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		writeRules(w)
+	})
+	httpServer.AddRoute(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		rules, _ = io.ReadAll(r.Body)
+		writeRules(w)
+	})
+	return httpServer
+}
+
+func createWebsiteGeoMappingRulesResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceWebsiteGeoMappingRulesDefinitionTemplate, testWebsiteID, iteration), httpPort)
+	ruleField := func(index int, field string) string {
+		return fmt.Sprintf("%s.%d.%s", GeoMappingRulesFieldRule, index, field)
+	}
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, "id", testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, GeoMappingRulesFieldRule+".#", "2"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleCIDR), "10.0.0.0/8"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleLatitude), "52.52"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleLongitude), "13.405"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleAccuracyRadius), "10"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleCity), fmt.Sprintf("city-%d", iteration)),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleCountryCode), "DE"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleSubdivision)+".#", "2"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleSubdivision)+".0."+GeoMappingRulesFieldRuleSubdivisionCode, "BY"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleSubdivision)+".0."+GeoMappingRulesFieldRuleSubdivisionName, "Bavaria"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleSubdivision)+".1."+GeoMappingRulesFieldRuleSubdivisionCode, ""),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(0, GeoMappingRulesFieldRuleSubdivision)+".1."+GeoMappingRulesFieldRuleSubdivisionName, "Upper Bavaria"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(1, GeoMappingRulesFieldRuleSubdivision)+".#", "0"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(1, GeoMappingRulesFieldRuleCIDR), "192.168.0.0/16"),
+			resource.TestCheckResourceAttr(testWebsiteGeoMappingRulesDefinition, ruleField(1, GeoMappingRulesFieldRuleCountry), "United States, of America"),
+		),
+	}
+}
+
+func TestWebsiteGeoMappingRulesSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewWebsiteGeoMappingRulesResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringSettingsFieldWebsiteID)
+	require.True(t, resourceSchema[WebsiteMonitoringSettingsFieldWebsiteID].ForceNew)
+	require.Equal(t, schema.TypeList, resourceSchema[GeoMappingRulesFieldRule].Type)
+	require.True(t, resourceSchema[GeoMappingRulesFieldRule].Optional)
+	require.Equal(t, restapi.MaxGeoMappingRules, resourceSchema[GeoMappingRulesFieldRule].MaxItems)
+
+	ruleSchema := resourceSchema[GeoMappingRulesFieldRule].Elem.(*schema.Resource).Schema
+	ruleSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchema, t)
+	ruleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GeoMappingRulesFieldRuleCIDR)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(GeoMappingRulesFieldRuleLatitude)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeFloat(GeoMappingRulesFieldRuleLongitude)
+	ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeInt(GeoMappingRulesFieldRuleAccuracyRadius)
+	for _, field := range []string{GeoMappingRulesFieldRuleCity, GeoMappingRulesFieldRuleCountryCode, GeoMappingRulesFieldRuleCountry, GeoMappingRulesFieldRuleContinentCode, GeoMappingRulesFieldRuleContinent} {
+		ruleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(field)
+	}
+	require.Equal(t, schema.TypeList, ruleSchema[GeoMappingRulesFieldRuleSubdivision].Type)
+	require.True(t, ruleSchema[GeoMappingRulesFieldRuleSubdivision].Optional)
+	require.Equal(t, restapi.MaxGeoSubdivisions, ruleSchema[GeoMappingRulesFieldRuleSubdivision].MaxItems)
+
+	subdivisionSchemaAssert := testutils.NewTerraformSchemaAssert(ruleSchema[GeoMappingRulesFieldRuleSubdivision].Elem.(*schema.Resource).Schema, t)
+	subdivisionSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(GeoMappingRulesFieldRuleSubdivisionCode)
+	subdivisionSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GeoMappingRulesFieldRuleSubdivisionName)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteGeoMappingRulesResource(t *testing.T) {
+	name := NewWebsiteGeoMappingRulesResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_website_geo_mapping_rules", name)
+}
+
+func TestWebsiteGeoMappingRulesResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewWebsiteGeoMappingRulesResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateWebsiteGeoMappingRulesTerraformResourceStateFromModel(t *testing.T) {
+	rules := &restapi.GeoMappingRules{
+		MonitoringConfigID: testWebsiteID,
+		Rules: []restapi.GeoMappingRule{
+			{CIDR: "10.0.0.0/8", Latitude: utils.Float64Ptr(52.52), Longitude: utils.Float64Ptr(13.405), AccuracyRadius: utils.Int64Ptr(10), City: "Berlin", Subdivisions: []restapi.GeoSubdivision{{Code: "BE", Name: "Berlin"}}, CountryCode: "DE", Country: "Germany", ContinentCode: "EU", Continent: "Europe"},
+			{CIDR: "192.168.0.0/16"},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteGeoMappingRulesResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, rules, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, testWebsiteID, resourceData.Id())
+	require.Equal(t, testWebsiteID, resourceData.Get(WebsiteMonitoringSettingsFieldWebsiteID))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:           "10.0.0.0/8",
+			GeoMappingRulesFieldRuleLatitude:       52.52,
+			GeoMappingRulesFieldRuleLongitude:      13.405,
+			GeoMappingRulesFieldRuleAccuracyRadius: 10,
+			GeoMappingRulesFieldRuleCity:           "Berlin",
+			GeoMappingRulesFieldRuleSubdivision: []interface{}{
+				map[string]interface{}{
+					GeoMappingRulesFieldRuleSubdivisionCode: "BE",
+					GeoMappingRulesFieldRuleSubdivisionName: "Berlin",
+				},
+			},
+			GeoMappingRulesFieldRuleCountryCode:   "DE",
+			GeoMappingRulesFieldRuleCountry:       "Germany",
+			GeoMappingRulesFieldRuleContinentCode: "EU",
+			GeoMappingRulesFieldRuleContinent:     "Europe",
+		},
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:           "192.168.0.0/16",
+			GeoMappingRulesFieldRuleLatitude:       float64(0),
+			GeoMappingRulesFieldRuleLongitude:      float64(0),
+			GeoMappingRulesFieldRuleAccuracyRadius: 0,
+			GeoMappingRulesFieldRuleCity:           "",
+			GeoMappingRulesFieldRuleSubdivision:    []interface{}{},
+			GeoMappingRulesFieldRuleCountryCode:    "",
+			GeoMappingRulesFieldRuleCountry:        "",
+			GeoMappingRulesFieldRuleContinentCode:  "",
+			GeoMappingRulesFieldRuleContinent:      "",
+		},
+	}, resourceData.Get(GeoMappingRulesFieldRule))
+}
+
+func TestShouldSuccessfullyConvertWebsiteGeoMappingRulesStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewWebsiteGeoMappingRulesResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID)
+	resourceData.Set(GeoMappingRulesFieldRule, []interface{}{
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:           "10.0.0.0/8",
+			GeoMappingRulesFieldRuleLatitude:       52.52,
+			GeoMappingRulesFieldRuleLongitude:      13.405,
+			GeoMappingRulesFieldRuleAccuracyRadius: 10,
+			GeoMappingRulesFieldRuleCity:           "Berlin",
+			GeoMappingRulesFieldRuleSubdivision: []interface{}{
+				map[string]interface{}{
+					GeoMappingRulesFieldRuleSubdivisionCode: "BE",
+					GeoMappingRulesFieldRuleSubdivisionName: "Berlin",
+				},
+				map[string]interface{}{
+					GeoMappingRulesFieldRuleSubdivisionName: "Mitte",
+				},
+			},
+		},
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:    "192.168.0.0/16",
+			GeoMappingRulesFieldRuleCountry: "Germany",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoMappingRules{
+		MonitoringConfigID: testWebsiteID,
+		Rules: []restapi.GeoMappingRule{
+			{CIDR: "10.0.0.0/8", Latitude: utils.Float64Ptr(52.52), Longitude: utils.Float64Ptr(13.405), AccuracyRadius: utils.Int64Ptr(10), City: "Berlin", Subdivisions: []restapi.GeoSubdivision{{Code: "BE", Name: "Berlin"}, {Name: "Mitte"}}},
+			{CIDR: "192.168.0.0/16", Country: "Germany"},
+		},
+	}, result)
+}
+
+func TestShouldMapZeroValuesOfWebsiteGeoMappingRulesWhenDefinedInConfiguration(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewWebsiteGeoMappingRulesResourceHandle()
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		WebsiteMonitoringSettingsFieldWebsiteID: cty.StringVal(testWebsiteID),
+		GeoMappingRulesFieldRule: cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				GeoMappingRulesFieldRuleCIDR:           cty.StringVal("10.0.0.0/8"),
+				GeoMappingRulesFieldRuleLatitude:       cty.NumberFloatVal(0),
+				GeoMappingRulesFieldRuleLongitude:      cty.NumberFloatVal(0),
+				GeoMappingRulesFieldRuleAccuracyRadius: cty.NumberIntVal(0),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				GeoMappingRulesFieldRuleCIDR:           cty.StringVal("192.168.0.0/16"),
+				GeoMappingRulesFieldRuleLatitude:       cty.NullVal(cty.Number),
+				GeoMappingRulesFieldRuleLongitude:      cty.NullVal(cty.Number),
+				GeoMappingRulesFieldRuleAccuracyRadius: cty.NullVal(cty.Number),
+			}),
+		}),
+	})
+	resourceData := NewTerraformResource(resourceHandle).ToSchemaResource().Data(&terraform.InstanceState{ID: testWebsiteID, RawConfig: rawConfig})
+	resourceData.Set(WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID)
+	resourceData.Set(GeoMappingRulesFieldRule, []interface{}{
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:           "10.0.0.0/8",
+			GeoMappingRulesFieldRuleLatitude:       0.0,
+			GeoMappingRulesFieldRuleLongitude:      0.0,
+			GeoMappingRulesFieldRuleAccuracyRadius: 0,
+		},
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR: "192.168.0.0/16",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoMappingRules{
+		MonitoringConfigID: testWebsiteID,
+		Rules: []restapi.GeoMappingRule{
+			{CIDR: "10.0.0.0/8", Latitude: utils.Float64Ptr(0), Longitude: utils.Float64Ptr(0), AccuracyRadius: utils.Int64Ptr(0)},
+			{CIDR: "192.168.0.0/16"},
+		},
+	}, result)
+}
+
+func TestShouldReturnCorrectResourceNameAndSchemaForMobileAppGeoMappingRulesResource(t *testing.T) {
+	metaData := NewMobileAppGeoMappingRulesResourceHandle().MetaData()
+
+	require.Equal(t, "instana_mobile_app_geo_mapping_rules", metaData.ResourceName)
+	schemaAssert := testutils.NewTerraformSchemaAssert(metaData.Schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppSettingsFieldMobileAppID)
+	require.Contains(t, metaData.Schema, GeoMappingRulesFieldRule)
+	require.NotContains(t, metaData.Schema, WebsiteMonitoringSettingsFieldWebsiteID)
+}
+
+func TestShouldSuccessfullyConvertMobileAppGeoMappingRulesStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMobileAppGeoMappingRulesResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(MobileAppSettingsFieldMobileAppID, "mobile-app-id")
+	resourceData.Set(GeoMappingRulesFieldRule, []interface{}{
+		map[string]interface{}{
+			GeoMappingRulesFieldRuleCIDR:        "10.0.0.0/8",
+			GeoMappingRulesFieldRuleCountryCode: "DE",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GeoMappingRules{
+		MonitoringConfigID: "mobile-app-id",
+		Rules:              []restapi.GeoMappingRule{{CIDR: "10.0.0.0/8", CountryCode: "DE"}},
+	}, result)
+}
@@ -0,0 +1,184 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//ResourceInstanaGlobalCustomPayloadConfiguration the name of the terraform-provider-instana resource to manage the tenant wide custom payload of alerts
+const ResourceInstanaGlobalCustomPayloadConfiguration = "instana_global_custom_payload_configuration"
+
+const (
+	//GlobalCustomPayloadConfigurationFieldCustomPayloadField constant value for the schema field custom_payload_field
+	GlobalCustomPayloadConfigurationFieldCustomPayloadField = "custom_payload_field"
+	//GlobalCustomPayloadConfigurationFieldKey constant value for the schema field custom_payload_field.key
+	GlobalCustomPayloadConfigurationFieldKey = "key"
+	//GlobalCustomPayloadConfigurationFieldValue constant value for the schema field custom_payload_field.value
+	GlobalCustomPayloadConfigurationFieldValue = "value"
+	//GlobalCustomPayloadConfigurationFieldDynamicValue constant value for the schema field custom_payload_field.dynamic_value
+	GlobalCustomPayloadConfigurationFieldDynamicValue = "dynamic_value"
+	//GlobalCustomPayloadConfigurationFieldDynamicValueTagName constant value for the schema field custom_payload_field.dynamic_value.tag_name
+	GlobalCustomPayloadConfigurationFieldDynamicValueTagName = "tag_name"
+	//GlobalCustomPayloadConfigurationFieldDynamicValueKey constant value for the schema field custom_payload_field.dynamic_value.key
+	GlobalCustomPayloadConfigurationFieldDynamicValueKey = "key"
+)
+
+//GlobalCustomPayloadConfigurationCustomPayloadField schema field definition of instana_global_custom_payload_configuration field custom_payload_field
+var GlobalCustomPayloadConfigurationCustomPayloadField = &schema.Schema{
+	Type: schema.TypeSet,
+	Set: func(i interface{}) int {
+		return schema.HashString(i.(map[string]interface{})[GlobalCustomPayloadConfigurationFieldKey])
+	},
+	Optional: true,
+	MinItems: 0,
+	MaxItems: restapi.MaxCustomPayloadFields,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			GlobalCustomPayloadConfigurationFieldKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The key of the custom payload field",
+			},
+			GlobalCustomPayloadConfigurationFieldValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The static string value of the custom payload field. Either value or dynamic_value must be defined",
+			},
+			GlobalCustomPayloadConfigurationFieldDynamicValue: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						GlobalCustomPayloadConfigurationFieldDynamicValueTagName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the tag from which the value is taken. The tag must exist in the tag catalog of Instana",
+						},
+						GlobalCustomPayloadConfigurationFieldDynamicValueKey: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The key of the tag value for tags of type key/value pair",
+						},
+					},
+				},
+				Description: "The dynamic value of the custom payload field which is resolved from a tag of the entity of the alert. Either value or dynamic_value must be defined",
+			},
+		},
+	},
+	Description: "The custom payload fields which are attached to every alert",
+}
+
+//NewGlobalCustomPayloadConfigurationResourceHandle creates the resource handle for the tenant wide custom payload which
+//is attached to every alert. The configuration exists exactly once per tenant. Deleting the resource removes all fields.
+func NewGlobalCustomPayloadConfigurationResourceHandle() ResourceHandle {
+	return &globalCustomPayloadConfigurationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGlobalCustomPayloadConfiguration,
+			Schema: map[string]*schema.Schema{
+				GlobalCustomPayloadConfigurationFieldCustomPayloadField: GlobalCustomPayloadConfigurationCustomPayloadField,
+			},
+			SingletonID: restapi.GlobalCustomPayloadConfigurationPathElement,
+		},
+	}
+}
+
+type globalCustomPayloadConfigurationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *globalCustomPayloadConfigurationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *globalCustomPayloadConfigurationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.GlobalCustomPayloadConfiguration()
+}
+
+func (r *globalCustomPayloadConfigurationResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *globalCustomPayloadConfigurationResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.GlobalCustomPayloadConfiguration)
+	d.Set(GlobalCustomPayloadConfigurationFieldCustomPayloadField, r.mapCustomPayloadFieldsToSchema(config.Fields))
+	d.SetId(config.GetIDForResourcePath())
+	return nil
+}
+
+func (r *globalCustomPayloadConfigurationResource) mapCustomPayloadFieldsToSchema(fields []restapi.CustomPayloadField[any]) []interface{} {
+	result := make([]interface{}, len(fields))
+	for i, v := range fields {
+		field := map[string]interface{}{
+			GlobalCustomPayloadConfigurationFieldKey: v.Key,
+		}
+		if v.Type == restapi.DynamicCustomPayloadType {
+			value := v.Value.(restapi.DynamicCustomPayloadFieldValue)
+			dynamicValue := map[string]interface{}{
+				GlobalCustomPayloadConfigurationFieldDynamicValueTagName: value.TagName,
+			}
+			if value.Key != nil {
+				dynamicValue[GlobalCustomPayloadConfigurationFieldDynamicValueKey] = *value.Key
+			}
+			field[GlobalCustomPayloadConfigurationFieldDynamicValue] = []interface{}{dynamicValue}
+		} else {
+			field[GlobalCustomPayloadConfigurationFieldValue] = string(v.Value.(restapi.StaticStringCustomPayloadFieldValue))
+		}
+		result[i] = field
+	}
+	return result
+}
+
+func (r *globalCustomPayloadConfigurationResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	fields, err := r.mapCustomPayloadFieldsFromSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	return &restapi.GlobalCustomPayloadConfiguration{Fields: fields}, nil
+}
+
+func (r *globalCustomPayloadConfigurationResource) mapCustomPayloadFieldsFromSchema(d *schema.ResourceData) ([]restapi.CustomPayloadField[any], error) {
+	rawFields := d.Get(GlobalCustomPayloadConfigurationFieldCustomPayloadField).(*schema.Set).List()
+	result := make([]restapi.CustomPayloadField[any], len(rawFields))
+	for i, v := range rawFields {
+		field := v.(map[string]interface{})
+		key := field[GlobalCustomPayloadConfigurationFieldKey].(string)
+		value := field[GlobalCustomPayloadConfigurationFieldValue].(string)
+		dynamicValues := field[GlobalCustomPayloadConfigurationFieldDynamicValue].([]interface{})
+
+		if len(dynamicValues) == 1 && dynamicValues[0] != nil && value == "" {
+			result[i] = restapi.CustomPayloadField[any]{
+				Type:  restapi.DynamicCustomPayloadType,
+				Key:   key,
+				Value: r.mapDynamicValueFromSchema(dynamicValues[0].(map[string]interface{})),
+			}
+		} else if len(dynamicValues) == 0 && value != "" {
+			result[i] = restapi.CustomPayloadField[any]{
+				Type:  restapi.StaticCustomPayloadType,
+				Key:   key,
+				Value: restapi.StaticStringCustomPayloadFieldValue(value),
+			}
+		} else {
+			return nil, fmt.Errorf("exactly one of %s or %s must be defined for custom payload field %s", GlobalCustomPayloadConfigurationFieldValue, GlobalCustomPayloadConfigurationFieldDynamicValue, key)
+		}
+	}
+	return result, nil
+}
+
+func (r *globalCustomPayloadConfigurationResource) mapDynamicValueFromSchema(dynamicValue map[string]interface{}) restapi.DynamicCustomPayloadFieldValue {
+	result := restapi.DynamicCustomPayloadFieldValue{
+		TagName: dynamicValue[GlobalCustomPayloadConfigurationFieldDynamicValueTagName].(string),
+	}
+	if key, ok := dynamicValue[GlobalCustomPayloadConfigurationFieldDynamicValueKey].(string); ok && key != "" {
+		result.Key = &key
+	}
+	return result
+}
@@ -0,0 +1,242 @@
+package instana_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceGlobalCustomPayloadConfigurationDefinitionTemplate = `
+resource "instana_global_custom_payload_configuration" "example" {
+  custom_payload_field {
+    key   = "team"
+    value = "team-%d"
+  }
+
+  custom_payload_field {
+    key = "stage"
+    dynamic_value {
+      tag_name = "agent.tag"
+      key      = "stage"
+    }
+  }
+}
+`
+
+const (
+	testGlobalCustomPayloadConfigurationDefinition = "instana_global_custom_payload_configuration.example"
+	emptyGlobalCustomPayloadConfiguration          = `{"fields":[]}`
+	customPayloadTagCatalog                        = `{"tagTree":[],"tags":[{"name":"agent.zone","type":"STRING"},{"name":"agent.tag","type":"KEY_VALUE_PAIR"}]}`
+)
+
+func TestCRUDOfGlobalCustomPayloadConfigurationResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForGlobalCustomPayloadConfiguration()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGlobalCustomPayloadConfigurationResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testGlobalCustomPayloadConfigurationDefinition),
+			createGlobalCustomPayloadConfigurationResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testGlobalCustomPayloadConfigurationDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForGlobalCustomPayloadConfiguration creates a mock server which keeps the configuration in memory.
+//Like the Instana API, put requests respond with the history of the configuration instead of the configuration itself
+func createMockHttpServerForGlobalCustomPayloadConfiguration() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	config := []byte(emptyGlobalCustomPayloadConfiguration)
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		httpServer.WriteJSONResponse(w, config)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		config = body
+		httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf("[%s]", body)))
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.GlobalCustomPayloadConfigurationResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		config = []byte(emptyGlobalCustomPayloadConfiguration)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.CustomPayloadTagCatalogResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte(customPayloadTagCatalog))
+	})
+	return httpServer
+}
+
+func createGlobalCustomPayloadConfigurationResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceGlobalCustomPayloadConfigurationDefinitionTemplate, iteration), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testGlobalCustomPayloadConfigurationDefinition, "id", restapi.GlobalCustomPayloadConfigurationPathElement),
+			resource.TestCheckResourceAttr(testGlobalCustomPayloadConfigurationDefinition, GlobalCustomPayloadConfigurationFieldCustomPayloadField+".#", "2"),
+			resource.TestCheckTypeSetElemNestedAttrs(testGlobalCustomPayloadConfigurationDefinition, GlobalCustomPayloadConfigurationFieldCustomPayloadField+".*", map[string]string{
+				GlobalCustomPayloadConfigurationFieldKey:   "team",
+				GlobalCustomPayloadConfigurationFieldValue: fmt.Sprintf("team-%d", iteration),
+			}),
+			resource.TestCheckTypeSetElemNestedAttrs(testGlobalCustomPayloadConfigurationDefinition, GlobalCustomPayloadConfigurationFieldCustomPayloadField+".*", map[string]string{
+				GlobalCustomPayloadConfigurationFieldKey: "stage",
+				GlobalCustomPayloadConfigurationFieldDynamicValue + ".0." + GlobalCustomPayloadConfigurationFieldDynamicValueTagName: "agent.tag",
+				GlobalCustomPayloadConfigurationFieldDynamicValue + ".0." + GlobalCustomPayloadConfigurationFieldDynamicValueKey:     "stage",
+			}),
+		),
+	}
+}
+
+func TestGlobalCustomPayloadConfigurationSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewGlobalCustomPayloadConfigurationResourceHandle().MetaData().Schema
+
+	fieldSchema := resourceSchema[GlobalCustomPayloadConfigurationFieldCustomPayloadField]
+	require.Equal(t, schema.TypeSet, fieldSchema.Type)
+	require.True(t, fieldSchema.Optional)
+	require.Equal(t, restapi.MaxCustomPayloadFields, fieldSchema.MaxItems)
+
+	fieldSchemaAssert := testutils.NewTerraformSchemaAssert(fieldSchema.Elem.(*schema.Resource).Schema, t)
+	fieldSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GlobalCustomPayloadConfigurationFieldKey)
+	fieldSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(GlobalCustomPayloadConfigurationFieldValue)
+
+	dynamicValueSchema := fieldSchema.Elem.(*schema.Resource).Schema[GlobalCustomPayloadConfigurationFieldDynamicValue]
+	require.Equal(t, schema.TypeList, dynamicValueSchema.Type)
+	require.True(t, dynamicValueSchema.Optional)
+	require.Equal(t, 1, dynamicValueSchema.MaxItems)
+
+	dynamicValueSchemaAssert := testutils.NewTerraformSchemaAssert(dynamicValueSchema.Elem.(*schema.Resource).Schema, t)
+	dynamicValueSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(GlobalCustomPayloadConfigurationFieldDynamicValueTagName)
+	dynamicValueSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(GlobalCustomPayloadConfigurationFieldDynamicValueKey)
+}
+
+func TestShouldReturnCorrectResourceNameForGlobalCustomPayloadConfigurationResource(t *testing.T) {
+	name := NewGlobalCustomPayloadConfigurationResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_global_custom_payload_configuration", name)
+}
+
+func TestGlobalCustomPayloadConfigurationResourceShouldBeASingletonWithSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.Equal(t, restapi.GlobalCustomPayloadConfigurationPathElement, resourceHandle.MetaData().SingletonID)
+}
+
+func TestShouldUpdateGlobalCustomPayloadConfigurationTerraformResourceStateFromModel(t *testing.T) {
+	config := &restapi.GlobalCustomPayloadConfiguration{Fields: []restapi.CustomPayloadField[any]{
+		{Type: restapi.StaticCustomPayloadType, Key: "team", Value: restapi.StaticStringCustomPayloadFieldValue("platform")},
+		{Type: restapi.DynamicCustomPayloadType, Key: "stage", Value: restapi.DynamicCustomPayloadFieldValue{TagName: "agent.tag", Key: utils.StringPtr("stage")}},
+	}}
+
+	testHelper := NewTestHelper(t)
+	sut := NewGlobalCustomPayloadConfigurationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.GlobalCustomPayloadConfigurationPathElement, resourceData.Id())
+	fields := resourceData.Get(GlobalCustomPayloadConfigurationFieldCustomPayloadField).(*schema.Set).List()
+	require.Len(t, fields, 2)
+	require.Contains(t, fields, map[string]interface{}{
+		GlobalCustomPayloadConfigurationFieldKey:          "team",
+		GlobalCustomPayloadConfigurationFieldValue:        "platform",
+		GlobalCustomPayloadConfigurationFieldDynamicValue: []interface{}{},
+	})
+	require.Contains(t, fields, map[string]interface{}{
+		GlobalCustomPayloadConfigurationFieldKey:   "stage",
+		GlobalCustomPayloadConfigurationFieldValue: "",
+		GlobalCustomPayloadConfigurationFieldDynamicValue: []interface{}{
+			map[string]interface{}{
+				GlobalCustomPayloadConfigurationFieldDynamicValueTagName: "agent.tag",
+				GlobalCustomPayloadConfigurationFieldDynamicValueKey:     "stage",
+			},
+		},
+	})
+}
+
+func TestShouldSuccessfullyConvertGlobalCustomPayloadConfigurationStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.GlobalCustomPayloadConfigurationPathElement)
+	resourceData.Set(GlobalCustomPayloadConfigurationFieldCustomPayloadField, []interface{}{
+		map[string]interface{}{
+			GlobalCustomPayloadConfigurationFieldKey: "zone",
+			GlobalCustomPayloadConfigurationFieldDynamicValue: []interface{}{
+				map[string]interface{}{GlobalCustomPayloadConfigurationFieldDynamicValueTagName: "agent.zone"},
+			},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GlobalCustomPayloadConfiguration{Fields: []restapi.CustomPayloadField[any]{
+		{Type: restapi.DynamicCustomPayloadType, Key: "zone", Value: restapi.DynamicCustomPayloadFieldValue{TagName: "agent.zone"}},
+	}}, result)
+}
+
+func TestShouldSuccessfullyConvertStaticGlobalCustomPayloadFieldStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(GlobalCustomPayloadConfigurationFieldCustomPayloadField, []interface{}{
+		map[string]interface{}{GlobalCustomPayloadConfigurationFieldKey: "team", GlobalCustomPayloadConfigurationFieldValue: "platform"},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GlobalCustomPayloadConfiguration{Fields: []restapi.CustomPayloadField[any]{
+		{Type: restapi.StaticCustomPayloadType, Key: "team", Value: restapi.StaticStringCustomPayloadFieldValue("platform")},
+	}}, result)
+}
+
+func TestShouldFailToConvertGlobalCustomPayloadConfigurationStateToDataModelWhenNotExactlyOneValueIsDefined(t *testing.T) {
+	testCases := map[string]map[string]interface{}{
+		"no value is defined": {GlobalCustomPayloadConfigurationFieldKey: "team"},
+		"both values are defined": {
+			GlobalCustomPayloadConfigurationFieldKey:   "team",
+			GlobalCustomPayloadConfigurationFieldValue: "platform",
+			GlobalCustomPayloadConfigurationFieldDynamicValue: []interface{}{
+				map[string]interface{}{GlobalCustomPayloadConfigurationFieldDynamicValueTagName: "agent.zone"},
+			},
+		},
+	}
+	for name, field := range testCases {
+		t.Run("Should fail when "+name, func(t *testing.T) {
+			testHelper := NewTestHelper(t)
+			resourceHandle := NewGlobalCustomPayloadConfigurationResourceHandle()
+			resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+			resourceData.Set(GlobalCustomPayloadConfigurationFieldCustomPayloadField, []interface{}{field})
+
+			_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "exactly one of value or dynamic_value must be defined for custom payload field team")
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package instana
+
+import (
+	"regexp"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaGroupMapping the name of the terraform-provider-instana resource to manage mappings of identity provider groups to groups for role based access control
+const ResourceInstanaGroupMapping = "instana_rbac_mapping"
+
+const (
+	//GroupMappingFieldGroupID constant value for the schema field group_id
+	GroupMappingFieldGroupID = "group_id"
+	//GroupMappingFieldKey constant value for the schema field key
+	GroupMappingFieldKey = "key"
+	//GroupMappingFieldValue constant value for the schema field value
+	GroupMappingFieldValue = "value"
+
+	groupMappingMaxLength = 65536
+)
+
+var (
+	//GroupMappingGroupID schema field definition of instana_rbac_mapping field group_id
+	GroupMappingGroupID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the Instana group (instana_rbac_group) to which the users of the group of the identity provider are assigned",
+	}
+	//GroupMappingKey schema field definition of instana_rbac_mapping field key
+	GroupMappingKey = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, groupMappingMaxLength),
+			validation.StringMatch(regexp.MustCompile(`^\S+$`), "must be the name of an attribute of the identity provider without whitespace, e.g. memberOf"),
+		),
+		Description: "The attribute of the identity provider (LDAP, SAML or OIDC) which contains the groups of the user, e.g. memberOf",
+	}
+	//GroupMappingValue schema field definition of instana_rbac_mapping field value
+	GroupMappingValue = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, groupMappingMaxLength),
+			validation.StringMatch(regexp.MustCompile(`^\S(.*\S)?$`), "must not be blank and must not start or end with whitespace"),
+		),
+		Description: "The group of the identity provider which is mapped to the Instana group, e.g. cn=admins,ou=groups,dc=example,dc=com",
+	}
+)
+
+//NewGroupMappingResourceHandle creates a new instance of the ResourceHandle for mappings of identity provider groups to Instana groups
+func NewGroupMappingResourceHandle() ResourceHandle {
+	return &groupMappingResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMapping,
+			Schema: map[string]*schema.Schema{
+				GroupMappingFieldGroupID: GroupMappingGroupID,
+				GroupMappingFieldKey:     GroupMappingKey,
+				GroupMappingFieldValue:   GroupMappingValue,
+			},
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMappingResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMappingResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMappingResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMappingResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.GroupMappings()
+}
+
+func (r *groupMappingResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *groupMappingResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	mapping := obj.(*restapi.GroupMapping)
+	d.Set(GroupMappingFieldGroupID, mapping.GroupID)
+	d.Set(GroupMappingFieldKey, mapping.Key)
+	d.Set(GroupMappingFieldValue, mapping.Value)
+	d.SetId(mapping.ID)
+	return nil
+}
+
+func (r *groupMappingResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.GroupMapping{
+		ID:      d.Id(),
+		GroupID: d.Get(GroupMappingFieldGroupID).(string),
+		Key:     d.Get(GroupMappingFieldKey).(string),
+		Value:   d.Get(GroupMappingFieldValue).(string),
+	}, nil
+}
@@ -0,0 +1,198 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceGroupMappingDefinitionTemplate = `
+resource "instana_rbac_mapping" "example" {
+  group_id = "group-id"
+  key      = "memberOf"
+  value    = "cn=group-%d,ou=groups,dc=example,dc=com"
+}
+`
+
+const (
+	testGroupMappingDefinition = "instana_rbac_mapping.example"
+	groupMappingID             = "group-mapping-id"
+	groupMappingGroupID        = "group-id"
+	groupMappingKey            = "memberOf"
+	groupMappingValue          = "cn=admins,ou=groups,dc=example,dc=com"
+)
+
+func TestCRUDOfGroupMappingResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForGroupMapping()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createGroupMappingResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testGroupMappingDefinition),
+			createGroupMappingResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testGroupMappingDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForGroupMapping creates a mock server which stores the group mapping of the last create or update
+//request and returns it as part of the list of all group mappings
+func createMockHttpServerForGroupMapping() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	mappings := make([]*restapi.GroupMapping, 0)
+	httpServer := testutils.NewTestHTTPServer()
+	storeMapping := func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		mapping := &restapi.GroupMapping{}
+		if err := json.Unmarshal(body, mapping); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mapping.ID = groupMappingID
+		mappings = []*restapi.GroupMapping{mapping}
+		data, _ := json.Marshal(mapping)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.GroupMappingsResourcePath, storeMapping)
+	httpServer.AddRoute(http.MethodPut, restapi.GroupMappingsResourcePath+"/{id}", storeMapping)
+	httpServer.AddRoute(http.MethodGet, restapi.GroupMappingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		data, _ := json.Marshal(mappings)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.GroupMappingsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		mappings = make([]*restapi.GroupMapping, 0)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
+func createGroupMappingResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceGroupMappingDefinitionTemplate, iteration), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testGroupMappingDefinition, "id", groupMappingID),
+			resource.TestCheckResourceAttr(testGroupMappingDefinition, GroupMappingFieldGroupID, groupMappingGroupID),
+			resource.TestCheckResourceAttr(testGroupMappingDefinition, GroupMappingFieldKey, groupMappingKey),
+			resource.TestCheckResourceAttr(testGroupMappingDefinition, GroupMappingFieldValue, fmt.Sprintf("cn=group-%d,ou=groups,dc=example,dc=com", iteration)),
+		),
+	}
+}
+
+func TestGroupMappingSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewGroupMappingResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldGroupID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldKey)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMappingFieldValue)
+}
+
+func TestShouldValidateKeyOfGroupMapping(t *testing.T) {
+	validateFunc := NewGroupMappingResourceHandle().MetaData().Schema[GroupMappingFieldKey].ValidateFunc
+
+	for _, key := range []string{"memberOf", "groups", "http://schemas.xmlsoap.org/claims/Group"} {
+		t.Run("Should accept key "+key, func(t *testing.T) {
+			_, errs := validateFunc(key, GroupMappingFieldKey)
+			require.Empty(t, errs)
+		})
+	}
+	for _, key := range []string{"", " ", "member Of", " memberOf"} {
+		t.Run("Should reject key '"+key+"'", func(t *testing.T) {
+			_, errs := validateFunc(key, GroupMappingFieldKey)
+			require.NotEmpty(t, errs)
+		})
+	}
+}
+
+func TestShouldValidateValueOfGroupMapping(t *testing.T) {
+	validateFunc := NewGroupMappingResourceHandle().MetaData().Schema[GroupMappingFieldValue].ValidateFunc
+
+	for _, value := range []string{"a", "admins", "cn=Instana Admins,ou=groups,dc=example,dc=com"} {
+		t.Run("Should accept value "+value, func(t *testing.T) {
+			_, errs := validateFunc(value, GroupMappingFieldValue)
+			require.Empty(t, errs)
+		})
+	}
+	for _, value := range []string{"", "  ", " admins", "admins "} {
+		t.Run("Should reject value '"+value+"'", func(t *testing.T) {
+			_, errs := validateFunc(value, GroupMappingFieldValue)
+			require.NotEmpty(t, errs)
+		})
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForGroupMappingResource(t *testing.T) {
+	name := NewGroupMappingResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_rbac_mapping", name)
+}
+
+func TestGroupMappingResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewGroupMappingResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateGroupMappingTerraformResourceStateFromModel(t *testing.T) {
+	mapping := &restapi.GroupMapping{
+		ID:      groupMappingID,
+		GroupID: groupMappingGroupID,
+		Key:     groupMappingKey,
+		Value:   groupMappingValue,
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewGroupMappingResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, mapping, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, groupMappingID, resourceData.Id())
+	require.Equal(t, groupMappingGroupID, resourceData.Get(GroupMappingFieldGroupID))
+	require.Equal(t, groupMappingKey, resourceData.Get(GroupMappingFieldKey))
+	require.Equal(t, groupMappingValue, resourceData.Get(GroupMappingFieldValue))
+}
+
+func TestShouldSuccessfullyConvertGroupMappingStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupMappingResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(groupMappingID)
+	resourceData.Set(GroupMappingFieldGroupID, groupMappingGroupID)
+	resourceData.Set(GroupMappingFieldKey, groupMappingKey)
+	resourceData.Set(GroupMappingFieldValue, groupMappingValue)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMapping{
+		ID:      groupMappingID,
+		GroupID: groupMappingGroupID,
+		Key:     groupMappingKey,
+		Value:   groupMappingValue,
+	}, result)
+}
@@ -0,0 +1,105 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaGroupMembership the name of the terraform-provider-instana resource to manage the membership of a single user in a group for role based access control
+const ResourceInstanaGroupMembership = "instana_rbac_group_membership"
+
+const (
+	//GroupMembershipFieldGroupID constant value for the schema field group_id
+	GroupMembershipFieldGroupID = "group_id"
+	//GroupMembershipFieldUserID constant value for the schema field user_id
+	GroupMembershipFieldUserID = "user_id"
+	//GroupMembershipFieldEmail constant value for the schema field email
+	GroupMembershipFieldEmail = "email"
+)
+
+var (
+	//GroupMembershipGroupID schema field definition of instana_rbac_group_membership field group_id
+	GroupMembershipGroupID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the group (instana_rbac_group) to which the user is added",
+	}
+	//GroupMembershipUserID schema field definition of instana_rbac_group_membership field user_id
+	GroupMembershipUserID = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		ExactlyOneOf: []string{GroupMembershipFieldUserID, GroupMembershipFieldEmail},
+		Description:  "The ID of the user which is added to the group. Computed when the user is identified by email",
+	}
+	//GroupMembershipEmail schema field definition of instana_rbac_group_membership field email
+	GroupMembershipEmail = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		ExactlyOneOf: []string{GroupMembershipFieldUserID, GroupMembershipFieldEmail},
+		Description:  "The email address of the user which is added to the group. Computed when the user is identified by user ID",
+	}
+)
+
+//NewGroupMembershipResourceHandle creates the resource handle for the membership of a single user in an RBAC group
+func NewGroupMembershipResourceHandle() ResourceHandle {
+	return &groupMembershipResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaGroupMembership,
+			Schema: map[string]*schema.Schema{
+				GroupMembershipFieldGroupID: GroupMembershipGroupID,
+				GroupMembershipFieldUserID:  GroupMembershipUserID,
+				GroupMembershipFieldEmail:   GroupMembershipEmail,
+			},
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type groupMembershipResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *groupMembershipResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *groupMembershipResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *groupMembershipResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.GroupMemberships()
+}
+
+func (r *groupMembershipResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *groupMembershipResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	membership := obj.(*restapi.GroupMembership)
+	d.Set(GroupMembershipFieldGroupID, membership.GroupID)
+	d.Set(GroupMembershipFieldUserID, membership.UserID)
+	if membership.Email != nil {
+		d.Set(GroupMembershipFieldEmail, *membership.Email)
+	}
+	d.SetId(membership.GetIDForResourcePath())
+	return nil
+}
+
+func (r *groupMembershipResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.GroupMembership{
+		GroupID: d.Get(GroupMembershipFieldGroupID).(string),
+		UserID:  d.Get(GroupMembershipFieldUserID).(string),
+		Email:   GetStringPointerFromResourceData(d, GroupMembershipFieldEmail),
+	}, nil
+}
@@ -0,0 +1,179 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceGroupMembershipDefinitionTemplate = `
+resource "instana_rbac_group_membership" "example" {
+  group_id = "group-id"
+  %s       = "%s"
+}
+`
+
+const (
+	testGroupMembershipDefinition = "instana_rbac_group_membership.example"
+	groupMembershipGroupID        = "group-id"
+	groupMembershipUserID         = "user-id"
+	groupMembershipEmail          = "user@example.com"
+	groupMembershipOtherUserID    = "other-user-id"
+)
+
+func TestCRUDOfGroupMembershipResourceWithMockServer(t *testing.T) {
+	for field, value := range map[string]string{GroupMembershipFieldUserID: groupMembershipUserID, GroupMembershipFieldEmail: groupMembershipEmail} {
+		t.Run(fmt.Sprintf("CRUD of group membership identified by %s", field), func(t *testing.T) {
+			httpServer := createMockHttpServerForGroupMembership()
+			httpServer.Start()
+			defer httpServer.Close()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProviderFactories: testProviderFactory,
+				Steps: []resource.TestStep{
+					{
+						Config: appendProviderConfig(fmt.Sprintf(resourceGroupMembershipDefinitionTemplate, field, value), httpServer.GetPort()),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testGroupMembershipDefinition, "id", groupMembershipGroupID+":"+groupMembershipUserID),
+							resource.TestCheckResourceAttr(testGroupMembershipDefinition, GroupMembershipFieldGroupID, groupMembershipGroupID),
+							resource.TestCheckResourceAttr(testGroupMembershipDefinition, GroupMembershipFieldUserID, groupMembershipUserID),
+							resource.TestCheckResourceAttr(testGroupMembershipDefinition, GroupMembershipFieldEmail, groupMembershipEmail),
+						),
+					},
+					testStepImport(testGroupMembershipDefinition),
+				},
+			})
+		})
+	}
+}
+
+//createMockHttpServerForGroupMembership creates a mock server for a single group which initially contains another
+//member. Users are added through the users endpoint of the group and removed by updating the group.
+func createMockHttpServerForGroupMembership() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	users := map[string]string{groupMembershipUserID: groupMembershipEmail, groupMembershipOtherUserID: "other@example.com"}
+	group := restapi.Group{
+		ID:      groupMembershipGroupID,
+		Name:    "group",
+		Members: []restapi.APIMember{{UserID: groupMembershipOtherUserID, Email: utils.StringPtr(users[groupMembershipOtherUserID])}},
+	}
+	httpServer := testutils.NewTestHTTPServer()
+	writeGroup := func(w http.ResponseWriter) {
+		data, _ := json.Marshal(group)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.GroupsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if mux.Vars(r)["id"] != groupMembershipGroupID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeGroup(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.GroupsResourcePath+"/{id}/users", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		userIDs := make([]string, 0)
+		if err := json.Unmarshal(body, &userIDs); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, userID := range userIDs {
+			group.Members = append(group.Members, restapi.APIMember{UserID: userID, Email: utils.StringPtr(users[userID])})
+		}
+		writeGroup(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.GroupsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &group); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeGroup(w)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		result := make([]restapi.User, 0)
+		for id, email := range users {
+			result = append(result, restapi.User{ID: id, Email: email, FullName: id})
+		}
+		data, _ := json.Marshal(result)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	return httpServer
+}
+
+func TestGroupMembershipSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewGroupMembershipResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupMembershipFieldGroupID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(GroupMembershipFieldUserID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(GroupMembershipFieldEmail)
+	for _, field := range []string{GroupMembershipFieldGroupID, GroupMembershipFieldUserID, GroupMembershipFieldEmail} {
+		require.True(t, resourceSchema[field].ForceNew)
+	}
+	require.True(t, resourceSchema[GroupMembershipFieldUserID].Computed)
+	require.True(t, resourceSchema[GroupMembershipFieldEmail].Computed)
+	require.Equal(t, []string{GroupMembershipFieldUserID, GroupMembershipFieldEmail}, resourceSchema[GroupMembershipFieldUserID].ExactlyOneOf)
+	require.Equal(t, []string{GroupMembershipFieldUserID, GroupMembershipFieldEmail}, resourceSchema[GroupMembershipFieldEmail].ExactlyOneOf)
+}
+
+func TestShouldReturnCorrectResourceNameForGroupMembershipResource(t *testing.T) {
+	name := NewGroupMembershipResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_rbac_group_membership", name)
+}
+
+func TestGroupMembershipResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewGroupMembershipResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateGroupMembershipTerraformResourceStateFromModel(t *testing.T) {
+	membership := &restapi.GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID, Email: utils.StringPtr(groupMembershipEmail)}
+
+	testHelper := NewTestHelper(t)
+	sut := NewGroupMembershipResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, membership, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipGroupID+":"+groupMembershipUserID, resourceData.Id())
+	require.Equal(t, groupMembershipGroupID, resourceData.Get(GroupMembershipFieldGroupID))
+	require.Equal(t, groupMembershipUserID, resourceData.Get(GroupMembershipFieldUserID))
+	require.Equal(t, groupMembershipEmail, resourceData.Get(GroupMembershipFieldEmail))
+}
+
+func TestShouldSuccessfullyConvertGroupMembershipStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupMembershipResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(GroupMembershipFieldGroupID, groupMembershipGroupID)
+	resourceData.Set(GroupMembershipFieldEmail, groupMembershipEmail)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.GroupMembership{GroupID: groupMembershipGroupID, Email: utils.StringPtr(groupMembershipEmail)}, result)
+}
@@ -18,6 +18,8 @@ const (
 	GroupFieldFullName = "full_name"
 	//GroupFieldMembers constant value for the schema field members
 	GroupFieldMembers = "member"
+	//GroupFieldIgnoreMembership constant value for the schema field ignore_membership
+	GroupFieldIgnoreMembership = "ignore_membership"
 	//GroupFieldMemberEmail constant value for the schema field email
 	GroupFieldMemberEmail = "email"
 	//GroupFieldMemberUserID constant value for the schema field user_id
@@ -137,6 +139,14 @@ var groupSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: groupMemberSchema,
 		},
+		ConflictsWith: []string{GroupFieldIgnoreMembership},
+	},
+	GroupFieldIgnoreMembership: {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		Description:   "Flag to indicate if the members of the group are managed outside of this resource, e.g. by instana_rbac_group_membership. When enabled the current members of the group are kept and changes of the members are ignored",
+		ConflictsWith: []string{GroupFieldMembers},
 	},
 	GroupFieldPermissionSet: {
 		Type:        schema.TypeList,
@@ -187,8 +197,9 @@ func (r *groupResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaD
 	d.Set(GroupFieldName, formatter.UndoFormat(group.Name))
 	d.Set(GroupFieldFullName, group.Name)
 
-	members := r.convertGroupMembersToState(group)
-	if members != nil {
+	if d.Get(GroupFieldIgnoreMembership).(bool) {
+		d.Set(GroupFieldMembers, nil)
+	} else if members := r.convertGroupMembersToState(group); members != nil {
 		d.Set(GroupFieldMembers, members)
 	}
 	if !group.PermissionSet.IsEmpty() {
@@ -245,7 +256,10 @@ func (r *groupResource) convertScopeBindingSliceToState(value []restapi.ScopeBin
 
 func (r *groupResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
 	name := r.computeFullNameString(d, formatter)
-	members := r.convertStateToGroupMembers(d)
+	var members []restapi.APIMember
+	if !d.Get(GroupFieldIgnoreMembership).(bool) {
+		members = r.convertStateToGroupMembers(d)
+	}
 	permissionSet := convertStateToPermissionSet(d)
 	return &restapi.Group{
 		ID:            d.Id(),
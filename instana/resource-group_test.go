@@ -264,6 +264,9 @@ func TestResourceGroupDefinition(t *testing.T) {
 	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
 	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(GroupFieldName)
 	schemaAssert.AssertSchemaIsComputedAndOfTypeString(GroupFieldFullName)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(GroupFieldIgnoreMembership, false)
+	require.Equal(t, []string{GroupFieldMembers}, schemaMap[GroupFieldIgnoreMembership].ConflictsWith)
+	require.Equal(t, []string{GroupFieldIgnoreMembership}, schemaMap[GroupFieldMembers].ConflictsWith)
 	verifyGroupMemberSchema(t, schemaMap[GroupFieldMembers])
 	verifyGroupPermissionSetSchema(t, schemaMap[GroupFieldPermissionSet])
 }
@@ -448,3 +451,40 @@ func TestGroupResourceShouldReadModelFromState(t *testing.T) {
 	}
 	require.Equal(t, expectedMembers, group.Members)
 }
+
+func TestShouldNotUpdateMembersInStateWhenMembershipOfGroupIsIgnored(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(GroupFieldIgnoreMembership, true)
+
+	member1Email := defaultGroupMember1Email
+	group := restapi.Group{
+		ID:      defaultGroupID,
+		Name:    defaultGroupName,
+		Members: []restapi.APIMember{{UserID: defaultGroupMember1UserID, Email: &member1Email}},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &group, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, defaultGroupID, resourceData.Id())
+	require.Equal(t, 0, resourceData.Get(GroupFieldMembers).(*schema.Set).Len())
+	require.True(t, resourceData.Get(GroupFieldIgnoreMembership).(bool))
+}
+
+func TestGroupResourceShouldReadModelWithoutMembersFromStateWhenMembershipOfGroupIsIgnored(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewGroupResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(defaultGroupID)
+	resourceData.Set(GroupFieldFullName, defaultGroupFullName)
+	resourceData.Set(GroupFieldIgnoreMembership, true)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	group := result.(*restapi.Group)
+	require.Equal(t, defaultGroupID, group.ID)
+	require.Nil(t, group.Members)
+}
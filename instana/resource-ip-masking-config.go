@@ -0,0 +1,93 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaWebsiteIPMaskingConfig the name of the terraform-provider-instana resource to manage the IP masking of websites
+const ResourceInstanaWebsiteIPMaskingConfig = "instana_website_ip_masking_config"
+
+//ResourceInstanaMobileAppIPMaskingConfig the name of the terraform-provider-instana resource to manage the IP masking of mobile apps
+const ResourceInstanaMobileAppIPMaskingConfig = "instana_mobile_app_ip_masking_config"
+
+//IPMaskingConfigFieldIPMasking constant value for the schema field ip_masking
+const IPMaskingConfigFieldIPMasking = "ip_masking"
+
+//IPMaskingConfigIPMasking schema field definition of the field ip_masking of IP masking configurations
+var IPMaskingConfigIPMasking = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringInSlice(restapi.SupportedIPMaskings.ToStringSlice(), false),
+	Description:  "The masking of the IP addresses of the beacons. DEFAULT removes the last octet of IPv4 and the last 80 bits of IPv6 addresses, STRICT removes the last two octets and the last 96 bits, REMOVE_ALL_DETAILS removes the IP addresses entirely",
+}
+
+//NewWebsiteIPMaskingConfigResourceHandle creates the resource handle for the IP masking of websites
+func NewWebsiteIPMaskingConfigResourceHandle() ResourceHandle {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaWebsiteIPMaskingConfig, WebsiteMonitoringSettingsFieldWebsiteID, WebsiteMonitoringSettingsWebsiteID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.WebsiteIPMaskingConfiguration()
+	})
+}
+
+//NewMobileAppIPMaskingConfigResourceHandle creates the resource handle for the IP masking of mobile apps
+func NewMobileAppIPMaskingConfigResourceHandle() ResourceHandle {
+	return newIPMaskingConfigResourceHandle(ResourceInstanaMobileAppIPMaskingConfig, MobileAppSettingsFieldMobileAppID, MobileAppSettingsMobileAppID, func(api restapi.InstanaAPI) restapi.RestResource {
+		return api.MobileAppIPMaskingConfiguration()
+	})
+}
+
+//newIPMaskingConfigResourceHandle creates a resource handle for the IP masking of the monitoring config which is
+//referenced by the given field (website or mobile app). Deleting the resource resets the IP masking to the default.
+func newIPMaskingConfigResourceHandle(resourceName string, monitoringConfigIDField string, monitoringConfigIDSchema *schema.Schema, restResource func(api restapi.InstanaAPI) restapi.RestResource) ResourceHandle {
+	return &ipMaskingConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: resourceName,
+			Schema: map[string]*schema.Schema{
+				monitoringConfigIDField:       monitoringConfigIDSchema,
+				IPMaskingConfigFieldIPMasking: IPMaskingConfigIPMasking,
+			},
+			SkipIDGeneration: true,
+		},
+		monitoringConfigIDField: monitoringConfigIDField,
+		restResource:            restResource,
+	}
+}
+
+type ipMaskingConfigResource struct {
+	metaData                ResourceMetaData
+	monitoringConfigIDField string
+	restResource            func(api restapi.InstanaAPI) restapi.RestResource
+}
+
+func (r *ipMaskingConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *ipMaskingConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *ipMaskingConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return r.restResource(api)
+}
+
+func (r *ipMaskingConfigResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *ipMaskingConfigResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.IPMaskingConfiguration)
+	d.Set(r.monitoringConfigIDField, config.MonitoringConfigID)
+	d.Set(IPMaskingConfigFieldIPMasking, string(config.IPMasking))
+	d.SetId(config.MonitoringConfigID)
+	return nil
+}
+
+func (r *ipMaskingConfigResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.IPMaskingConfiguration{
+		MonitoringConfigID: d.Get(r.monitoringConfigIDField).(string),
+		IPMasking:          restapi.IPMasking(d.Get(IPMaskingConfigFieldIPMasking).(string)),
+	}, nil
+}
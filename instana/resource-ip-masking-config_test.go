@@ -0,0 +1,159 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceWebsiteIPMaskingConfigDefinitionTemplate = `
+resource "instana_website_ip_masking_config" "example" {
+  website_id = "%s"
+  ip_masking = "%s"
+}
+`
+
+const (
+	testWebsiteIPMaskingConfigDefinition = "instana_website_ip_masking_config.example"
+	testWebsiteID                        = "website-id"
+)
+
+func TestCRUDOfWebsiteIPMaskingConfigResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForWebsiteIPMaskingConfig()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createWebsiteIPMaskingConfigResourceTestStep(httpServer.GetPort(), restapi.IPMaskingStrict),
+			testStepImport(testWebsiteIPMaskingConfigDefinition),
+			createWebsiteIPMaskingConfigResourceTestStep(httpServer.GetPort(), restapi.IPMaskingRemoveAllDetails),
+			testStepImport(testWebsiteIPMaskingConfigDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForWebsiteIPMaskingConfig creates a mock server which keeps the IP masking of the test website in memory
+func createMockHttpServerForWebsiteIPMaskingConfig() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	config := []byte(`{"ipMasking":"DEFAULT"}`)
+	path := restapi.WebsiteMonitoringConfigResourcePath + "/" + testWebsiteID + "/" + restapi.IPMaskingConfigurationPathElement
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		httpServer.WriteJSONResponse(w, config)
+	})
+	httpServer.AddRoute(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if !json.Valid(body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		config = body
+		httpServer.WriteJSONResponse(w, config)
+	})
+	return httpServer
+}
+
+func createWebsiteIPMaskingConfigResourceTestStep(httpPort int, masking restapi.IPMasking) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceWebsiteIPMaskingConfigDefinitionTemplate, testWebsiteID, masking), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testWebsiteIPMaskingConfigDefinition, "id", testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteIPMaskingConfigDefinition, WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID),
+			resource.TestCheckResourceAttr(testWebsiteIPMaskingConfigDefinition, IPMaskingConfigFieldIPMasking, string(masking)),
+		),
+	}
+}
+
+func TestWebsiteIPMaskingConfigSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewWebsiteIPMaskingConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(WebsiteMonitoringSettingsFieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(IPMaskingConfigFieldIPMasking)
+	require.True(t, resourceSchema[WebsiteMonitoringSettingsFieldWebsiteID].ForceNew)
+}
+
+func TestShouldReturnCorrectResourceNameForWebsiteIPMaskingConfigResource(t *testing.T) {
+	name := NewWebsiteIPMaskingConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_website_ip_masking_config", name)
+}
+
+func TestWebsiteIPMaskingConfigResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewWebsiteIPMaskingConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateWebsiteIPMaskingConfigTerraformResourceStateFromModel(t *testing.T) {
+	config := &restapi.IPMaskingConfiguration{MonitoringConfigID: testWebsiteID, IPMasking: restapi.IPMaskingStrict}
+
+	testHelper := NewTestHelper(t)
+	sut := NewWebsiteIPMaskingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, testWebsiteID, resourceData.Id())
+	require.Equal(t, testWebsiteID, resourceData.Get(WebsiteMonitoringSettingsFieldWebsiteID))
+	require.Equal(t, "STRICT", resourceData.Get(IPMaskingConfigFieldIPMasking))
+}
+
+func TestShouldSuccessfullyConvertWebsiteIPMaskingConfigStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewWebsiteIPMaskingConfigResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(WebsiteMonitoringSettingsFieldWebsiteID, testWebsiteID)
+	resourceData.Set(IPMaskingConfigFieldIPMasking, "REMOVE_ALL_DETAILS")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.IPMaskingConfiguration{MonitoringConfigID: testWebsiteID, IPMasking: restapi.IPMaskingRemoveAllDetails}, result)
+}
+
+func TestShouldReturnCorrectResourceNameAndSchemaForMobileAppIPMaskingConfigResource(t *testing.T) {
+	metaData := NewMobileAppIPMaskingConfigResourceHandle().MetaData()
+
+	require.Equal(t, "instana_mobile_app_ip_masking_config", metaData.ResourceName)
+	schemaAssert := testutils.NewTerraformSchemaAssert(metaData.Schema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppSettingsFieldMobileAppID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(IPMaskingConfigFieldIPMasking)
+	require.NotContains(t, metaData.Schema, WebsiteMonitoringSettingsFieldWebsiteID)
+}
+
+func TestShouldUpdateMobileAppIPMaskingConfigTerraformResourceStateFromModel(t *testing.T) {
+	config := &restapi.IPMaskingConfiguration{MonitoringConfigID: "mobile-app-id", IPMasking: restapi.IPMaskingStrict}
+
+	testHelper := NewTestHelper(t)
+	sut := NewMobileAppIPMaskingConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, "mobile-app-id", resourceData.Id())
+	require.Equal(t, "mobile-app-id", resourceData.Get(MobileAppSettingsFieldMobileAppID))
+	require.Equal(t, "STRICT", resourceData.Get(IPMaskingConfigFieldIPMasking))
+}
@@ -0,0 +1,174 @@
+package instana
+
+import (
+	"time"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaMaintenanceWindow the name of the terraform-provider-instana resource to manage maintenance windows
+const ResourceInstanaMaintenanceWindow = "instana_maintenance_window"
+
+const (
+	//MaintenanceWindowFieldName constant value for the schema field name
+	MaintenanceWindowFieldName = "name"
+	//MaintenanceWindowFieldFullName constant value for the schema field full_name
+	MaintenanceWindowFieldFullName = "full_name"
+	//MaintenanceWindowFieldQuery constant value for the schema field query
+	MaintenanceWindowFieldQuery = "query"
+	//MaintenanceWindowFieldWindow constant value for the schema field window
+	MaintenanceWindowFieldWindow = "window"
+	//MaintenanceWindowFieldWindowStart constant value for the schema field window.start
+	MaintenanceWindowFieldWindowStart = "start"
+	//MaintenanceWindowFieldWindowEnd constant value for the schema field window.end
+	MaintenanceWindowFieldWindowEnd = "end"
+)
+
+var (
+	//MaintenanceWindowName schema field definition of instana_maintenance_window field name
+	MaintenanceWindowName = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The name of the maintenance window",
+	}
+	//MaintenanceWindowFullName schema field definition of instana_maintenance_window field full_name
+	MaintenanceWindowFullName = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The full name of the maintenance window. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+	}
+	//MaintenanceWindowQuery schema field definition of instana_maintenance_window field query
+	MaintenanceWindowQuery = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 2048),
+		Description:  "The dynamic focus query which selects the entities affected by the maintenance window",
+	}
+	//MaintenanceWindowWindow schema field definition of instana_maintenance_window field window
+	MaintenanceWindowWindow = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MinItems:    0,
+		MaxItems:    restapi.MaxMaintenanceWindowsPerConfig,
+		Description: "The time window in which the maintenance window is active. The maintenance window is not scheduled when no window is defined",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				MaintenanceWindowFieldWindowStart: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.IsRFC3339Time,
+					DiffSuppressFunc: suppressDiffOfEqualRFC3339Timestamps,
+					Description:      "The start of the maintenance window as RFC 3339 timestamp, e.g. 2023-01-01T22:00:00+01:00",
+				},
+				MaintenanceWindowFieldWindowEnd: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateFunc:     validation.IsRFC3339Time,
+					DiffSuppressFunc: suppressDiffOfEqualRFC3339Timestamps,
+					Description:      "The end of the maintenance window as RFC 3339 timestamp, e.g. 2023-01-02T02:00:00+01:00. The end must be after the start",
+				},
+			},
+		},
+	}
+)
+
+//NewMaintenanceWindowResourceHandle creates a new instance of the ResourceHandle for maintenance windows
+func NewMaintenanceWindowResourceHandle() ResourceHandle {
+	return &maintenanceWindowResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMaintenanceWindow,
+			Schema: map[string]*schema.Schema{
+				MaintenanceWindowFieldName:     MaintenanceWindowName,
+				MaintenanceWindowFieldFullName: MaintenanceWindowFullName,
+				MaintenanceWindowFieldQuery:    MaintenanceWindowQuery,
+				MaintenanceWindowFieldWindow:   MaintenanceWindowWindow,
+			},
+		},
+	}
+}
+
+type maintenanceWindowResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *maintenanceWindowResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *maintenanceWindowResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *maintenanceWindowResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.MaintenanceWindowConfigs()
+}
+
+func (r *maintenanceWindowResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *maintenanceWindowResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.MaintenanceWindowConfig)
+
+	windows := make([]interface{}, len(config.Windows))
+	for i, w := range config.Windows {
+		windows[i] = map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: time.UnixMilli(w.Start).UTC().Format(time.RFC3339),
+			MaintenanceWindowFieldWindowEnd:   time.UnixMilli(w.End).UTC().Format(time.RFC3339),
+		}
+	}
+
+	d.Set(MaintenanceWindowFieldName, formatter.UndoFormat(config.Name))
+	d.Set(MaintenanceWindowFieldFullName, config.Name)
+	d.Set(MaintenanceWindowFieldQuery, config.Query)
+	d.Set(MaintenanceWindowFieldWindow, windows)
+	d.SetId(config.ID)
+	return nil
+}
+
+func (r *maintenanceWindowResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	windows, err := r.mapWindowsFromSchema(d)
+	if err != nil {
+		return &restapi.MaintenanceWindowConfig{}, err
+	}
+	return &restapi.MaintenanceWindowConfig{
+		ID:      d.Id(),
+		Name:    r.computeFullNameString(d, formatter),
+		Query:   d.Get(MaintenanceWindowFieldQuery).(string),
+		Windows: windows,
+	}, nil
+}
+
+//mapWindowsFromSchema maps the window of the schema to the API model. Instana supports only a single window per
+//config and replaces the window on each update. Therefore, the ID of the config is used as ID of the window
+func (r *maintenanceWindowResource) mapWindowsFromSchema(d *schema.ResourceData) ([]restapi.MaintenanceWindow, error) {
+	rawWindows := d.Get(MaintenanceWindowFieldWindow).([]interface{})
+	windows := make([]restapi.MaintenanceWindow, len(rawWindows))
+	for i, v := range rawWindows {
+		window := v.(map[string]interface{})
+		start, err := time.Parse(time.RFC3339, window[MaintenanceWindowFieldWindowStart].(string))
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(time.RFC3339, window[MaintenanceWindowFieldWindowEnd].(string))
+		if err != nil {
+			return nil, err
+		}
+		windows[i] = restapi.MaintenanceWindow{
+			ID:    d.Id(),
+			Start: start.UnixMilli(),
+			End:   end.UnixMilli(),
+		}
+	}
+	return windows, nil
+}
+
+func (r *maintenanceWindowResource) computeFullNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(MaintenanceWindowFieldName) {
+		return formatter.Format(d.Get(MaintenanceWindowFieldName).(string))
+	}
+	return d.Get(MaintenanceWindowFieldFullName).(string)
+}
@@ -0,0 +1,254 @@
+package instana_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceMaintenanceWindowDefinitionTemplate = `
+resource "instana_maintenance_window" "example" {
+  name  = "name %d"
+  query = "entity.zone:production"
+
+  window {
+    start = "2023-11-14T23:13:20+01:00"
+    end   = "2023-11-15T01:13:20+01:00"
+  }
+}
+`
+
+const maintenanceWindowServerResponseTemplate = `
+{
+	"id" : "%s",
+	"name" : "prefix name %d suffix",
+	"query" : "entity.zone:production",
+	"lastUpdated" : 1700000000001,
+	"windows" : [
+		{
+			"id" : "window-id",
+			"start" : 1700000000000,
+			"end" : 1700007200000
+		}
+	]
+}
+`
+
+const (
+	testMaintenanceWindowDefinition = "instana_maintenance_window.example"
+	maintenanceWindowID             = "maintenance-window-id"
+	maintenanceWindowStart          = "2023-11-14T23:13:20+01:00"
+	maintenanceWindowEnd            = "2023-11-15T01:13:20+01:00"
+	maintenanceWindowStartMillis    = int64(1700000000000)
+	maintenanceWindowEndMillis      = int64(1700007200000)
+)
+
+func TestCRUDOfMaintenanceWindowResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForResource(restapi.MaintenanceWindowConfigResourcePath, maintenanceWindowServerResponseTemplate)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createMaintenanceWindowResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testMaintenanceWindowDefinition),
+			createMaintenanceWindowResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testMaintenanceWindowDefinition),
+		},
+	})
+}
+
+func createMaintenanceWindowResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceMaintenanceWindowDefinitionTemplate, iteration), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(testMaintenanceWindowDefinition, "id"),
+			resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldFullName, formatResourceFullName(iteration)),
+			resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldQuery, "entity.zone:production"),
+			resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowStart, maintenanceWindowStart),
+			resource.TestCheckResourceAttr(testMaintenanceWindowDefinition, MaintenanceWindowFieldWindow+".0."+MaintenanceWindowFieldWindowEnd, maintenanceWindowEnd),
+		),
+	}
+}
+
+func TestMaintenanceWindowSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewMaintenanceWindowResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(MaintenanceWindowFieldFullName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldQuery)
+
+	windowSchema := resourceSchema[MaintenanceWindowFieldWindow]
+	require.Equal(t, schema.TypeList, windowSchema.Type)
+	require.True(t, windowSchema.Optional)
+	require.Equal(t, 0, windowSchema.MinItems)
+	require.Equal(t, 1, windowSchema.MaxItems)
+
+	windowSchemaAssert := testutils.NewTerraformSchemaAssert(windowSchema.Elem.(*schema.Resource).Schema, t)
+	windowSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldWindowStart)
+	windowSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(MaintenanceWindowFieldWindowEnd)
+}
+
+func TestShouldValidateQueryOfMaintenanceWindow(t *testing.T) {
+	querySchema := NewMaintenanceWindowResourceHandle().MetaData().Schema[MaintenanceWindowFieldQuery]
+
+	_, errs := querySchema.ValidateFunc("entity.zone:production", MaintenanceWindowFieldQuery)
+	require.Empty(t, errs)
+
+	_, errs = querySchema.ValidateFunc("", MaintenanceWindowFieldQuery)
+	require.NotEmpty(t, errs)
+}
+
+func TestShouldValidateStartAndEndOfMaintenanceWindow(t *testing.T) {
+	for _, field := range []string{MaintenanceWindowFieldWindowStart, MaintenanceWindowFieldWindowEnd} {
+		fieldSchema := getMaintenanceWindowWindowSchema(field)
+
+		_, errs := fieldSchema.ValidateFunc("2023-11-14T23:00:00+01:00", field)
+		require.Empty(t, errs, field)
+
+		_, errs = fieldSchema.ValidateFunc("2023-11-14 23:00", field)
+		require.NotEmpty(t, errs, field)
+	}
+}
+
+func TestShouldSuppressDiffOfStartAndEndOfMaintenanceWindowWhenTimestampsAreEqual(t *testing.T) {
+	for _, field := range []string{MaintenanceWindowFieldWindowStart, MaintenanceWindowFieldWindowEnd} {
+		fieldSchema := getMaintenanceWindowWindowSchema(field)
+
+		require.True(t, fieldSchema.DiffSuppressFunc(field, "2023-11-14T23:00:00+01:00", "2023-11-14T22:00:00Z", nil), field)
+		require.False(t, fieldSchema.DiffSuppressFunc(field, "2023-11-14T23:00:00+01:00", "2023-11-14T23:00:00Z", nil), field)
+		require.False(t, fieldSchema.DiffSuppressFunc(field, "", "2023-11-14T23:00:00Z", nil), field)
+	}
+}
+
+func getMaintenanceWindowWindowSchema(field string) *schema.Schema {
+	windowSchema := NewMaintenanceWindowResourceHandle().MetaData().Schema[MaintenanceWindowFieldWindow]
+	return windowSchema.Elem.(*schema.Resource).Schema[field]
+}
+
+func TestShouldReturnCorrectResourceNameForMaintenanceWindowResource(t *testing.T) {
+	name := NewMaintenanceWindowResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_maintenance_window", name)
+}
+
+func TestMaintenanceWindowResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldUpdateMaintenanceWindowTerraformResourceStateFromModel(t *testing.T) {
+	config := createTestMaintenanceWindowModel()
+	config.Windows[0].ID = "window-id"
+
+	testHelper := NewTestHelper(t)
+	sut := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, maintenanceWindowID, resourceData.Id())
+	require.Equal(t, "name", resourceData.Get(MaintenanceWindowFieldName))
+	require.Equal(t, "prefix name suffix", resourceData.Get(MaintenanceWindowFieldFullName))
+	require.Equal(t, "entity.zone:production", resourceData.Get(MaintenanceWindowFieldQuery))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: "2023-11-14T22:13:20Z",
+			MaintenanceWindowFieldWindowEnd:   "2023-11-15T00:13:20Z",
+		},
+	}, resourceData.Get(MaintenanceWindowFieldWindow))
+}
+
+func TestShouldUpdateMaintenanceWindowTerraformResourceStateFromModelWithoutWindow(t *testing.T) {
+	config := createTestMaintenanceWindowModel()
+	config.Windows = nil
+
+	testHelper := NewTestHelper(t)
+	sut := NewMaintenanceWindowResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Empty(t, resourceData.Get(MaintenanceWindowFieldWindow))
+}
+
+func TestShouldSuccessfullyConvertMaintenanceWindowStateToDataModelAndUseConfigIDAsWindowID(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	resourceData.Set(MaintenanceWindowFieldFullName, "prefix name suffix")
+	resourceData.Set(MaintenanceWindowFieldQuery, "entity.zone:production")
+	resourceData.Set(MaintenanceWindowFieldWindow, []interface{}{
+		map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: maintenanceWindowStart,
+			MaintenanceWindowFieldWindowEnd:   maintenanceWindowEnd,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, createTestMaintenanceWindowModel(), result)
+}
+
+func TestShouldSuccessfullyConvertMaintenanceWindowStateWithoutWindowToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMaintenanceWindowResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(maintenanceWindowID)
+	resourceData.Set(MaintenanceWindowFieldFullName, "prefix name suffix")
+	resourceData.Set(MaintenanceWindowFieldQuery, "entity.zone:production")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Empty(t, result.(*restapi.MaintenanceWindowConfig).Windows)
+}
+
+func TestShouldFailToConvertMaintenanceWindowStateToDataModelWhenTimestampIsNotValid(t *testing.T) {
+	for _, field := range []string{MaintenanceWindowFieldWindowStart, MaintenanceWindowFieldWindowEnd} {
+		testHelper := NewTestHelper(t)
+		resourceHandle := NewMaintenanceWindowResourceHandle()
+		window := map[string]interface{}{
+			MaintenanceWindowFieldWindowStart: maintenanceWindowStart,
+			MaintenanceWindowFieldWindowEnd:   maintenanceWindowEnd,
+		}
+		window[field] = "invalid"
+
+		resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+		resourceData.SetId(maintenanceWindowID)
+		resourceData.Set(MaintenanceWindowFieldQuery, "entity.zone:production")
+		resourceData.Set(MaintenanceWindowFieldWindow, []interface{}{window})
+
+		_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+		require.Error(t, err, field)
+	}
+}
+
+func createTestMaintenanceWindowModel() *restapi.MaintenanceWindowConfig {
+	return &restapi.MaintenanceWindowConfig{
+		ID:      maintenanceWindowID,
+		Name:    "prefix name suffix",
+		Query:   "entity.zone:production",
+		Windows: []restapi.MaintenanceWindow{{ID: maintenanceWindowID, Start: maintenanceWindowStartMillis, End: maintenanceWindowEndMillis}},
+	}
+}
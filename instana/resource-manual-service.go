@@ -0,0 +1,171 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//ResourceInstanaManualService the name of the terraform-provider-instana resource to manage manual service configs
+const ResourceInstanaManualService = "instana_manual_service"
+
+const (
+	//ManualServiceFieldTagFilter constant value for the schema field tag_filter
+	ManualServiceFieldTagFilter = "tag_filter"
+	//ManualServiceFieldDescription constant value for the schema field description
+	ManualServiceFieldDescription = "description"
+	//ManualServiceFieldEnabled constant value for the schema field enabled
+	ManualServiceFieldEnabled = "enabled"
+	//ManualServiceFieldExistingServiceID constant value for the schema field existing_service_id
+	ManualServiceFieldExistingServiceID = "existing_service_id"
+	//ManualServiceFieldUnmonitoredServiceName constant value for the schema field unmonitored_service_name
+	ManualServiceFieldUnmonitoredServiceName = "unmonitored_service_name"
+)
+
+var manualServiceTargetServiceFields = []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}
+
+var (
+	//ManualServiceTagFilter schema field definition of instana_manual_service field tag_filter
+	ManualServiceTagFilter = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The tag filter to select the calls which are mapped to the service. Use @src and @dest to match the source or destination of the calls",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			normalized, err := tagfilter.Normalize(new)
+			if err == nil {
+				return normalized == old
+			}
+			return old == new
+		},
+		StateFunc: func(val interface{}) string {
+			normalized, err := tagfilter.Normalize(val.(string))
+			if err == nil {
+				return normalized
+			}
+			return val.(string)
+		},
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			if _, err := tagfilter.NewParser().Parse(v); err != nil {
+				errs = append(errs, fmt.Errorf("%q is not a valid tag filter; %s", key, err))
+			}
+
+			return
+		},
+	}
+	//ManualServiceDescription schema field definition of instana_manual_service field description
+	ManualServiceDescription = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The description of the manual service config",
+	}
+	//ManualServiceEnabled schema field definition of instana_manual_service field enabled
+	ManualServiceEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Flag to indicate if the manual service config is enabled",
+	}
+	//ManualServiceExistingServiceID schema field definition of instana_manual_service field existing_service_id
+	ManualServiceExistingServiceID = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: manualServiceTargetServiceFields,
+		Description:  "The ID of an existing service to which the selected calls are mapped",
+	}
+	//ManualServiceUnmonitoredServiceName schema field definition of instana_manual_service field unmonitored_service_name
+	ManualServiceUnmonitoredServiceName = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: manualServiceTargetServiceFields,
+		Description:  "The name of the service of the unmonitored component (e.g. database or third-party API) to which the selected calls are mapped",
+	}
+)
+
+//NewManualServiceResourceHandle creates a new instance of the ResourceHandle for manual service configs
+func NewManualServiceResourceHandle() ResourceHandle {
+	return &manualServiceResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaManualService,
+			Schema: map[string]*schema.Schema{
+				ManualServiceFieldTagFilter:              ManualServiceTagFilter,
+				ManualServiceFieldDescription:            ManualServiceDescription,
+				ManualServiceFieldEnabled:                ManualServiceEnabled,
+				ManualServiceFieldExistingServiceID:      ManualServiceExistingServiceID,
+				ManualServiceFieldUnmonitoredServiceName: ManualServiceUnmonitoredServiceName,
+			},
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type manualServiceResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *manualServiceResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *manualServiceResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *manualServiceResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.ManualServiceConfigs()
+}
+
+func (r *manualServiceResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *manualServiceResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.ManualServiceConfig)
+
+	var normalizedTagFilterString *string
+	var err error
+	if config.TagFilterExpression != nil {
+		normalizedTagFilterString, err = tagfilter.MapTagFilterToNormalizedString(config.TagFilterExpression.(restapi.TagFilterExpressionElement))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.Set(ManualServiceFieldTagFilter, normalizedTagFilterString)
+	d.Set(ManualServiceFieldDescription, config.Description)
+	d.Set(ManualServiceFieldEnabled, config.Enabled)
+	d.Set(ManualServiceFieldExistingServiceID, config.ExistingServiceID)
+	d.Set(ManualServiceFieldUnmonitoredServiceName, config.UnmonitoredServiceName)
+	d.SetId(config.ID)
+	return nil
+}
+
+func (r *manualServiceResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	tagFilter, err := r.mapTagFilterStringToAPIModel(d.Get(ManualServiceFieldTagFilter).(string))
+	if err != nil {
+		return &restapi.ManualServiceConfig{}, err
+	}
+
+	return &restapi.ManualServiceConfig{
+		ID:                     d.Id(),
+		Description:            GetStringPointerFromResourceData(d, ManualServiceFieldDescription),
+		Enabled:                d.Get(ManualServiceFieldEnabled).(bool),
+		ExistingServiceID:      GetStringPointerFromResourceData(d, ManualServiceFieldExistingServiceID),
+		UnmonitoredServiceName: GetStringPointerFromResourceData(d, ManualServiceFieldUnmonitoredServiceName),
+		TagFilterExpression:    tagFilter,
+	}, nil
+}
+
+func (r *manualServiceResource) mapTagFilterStringToAPIModel(input string) (restapi.TagFilterExpressionElement, error) {
+	parser := tagfilter.NewParser()
+	expr, err := parser.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := tagfilter.NewMapper()
+	return mapper.ToAPIModel(expr), nil
+}
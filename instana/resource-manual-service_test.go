@@ -0,0 +1,211 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceManualServiceDefinitionTemplate = `
+resource "instana_manual_service" "example" {
+  tag_filter               = "call.database.connection@dest EQUALS 'jdbc:mysql://db:3306'"
+  description              = "description %d"
+  unmonitored_service_name = "my-database"
+}
+`
+
+const (
+	testManualServiceDefinition = "instana_manual_service.example"
+	manualServiceID             = "manual-service-id"
+	manualServiceTagFilter      = "call.database.connection@dest EQUALS 'jdbc:mysql://db:3306'"
+)
+
+func TestCRUDOfManualServiceResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForManualService()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createManualServiceResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testManualServiceDefinition),
+			createManualServiceResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testManualServiceDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForManualService creates a mock server which stores the manual service config in memory. The ID is
+//generated by the server on creation and the config is only readable through the list endpoint like at Instana
+func createMockHttpServerForManualService() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	configs := make(map[string]*restapi.ManualServiceConfig)
+	httpServer := testutils.NewTestHTTPServer()
+	storeConfig := func(w http.ResponseWriter, r *http.Request, id string) {
+		lock.Lock()
+		defer lock.Unlock()
+		config := &restapi.ManualServiceConfig{}
+		if err := json.NewDecoder(r.Body).Decode(config); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		config.ID = id
+		configs[id] = config
+		data, _ := json.Marshal(config)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		storeConfig(w, r, manualServiceID)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.ManualServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		storeConfig(w, r, mux.Vars(r)["id"])
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.ManualServiceConfigResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.ManualServiceConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		result := make([]*restapi.ManualServiceConfig, 0)
+		for _, config := range configs {
+			result = append(result, config)
+		}
+		data, _ := json.Marshal(result)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	return httpServer
+}
+
+func createManualServiceResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceManualServiceDefinitionTemplate, iteration), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testManualServiceDefinition, "id", manualServiceID),
+			resource.TestCheckResourceAttr(testManualServiceDefinition, ManualServiceFieldTagFilter, manualServiceTagFilter),
+			resource.TestCheckResourceAttr(testManualServiceDefinition, ManualServiceFieldDescription, fmt.Sprintf("description %d", iteration)),
+			resource.TestCheckResourceAttr(testManualServiceDefinition, ManualServiceFieldEnabled, trueAsString),
+			resource.TestCheckResourceAttr(testManualServiceDefinition, ManualServiceFieldUnmonitoredServiceName, "my-database"),
+			resource.TestCheckNoResourceAttr(testManualServiceDefinition, ManualServiceFieldExistingServiceID),
+		),
+	}
+}
+
+func TestManualServiceSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewManualServiceResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ManualServiceFieldTagFilter)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldDescription)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(ManualServiceFieldEnabled, true)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldExistingServiceID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(ManualServiceFieldUnmonitoredServiceName)
+	require.Equal(t, []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}, resourceSchema[ManualServiceFieldExistingServiceID].ExactlyOneOf)
+	require.Equal(t, []string{ManualServiceFieldExistingServiceID, ManualServiceFieldUnmonitoredServiceName}, resourceSchema[ManualServiceFieldUnmonitoredServiceName].ExactlyOneOf)
+}
+
+func TestShouldReturnCorrectResourceNameForManualServiceResource(t *testing.T) {
+	name := NewManualServiceResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_manual_service", name)
+}
+
+func TestManualServiceResourceShouldSkipIDGenerationAsIDIsGeneratedByInstana(t *testing.T) {
+	require.True(t, NewManualServiceResourceHandle().MetaData().SkipIDGeneration)
+}
+
+func TestManualServiceResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewManualServiceResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldUpdateManualServiceTerraformResourceStateFromModel(t *testing.T) {
+	config := &restapi.ManualServiceConfig{
+		ID:                  manualServiceID,
+		Description:         utils.StringPtr("description"),
+		Enabled:             false,
+		ExistingServiceID:   utils.StringPtr("existing-service-id"),
+		TagFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", restapi.EqualsOperator, "jdbc:mysql://db:3306"),
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, manualServiceID, resourceData.Id())
+	require.Equal(t, manualServiceTagFilter, resourceData.Get(ManualServiceFieldTagFilter))
+	require.Equal(t, "description", resourceData.Get(ManualServiceFieldDescription))
+	require.False(t, resourceData.Get(ManualServiceFieldEnabled).(bool))
+	require.Equal(t, "existing-service-id", resourceData.Get(ManualServiceFieldExistingServiceID))
+	require.Equal(t, "", resourceData.Get(ManualServiceFieldUnmonitoredServiceName))
+}
+
+func TestShouldFailToUpdateManualServiceTerraformResourceStateWhenTagFilterIsNotValid(t *testing.T) {
+	config := &restapi.ManualServiceConfig{
+		ID:                     manualServiceID,
+		UnmonitoredServiceName: utils.StringPtr("my-database"),
+		TagFilterExpression:    restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", "INVALID", "foo"),
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewManualServiceResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, config, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyConvertManualServiceStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewManualServiceResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(manualServiceID)
+	resourceData.Set(ManualServiceFieldTagFilter, manualServiceTagFilter)
+	resourceData.Set(ManualServiceFieldEnabled, true)
+	resourceData.Set(ManualServiceFieldUnmonitoredServiceName, "my-database")
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.ManualServiceConfig{
+		ID:                     manualServiceID,
+		Enabled:                true,
+		UnmonitoredServiceName: utils.StringPtr("my-database"),
+		TagFilterExpression:    restapi.NewStringTagFilter(restapi.TagFilterEntityDestination, "call.database.connection", restapi.EqualsOperator, "jdbc:mysql://db:3306"),
+	}, result)
+}
+
+func TestShouldFailToConvertManualServiceStateToDataModelWhenTagFilterIsNotValid(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewManualServiceResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(manualServiceID)
+	resourceData.Set(ManualServiceFieldTagFilter, "invalid invalid")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
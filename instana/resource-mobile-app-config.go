@@ -0,0 +1,101 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaMobileAppConfig the name of the terraform-provider-instana resource to manage mobile app monitoring configurations
+const ResourceInstanaMobileAppConfig = "instana_mobile_app_config"
+
+const (
+	//MobileAppConfigFieldName constant value for the schema field name
+	MobileAppConfigFieldName = "name"
+	//MobileAppConfigFieldFullName constant value for the schema field full_name
+	MobileAppConfigFieldFullName = "full_name"
+)
+
+//MobileAppConfigSchemaName schema field definition of instana_mobile_app_config field name
+var MobileAppConfigSchemaName = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ValidateFunc: validation.StringLenBetween(1, 128),
+	Description:  "Configures the name of the mobile app monitoring configuration",
+}
+
+//MobileAppConfigSchemaFullName schema field definition of instana_mobile_app_config field full_name
+var MobileAppConfigSchemaFullName = &schema.Schema{
+	Type:        schema.TypeString,
+	Required:    false,
+	Computed:    true,
+	Description: "Configures the full name field of the mobile app monitoring configuration. The field is computed and contains the name which is sent to instana. The computation depends on the configured default_name_prefix and default_name_suffix at provider level",
+}
+
+//MobileAppSettingsFieldMobileAppID constant value for the schema field mobile_app_id of the resources which manage settings of a mobile app monitoring configuration
+const MobileAppSettingsFieldMobileAppID = "mobile_app_id"
+
+//MobileAppSettingsMobileAppID schema field definition of the field mobile_app_id of the resources which manage settings of a mobile app monitoring configuration
+var MobileAppSettingsMobileAppID = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ForceNew:     true,
+	ValidateFunc: validation.StringIsNotWhiteSpace,
+	Description:  "The ID of the mobile app monitoring configuration",
+}
+
+//NewMobileAppConfigResourceHandle creates the resource handle for Mobile App Monitoring Configurations
+func NewMobileAppConfigResourceHandle() ResourceHandle {
+	return &mobileAppConfigResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaMobileAppConfig,
+			Schema: map[string]*schema.Schema{
+				MobileAppConfigFieldName:     MobileAppConfigSchemaName,
+				MobileAppConfigFieldFullName: MobileAppConfigSchemaFullName,
+			},
+		},
+	}
+}
+
+type mobileAppConfigResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *mobileAppConfigResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *mobileAppConfigResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *mobileAppConfigResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.MobileAppConfig()
+}
+
+func (r *mobileAppConfigResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *mobileAppConfigResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	config := obj.(*restapi.MobileAppConfig)
+	d.Set(MobileAppConfigFieldName, formatter.UndoFormat(config.Name))
+	d.Set(MobileAppConfigFieldFullName, config.Name)
+	d.SetId(config.ID)
+	return nil
+}
+
+func (r *mobileAppConfigResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.MobileAppConfig{
+		ID:   d.Id(),
+		Name: r.computeFullMobileAppNameString(d, formatter),
+	}, nil
+}
+
+func (r *mobileAppConfigResource) computeFullMobileAppNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(MobileAppConfigFieldName) {
+		return formatter.Format(d.Get(MobileAppConfigFieldName).(string))
+	}
+	return d.Get(MobileAppConfigFieldFullName).(string)
+}
@@ -0,0 +1,163 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const mobileAppConfigTerraformTemplate = `
+resource "instana_mobile_app_config" "example_mobile_app_config" {
+	name = "name %d"
+}
+`
+
+const (
+	mobileAppConfigApiPath    = restapi.MobileAppConfigResourcePath + "/{id}"
+	mobileAppConfigDefinition = "instana_mobile_app_config.example_mobile_app_config"
+)
+
+func TestCRUDOfMobileAppConfigurationWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForMobileAppConfig()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createMobileAppConfigResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(mobileAppConfigDefinition),
+			createMobileAppConfigResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(mobileAppConfigDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForMobileAppConfig creates a mock server which creates and updates mobile apps by the name query
+//parameter. Like the Instana API, the server only provides the list of all mobile apps to read a mobile app
+func createMockHttpServerForMobileAppConfig() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	var serverState *restapi.MobileAppConfig
+	httpServer := testutils.NewTestHTTPServer()
+	writeState := func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if serverState == nil || (vars["id"] != "" && vars["id"] != serverState.ID) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, _ := json.Marshal(serverState)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.MobileAppConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		serverState = &restapi.MobileAppConfig{ID: utils.RandomString(10), Name: name}
+		writeState(w, r)
+	})
+	httpServer.AddRoute(http.MethodPut, mobileAppConfigApiPath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if serverState != nil && mux.Vars(r)["id"] == serverState.ID {
+			serverState.Name = name
+		}
+		writeState(w, r)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.MobileAppConfigResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		configs := make([]*restapi.MobileAppConfig, 0)
+		if serverState != nil {
+			configs = append(configs, serverState)
+		}
+		data, _ := json.Marshal(configs)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodDelete, mobileAppConfigApiPath, testutils.EchoHandlerFunc)
+	return httpServer
+}
+
+func createMobileAppConfigResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(mobileAppConfigTerraformTemplate, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(mobileAppConfigDefinition, "id"),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, MobileAppConfigFieldName, fmt.Sprintf("name %d", iteration)),
+			resource.TestCheckResourceAttr(mobileAppConfigDefinition, MobileAppConfigFieldFullName, fmt.Sprintf("prefix name %d suffix", iteration)),
+		),
+	}
+}
+
+func TestResourceMobileAppConfigDefinition(t *testing.T) {
+	schemaMap := NewMobileAppConfigResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(MobileAppConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(MobileAppConfigFieldFullName)
+}
+
+func TestShouldUpdateResourceStateForMobileAppConfig(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := restapi.MobileAppConfig{
+		ID:   "id",
+		Name: resourceFullName,
+	}
+
+	err := resourceHandle.UpdateState(resourceData, &data, testHelper.ResourceFormatter())
+
+	require.Nil(t, err)
+	require.Equal(t, "id", resourceData.Id())
+	require.Equal(t, "name", resourceData.Get(MobileAppConfigFieldName))
+	require.Equal(t, resourceFullName, resourceData.Get(MobileAppConfigFieldFullName))
+}
+
+func TestShouldConvertStateOfMobileAppConfigToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewMobileAppConfigResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId("id")
+	resourceData.Set(MobileAppConfigFieldName, "name")
+	resourceData.Set(MobileAppConfigFieldFullName, resourceFullName)
+
+	model, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.Nil(t, err)
+	require.IsType(t, &restapi.MobileAppConfig{}, model)
+	require.Equal(t, "id", model.GetIDForResourcePath())
+	require.Equal(t, resourceFullName, model.(*restapi.MobileAppConfig).Name)
+}
+
+func TestMobileAppConfigShouldHaveSchemaVersionZeroAndNoStateUpgrader(t *testing.T) {
+	resourceHandle := NewMobileAppConfigResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldReturnCorrectResourceNameForMobileAppConfig(t *testing.T) {
+	name := NewMobileAppConfigResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_mobile_app_config", name)
+}
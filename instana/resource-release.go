@@ -0,0 +1,188 @@
+package instana
+
+import (
+	"time"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaRelease the name of the terraform-provider-instana resource to manage releases
+const ResourceInstanaRelease = "instana_release"
+
+const (
+	//ReleaseFieldName constant value for the schema field name
+	ReleaseFieldName = "name"
+	//ReleaseFieldStart constant value for the schema field start
+	ReleaseFieldStart = "start"
+	//ReleaseFieldApplicationIDs constant value for the schema field application_ids
+	ReleaseFieldApplicationIDs = "application_ids"
+	//ReleaseFieldService constant value for the schema field service
+	ReleaseFieldService = "service"
+	//ReleaseFieldServiceName constant value for the schema field service.name
+	ReleaseFieldServiceName = "name"
+	//ReleaseFieldServiceApplicationIDs constant value for the schema field service.application_ids
+	ReleaseFieldServiceApplicationIDs = "application_ids"
+)
+
+var (
+	//ReleaseName schema field definition of instana_release field name
+	ReleaseName = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 256),
+		Description:  "The name of the release, e.g. the version which is deployed",
+	}
+	//ReleaseStart schema field definition of instana_release field start
+	ReleaseStart = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: suppressDiffOfEqualRFC3339Timestamps,
+		Description:      "The start of the release as RFC 3339 timestamp, e.g. 2023-01-01T22:00:00+01:00",
+	}
+	//ReleaseApplicationIDs schema field definition of instana_release field application_ids
+	ReleaseApplicationIDs = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: restapi.MaxReleaseScopes,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "The IDs of the application perspectives to which the release is scoped",
+	}
+	//ReleaseService schema field definition of instana_release field service
+	ReleaseService = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    restapi.MaxReleaseScopes,
+		Description: "The services to which the release is scoped",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldServiceName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+					Description:  "The name of the service",
+				},
+				ReleaseFieldServiceApplicationIDs: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MaxItems: restapi.MaxReleaseScopes,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "The IDs of the application perspectives to which the service of the release is scoped",
+				},
+			},
+		},
+	}
+)
+
+//NewReleaseResourceHandle creates a new instance of the ResourceHandle for releases
+func NewReleaseResourceHandle() ResourceHandle {
+	return &releaseResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaRelease,
+			Schema: map[string]*schema.Schema{
+				ReleaseFieldName:           ReleaseName,
+				ReleaseFieldStart:          ReleaseStart,
+				ReleaseFieldApplicationIDs: ReleaseApplicationIDs,
+				ReleaseFieldService:        ReleaseService,
+			},
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type releaseResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *releaseResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *releaseResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *releaseResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.Releases()
+}
+
+func (r *releaseResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *releaseResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	release := obj.(*restapi.Release)
+
+	d.Set(ReleaseFieldName, release.Name)
+	d.Set(ReleaseFieldStart, time.UnixMilli(release.Start).UTC().Format(time.RFC3339))
+	d.Set(ReleaseFieldApplicationIDs, r.mapApplicationIDsToSchema(release.Applications))
+	d.Set(ReleaseFieldService, r.mapServicesToSchema(release.Services))
+	d.SetId(release.ID)
+	return nil
+}
+
+func (r *releaseResource) mapServicesToSchema(services []restapi.ReleaseService) []interface{} {
+	result := make([]interface{}, len(services))
+	for i, service := range services {
+		applicationIDs := make([]string, 0)
+		if service.ScopedTo != nil {
+			applicationIDs = r.mapApplicationIDsToSchema(service.ScopedTo.Applications)
+		}
+		result[i] = map[string]interface{}{
+			ReleaseFieldServiceName:           service.Name,
+			ReleaseFieldServiceApplicationIDs: applicationIDs,
+		}
+	}
+	return result
+}
+
+func (r *releaseResource) mapApplicationIDsToSchema(applications []restapi.ReleaseApplication) []string {
+	result := make([]string, len(applications))
+	for i, application := range applications {
+		result[i] = application.ID
+	}
+	return result
+}
+
+func (r *releaseResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	start, err := time.Parse(time.RFC3339, d.Get(ReleaseFieldStart).(string))
+	if err != nil {
+		return &restapi.Release{}, err
+	}
+
+	return &restapi.Release{
+		ID:           d.Id(),
+		Name:         d.Get(ReleaseFieldName).(string),
+		Start:        start.UnixMilli(),
+		Applications: r.mapApplicationIDsFromSchema(d.Get(ReleaseFieldApplicationIDs).(*schema.Set)),
+		Services:     r.mapServicesFromSchema(d),
+	}, nil
+}
+
+func (r *releaseResource) mapServicesFromSchema(d *schema.ResourceData) []restapi.ReleaseService {
+	rawServices := d.Get(ReleaseFieldService).([]interface{})
+	result := make([]restapi.ReleaseService, len(rawServices))
+	for i, v := range rawServices {
+		service := v.(map[string]interface{})
+		result[i] = restapi.ReleaseService{Name: service[ReleaseFieldServiceName].(string)}
+		if applicationIDs, ok := service[ReleaseFieldServiceApplicationIDs].(*schema.Set); ok && applicationIDs.Len() > 0 {
+			result[i].ScopedTo = &restapi.ReleaseServiceScope{Applications: r.mapApplicationIDsFromSchema(applicationIDs)}
+		}
+	}
+	return result
+}
+
+func (r *releaseResource) mapApplicationIDsFromSchema(applicationIDs *schema.Set) []restapi.ReleaseApplication {
+	result := make([]restapi.ReleaseApplication, applicationIDs.Len())
+	for i, v := range applicationIDs.List() {
+		result[i] = restapi.ReleaseApplication{ID: v.(string)}
+	}
+	return result
+}
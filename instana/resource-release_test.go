@@ -0,0 +1,265 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceReleaseDefinitionTemplate = `
+resource "instana_release" "example" {
+  name            = "release %d"
+  start           = "2023-11-14T23:13:20+01:00"
+  application_ids = [ "release-application-id" ]
+
+  service {
+    name            = "service"
+    application_ids = [ "release-application-id" ]
+  }
+}
+`
+
+const (
+	testReleaseDefinition = "instana_release.example"
+	releaseID             = "release-id"
+	releaseApplicationID  = "release-application-id"
+	releaseStart          = "2023-11-14T23:13:20+01:00"
+	releaseStartUTC       = "2023-11-14T22:13:20Z"
+	releaseStartMillis    = int64(1700000000000)
+)
+
+func TestCRUDOfReleaseResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForRelease()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createReleaseResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(testReleaseDefinition),
+			createReleaseResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(testReleaseDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForRelease creates a mock server which stores the release of the last create or update request and
+//which provides the application referenced by the release
+func createMockHttpServerForRelease() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	var release []byte
+	httpServer := testutils.NewTestHTTPServer()
+	storeRelease := func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		data := &restapi.Release{}
+		if err := json.Unmarshal(body, data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data.ID = releaseID
+		release, _ = json.Marshal(data)
+		httpServer.WriteJSONResponse(w, release)
+	}
+	httpServer.AddRoute(http.MethodPost, restapi.ReleasesResourcePath, storeRelease)
+	httpServer.AddRoute(http.MethodPut, restapi.ReleasesResourcePath+"/{id}", storeRelease)
+	httpServer.AddRoute(http.MethodGet, restapi.ReleasesResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if release == nil || mux.Vars(r)["id"] != releaseID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, release)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.ReleasesResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		release = nil
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.ApplicationConfigsResourcePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		if id != releaseApplicationID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, []byte(fmt.Sprintf(`{ "id" : "%s", "label" : "application" }`, id)))
+	})
+	return httpServer
+}
+
+func createReleaseResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceReleaseDefinitionTemplate, iteration), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testReleaseDefinition, "id", releaseID),
+			resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldName, fmt.Sprintf("release %d", iteration)),
+			resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldStart, releaseStart),
+			resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldApplicationIDs+".0", releaseApplicationID),
+			resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldService+".0."+ReleaseFieldServiceName, "service"),
+			resource.TestCheckResourceAttr(testReleaseDefinition, ReleaseFieldService+".0."+ReleaseFieldServiceApplicationIDs+".0", releaseApplicationID),
+		),
+	}
+}
+
+func TestShouldFailToCreateReleaseWhenReferencedApplicationDoesNotExist(t *testing.T) {
+	httpServer := createMockHttpServerForRelease()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := `
+resource "instana_release" "example" {
+  name            = "release"
+  start           = "2023-11-14T23:13:20+01:00"
+  application_ids = [ "unknown" ]
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      appendProviderConfig(config, httpServer.GetPort()),
+				ExpectError: regexp.MustCompile("application unknown referenced by the release does not exist"),
+			},
+		},
+	})
+}
+
+func TestReleaseSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewReleaseResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldName)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldStart)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(ReleaseFieldApplicationIDs)
+	require.Equal(t, restapi.MaxReleaseScopes, resourceSchema[ReleaseFieldApplicationIDs].MaxItems)
+	require.Equal(t, schema.TypeList, resourceSchema[ReleaseFieldService].Type)
+	require.True(t, resourceSchema[ReleaseFieldService].Optional)
+	require.Equal(t, restapi.MaxReleaseScopes, resourceSchema[ReleaseFieldService].MaxItems)
+
+	serviceSchemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema[ReleaseFieldService].Elem.(*schema.Resource).Schema, t)
+	serviceSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(ReleaseFieldServiceName)
+	serviceSchemaAssert.AssertSchemaIsOptionalAndOfTypeSetOfStrings(ReleaseFieldServiceApplicationIDs)
+}
+
+func TestShouldReturnCorrectResourceNameForReleaseResource(t *testing.T) {
+	name := NewReleaseResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_release", name)
+}
+
+func TestReleaseResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewReleaseResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestReleaseResourceShouldUseIDGeneratedByInstana(t *testing.T) {
+	require.True(t, NewReleaseResourceHandle().MetaData().SkipIDGeneration)
+}
+
+func TestShouldSuppressDiffOfReleaseStartWhenTimestampsRepresentTheSameInstant(t *testing.T) {
+	diffSuppressFunc := NewReleaseResourceHandle().MetaData().Schema[ReleaseFieldStart].DiffSuppressFunc
+
+	require.True(t, diffSuppressFunc(ReleaseFieldStart, releaseStartUTC, releaseStart, nil))
+	require.False(t, diffSuppressFunc(ReleaseFieldStart, releaseStartUTC, "2023-11-14T22:13:20+01:00", nil))
+	require.False(t, diffSuppressFunc(ReleaseFieldStart, "invalid", releaseStart, nil))
+}
+
+func TestShouldUpdateReleaseTerraformResourceStateFromModel(t *testing.T) {
+	release := &restapi.Release{
+		ID:           releaseID,
+		Name:         "release",
+		Start:        releaseStartMillis,
+		Applications: []restapi.ReleaseApplication{{ID: releaseApplicationID, Name: "application"}},
+		Services: []restapi.ReleaseService{
+			{Name: "service 1", ScopedTo: &restapi.ReleaseServiceScope{Applications: []restapi.ReleaseApplication{{ID: releaseApplicationID, Name: "application"}}}},
+			{Name: "service 2"},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewReleaseResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, release, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, releaseID, resourceData.Id())
+	require.Equal(t, "release", resourceData.Get(ReleaseFieldName))
+	require.Equal(t, releaseStartUTC, resourceData.Get(ReleaseFieldStart))
+	require.Equal(t, []interface{}{releaseApplicationID}, resourceData.Get(ReleaseFieldApplicationIDs).(*schema.Set).List())
+
+	services := resourceData.Get(ReleaseFieldService).([]interface{})
+	require.Len(t, services, 2)
+	require.Equal(t, "service 1", services[0].(map[string]interface{})[ReleaseFieldServiceName])
+	require.Equal(t, []interface{}{releaseApplicationID}, services[0].(map[string]interface{})[ReleaseFieldServiceApplicationIDs].(*schema.Set).List())
+	require.Equal(t, "service 2", services[1].(map[string]interface{})[ReleaseFieldServiceName])
+	require.Equal(t, 0, services[1].(map[string]interface{})[ReleaseFieldServiceApplicationIDs].(*schema.Set).Len())
+}
+
+func TestShouldSuccessfullyConvertReleaseStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewReleaseResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(releaseID)
+	resourceData.Set(ReleaseFieldName, "release")
+	resourceData.Set(ReleaseFieldStart, releaseStart)
+	resourceData.Set(ReleaseFieldApplicationIDs, []interface{}{releaseApplicationID})
+	resourceData.Set(ReleaseFieldService, []interface{}{
+		map[string]interface{}{
+			ReleaseFieldServiceName:           "service 1",
+			ReleaseFieldServiceApplicationIDs: []interface{}{releaseApplicationID},
+		},
+		map[string]interface{}{
+			ReleaseFieldServiceName: "service 2",
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.Release{
+		ID:           releaseID,
+		Name:         "release",
+		Start:        releaseStartMillis,
+		Applications: []restapi.ReleaseApplication{{ID: releaseApplicationID}},
+		Services: []restapi.ReleaseService{
+			{Name: "service 1", ScopedTo: &restapi.ReleaseServiceScope{Applications: []restapi.ReleaseApplication{{ID: releaseApplicationID}}}},
+			{Name: "service 2"},
+		},
+	}, result)
+}
+
+func TestShouldFailToConvertReleaseStateToDataModelWhenStartIsNotAValidTimestamp(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewReleaseResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.Set(ReleaseFieldName, "release")
+	resourceData.Set(ReleaseFieldStart, "invalid")
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaSessionSettings the name of the terraform-provider-instana resource to manage the tenant wide session settings
+const ResourceInstanaSessionSettings = "instana_session_settings"
+
+const (
+	//SessionSettingsFieldIdleTimeInMillis constant value for the schema field idle_time_in_millis
+	SessionSettingsFieldIdleTimeInMillis = "idle_time_in_millis"
+	//SessionSettingsFieldTokenLifeTimeInMillis constant value for the schema field token_life_time_in_millis
+	SessionSettingsFieldTokenLifeTimeInMillis = "token_life_time_in_millis"
+)
+
+var (
+	//SessionSettingsIdleTimeInMillis schema field definition of instana_session_settings field idle_time_in_millis
+	SessionSettingsIdleTimeInMillis = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(int(restapi.MinSessionIdleTimeInMillis), int(restapi.MaxSessionIdleTimeInMillis)),
+		Description:  "The time in milliseconds after which an idle session expires (1 minute to 8 hours). The current setting is kept when not defined",
+	}
+	//SessionSettingsTokenLifeTimeInMillis schema field definition of instana_session_settings field token_life_time_in_millis
+	SessionSettingsTokenLifeTimeInMillis = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(int(restapi.MinSessionTokenLifeTimeInMillis), int(restapi.MaxSessionTokenLifeTimeInMillis)),
+		Description:  "The time in milliseconds after which a session expires independent of the activity of the user (15 minutes to 7 days). The current setting is kept when not defined",
+	}
+)
+
+//NewSessionSettingsResourceHandle creates the resource handle for the tenant wide session settings. The settings exist
+//exactly once per tenant. Deleting the resource resets the settings to the defaults of Instana.
+func NewSessionSettingsResourceHandle() ResourceHandle {
+	return &sessionSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSessionSettings,
+			Schema: map[string]*schema.Schema{
+				SessionSettingsFieldIdleTimeInMillis:      SessionSettingsIdleTimeInMillis,
+				SessionSettingsFieldTokenLifeTimeInMillis: SessionSettingsTokenLifeTimeInMillis,
+			},
+			SingletonID: restapi.SessionSettingsPathElement,
+		},
+	}
+}
+
+type sessionSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *sessionSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *sessionSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *sessionSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.SessionSettings()
+}
+
+func (r *sessionSettingsResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *sessionSettingsResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	settings := obj.(*restapi.SessionSettings)
+	d.Set(SessionSettingsFieldIdleTimeInMillis, settings.IdleTimeInMillis)
+	d.Set(SessionSettingsFieldTokenLifeTimeInMillis, settings.TokenLifeTimeInMillis)
+	d.SetId(settings.GetIDForResourcePath())
+	return nil
+}
+
+func (r *sessionSettingsResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.SessionSettings{
+		IdleTimeInMillis:      GetInt64PointerFromResourceData(d, SessionSettingsFieldIdleTimeInMillis),
+		TokenLifeTimeInMillis: GetInt64PointerFromResourceData(d, SessionSettingsFieldTokenLifeTimeInMillis),
+	}, nil
+}
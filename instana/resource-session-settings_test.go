@@ -0,0 +1,146 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceSessionSettingsDefinitionTemplate = `
+resource "instana_session_settings" "example" {
+  idle_time_in_millis       = %d
+  token_life_time_in_millis = %d
+}
+`
+
+const (
+	testSessionSettingsDefinition = "instana_session_settings.example"
+	defaultSessionIdleTime        = int64(3600000)
+	defaultSessionTokenLifeTime   = int64(86400000)
+)
+
+func TestCRUDOfSessionSettingsResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForSessionSettings()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSessionSettingsResourceTestStep(httpServer.GetPort(), 1800000, 43200000),
+			testStepImport(testSessionSettingsDefinition),
+			createSessionSettingsResourceTestStep(httpServer.GetPort(), 7200000, 604800000),
+			testStepImport(testSessionSettingsDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForSessionSettings creates a mock server which keeps the session settings in memory. Delete
+//requests reset the settings to the defaults
+func createMockHttpServerForSessionSettings() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	settings := restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(defaultSessionIdleTime), TokenLifeTimeInMillis: utils.Int64Ptr(defaultSessionTokenLifeTime)}
+	httpServer := testutils.NewTestHTTPServer()
+	writeSettings := func(w http.ResponseWriter) {
+		data, _ := json.Marshal(settings)
+		httpServer.WriteJSONResponse(w, data)
+	}
+	httpServer.AddRoute(http.MethodGet, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &settings); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeSettings(w)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.SessionSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		settings = restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(defaultSessionIdleTime), TokenLifeTimeInMillis: utils.Int64Ptr(defaultSessionTokenLifeTime)}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
+func createSessionSettingsResourceTestStep(httpPort int, idleTime int, tokenLifeTime int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceSessionSettingsDefinitionTemplate, idleTime, tokenLifeTime), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testSessionSettingsDefinition, "id", restapi.SessionSettingsPathElement),
+			resource.TestCheckResourceAttr(testSessionSettingsDefinition, SessionSettingsFieldIdleTimeInMillis, fmt.Sprintf("%d", idleTime)),
+			resource.TestCheckResourceAttr(testSessionSettingsDefinition, SessionSettingsFieldTokenLifeTimeInMillis, fmt.Sprintf("%d", tokenLifeTime)),
+		),
+	}
+}
+
+func TestSessionSettingsSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewSessionSettingsResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SessionSettingsFieldIdleTimeInMillis)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SessionSettingsFieldTokenLifeTimeInMillis)
+	require.True(t, resourceSchema[SessionSettingsFieldIdleTimeInMillis].Computed)
+	require.True(t, resourceSchema[SessionSettingsFieldTokenLifeTimeInMillis].Computed)
+}
+
+func TestShouldReturnCorrectResourceNameForSessionSettingsResource(t *testing.T) {
+	name := NewSessionSettingsResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_session_settings", name)
+}
+
+func TestSessionSettingsResourceShouldBeASingletonWithSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewSessionSettingsResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.Equal(t, restapi.SessionSettingsPathElement, resourceHandle.MetaData().SingletonID)
+}
+
+func TestShouldUpdateSessionSettingsTerraformResourceStateFromModel(t *testing.T) {
+	settings := &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(defaultSessionIdleTime), TokenLifeTimeInMillis: utils.Int64Ptr(defaultSessionTokenLifeTime)}
+
+	testHelper := NewTestHelper(t)
+	sut := NewSessionSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, settings, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.SessionSettingsPathElement, resourceData.Id())
+	require.Equal(t, int(defaultSessionIdleTime), resourceData.Get(SessionSettingsFieldIdleTimeInMillis))
+	require.Equal(t, int(defaultSessionTokenLifeTime), resourceData.Get(SessionSettingsFieldTokenLifeTimeInMillis))
+}
+
+func TestShouldSuccessfullyConvertSessionSettingsStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSessionSettingsResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.SessionSettingsPathElement)
+	resourceData.Set(SessionSettingsFieldIdleTimeInMillis, defaultSessionIdleTime)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(defaultSessionIdleTime)}, result)
+}
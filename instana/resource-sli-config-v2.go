@@ -0,0 +1,555 @@
+package instana
+
+import (
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/instana/tagfilter"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaSliConfigV2 the name of the terraform-provider-instana resource to manage SLI configurations of version 2 of the Instana API
+const ResourceInstanaSliConfigV2 = "instana_sli_config_v2"
+
+const (
+	//SliConfigV2FieldApplicationTimeBased constant value for the schema field sli_entity.application_time_based
+	SliConfigV2FieldApplicationTimeBased = "application_time_based"
+	//SliConfigV2FieldApplicationEventBased constant value for the schema field sli_entity.application_event_based
+	SliConfigV2FieldApplicationEventBased = "application_event_based"
+	//SliConfigV2FieldWebsiteEventBased constant value for the schema field sli_entity.website_event_based
+	SliConfigV2FieldWebsiteEventBased = "website_event_based"
+	//SliConfigV2FieldWebsiteTimeBased constant value for the schema field sli_entity.website_time_based
+	SliConfigV2FieldWebsiteTimeBased = "website_time_based"
+	//SliConfigV2FieldWebsiteID constant value for the schema field sli_entity.website_*.website_id
+	SliConfigV2FieldWebsiteID = "website_id"
+	//SliConfigV2FieldBeaconType constant value for the schema field sli_entity.website_*.beacon_type
+	SliConfigV2FieldBeaconType = "beacon_type"
+	//SliConfigV2FieldFilterExpression constant value for the schema field sli_entity.website_time_based.filter_expression
+	SliConfigV2FieldFilterExpression = "filter_expression"
+	//SliConfigV2FieldGoodEventFilterExpression constant value for the schema field sli_entity.*_event_based.good_event_filter_expression
+	SliConfigV2FieldGoodEventFilterExpression = "good_event_filter_expression"
+	//SliConfigV2FieldBadEventFilterExpression constant value for the schema field sli_entity.*_event_based.bad_event_filter_expression
+	SliConfigV2FieldBadEventFilterExpression = "bad_event_filter_expression"
+	//SliConfigV2FieldIncludeInternal constant value for the schema field sli_entity.application_event_based.include_internal
+	SliConfigV2FieldIncludeInternal = "include_internal"
+	//SliConfigV2FieldIncludeSynthetic constant value for the schema field sli_entity.application_event_based.include_synthetic
+	SliConfigV2FieldIncludeSynthetic = "include_synthetic"
+)
+
+var sliConfigV2SliEntityTypes = []string{
+	SliConfigFieldSliEntity + ".0." + SliConfigV2FieldApplicationTimeBased,
+	SliConfigFieldSliEntity + ".0." + SliConfigV2FieldApplicationEventBased,
+	SliConfigFieldSliEntity + ".0." + SliConfigV2FieldWebsiteEventBased,
+	SliConfigFieldSliEntity + ".0." + SliConfigV2FieldWebsiteTimeBased,
+}
+
+//newSliConfigV2TagFilterSchema creates the schema of a tag filter field of a SLI entity. The tag filter is normalized
+//to avoid false positive changes
+func newSliConfigV2TagFilterSchema(required bool, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    required,
+		Optional:    !required,
+		ForceNew:    true,
+		Description: description,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			normalized, err := tagfilter.Normalize(new)
+			if err == nil {
+				return normalized == old
+			}
+			return old == new
+		},
+		StateFunc: func(val interface{}) string {
+			normalized, err := tagfilter.Normalize(val.(string))
+			if err == nil {
+				return normalized
+			}
+			return val.(string)
+		},
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			v := val.(string)
+			if _, err := tagfilter.NewParser().Parse(v); err != nil {
+				errs = append(errs, fmt.Errorf("%q is not a valid tag filter; %s", key, err))
+			}
+			return
+		},
+	}
+}
+
+func newSliConfigV2StringSchema(required bool, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    required,
+		Optional:    !required,
+		ForceNew:    true,
+		Description: description,
+	}
+}
+
+var (
+	sliConfigV2BoundaryScopeSchema = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedSliBoundaryScopes.ToStringSlice(), false),
+		Description:  "The boundary scope of the application SLI (ALL, INBOUND)",
+	}
+
+	sliConfigV2BeaconTypeSchema = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(restapi.SupportedBeaconTypes.ToStringSlice(), false),
+		Description:  "The beacon type of the website SLI",
+	}
+
+	//SliConfigV2MetricConfiguration schema field definition of instana_sli_config_v2 field metric_configuration
+	SliConfigV2MetricConfiguration = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Metric configuration of the SLI config. Required for time based SLIs",
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SliConfigFieldMetricName: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The metric name for the metric configuration",
+				},
+				SliConfigFieldMetricAggregation: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{"SUM", "MEAN", "MAX", "MIN", "P25", "P50", "P75", "P90", "P95", "P98", "P99", "DISTINCT_COUNT"}, true),
+					Description:  "The aggregation type for the metric configuration (SUM, MEAN, MAX, MIN, P25, P50, P75, P90, P95, P98, P99, DISTINCT_COUNT)",
+				},
+				SliConfigFieldMetricThreshold: {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.FloatAtLeast(0.000001),
+					Description:  "The threshold for the metric configuration",
+				},
+			},
+		},
+	}
+
+	//SliConfigV2SliEntity schema field definition of instana_sli_config_v2 field sli_entity
+	SliConfigV2SliEntity = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		MaxItems:    1,
+		Description: "The entity of the SLI config. Exactly one entity type must be configured",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SliConfigV2FieldApplicationTimeBased: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MaxItems:     1,
+					ExactlyOneOf: sliConfigV2SliEntityTypes,
+					Description:  "Time based SLI of an application, service or endpoint which is calculated from the metric configuration",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SliConfigFieldApplicationID: newSliConfigV2StringSchema(true, "The application ID of the entity"),
+							SliConfigFieldServiceID:     newSliConfigV2StringSchema(false, "The service ID of the entity"),
+							SliConfigFieldEndpointID:    newSliConfigV2StringSchema(false, "The endpoint ID of the entity"),
+							SliConfigFieldBoundaryScope: sliConfigV2BoundaryScopeSchema,
+						},
+					},
+				},
+				SliConfigV2FieldApplicationEventBased: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MaxItems:     1,
+					ExactlyOneOf: sliConfigV2SliEntityTypes,
+					Description:  "Event based SLI of an application, service or endpoint which is calculated from the ratio of good and bad calls",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SliConfigFieldApplicationID:               newSliConfigV2StringSchema(true, "The application ID of the entity"),
+							SliConfigFieldServiceID:                   newSliConfigV2StringSchema(false, "The service ID of the entity"),
+							SliConfigFieldEndpointID:                  newSliConfigV2StringSchema(false, "The endpoint ID of the entity"),
+							SliConfigFieldBoundaryScope:               sliConfigV2BoundaryScopeSchema,
+							SliConfigV2FieldGoodEventFilterExpression: newSliConfigV2TagFilterSchema(true, "The tag filter expression which defines the good calls"),
+							SliConfigV2FieldBadEventFilterExpression:  newSliConfigV2TagFilterSchema(true, "The tag filter expression which defines the bad calls"),
+							SliConfigV2FieldIncludeInternal: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								ForceNew:    true,
+								Default:     false,
+								Description: "Flag to include internal calls",
+							},
+							SliConfigV2FieldIncludeSynthetic: {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								ForceNew:    true,
+								Default:     false,
+								Description: "Flag to include synthetic calls",
+							},
+						},
+					},
+				},
+				SliConfigV2FieldWebsiteEventBased: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MaxItems:     1,
+					ExactlyOneOf: sliConfigV2SliEntityTypes,
+					Description:  "Event based SLI of a website which is calculated from the ratio of good and bad beacons",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SliConfigV2FieldWebsiteID:                 newSliConfigV2StringSchema(true, "The ID of the website"),
+							SliConfigV2FieldBeaconType:                sliConfigV2BeaconTypeSchema,
+							SliConfigV2FieldGoodEventFilterExpression: newSliConfigV2TagFilterSchema(true, "The tag filter expression which defines the good beacons"),
+							SliConfigV2FieldBadEventFilterExpression:  newSliConfigV2TagFilterSchema(true, "The tag filter expression which defines the bad beacons"),
+						},
+					},
+				},
+				SliConfigV2FieldWebsiteTimeBased: {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MaxItems:     1,
+					ExactlyOneOf: sliConfigV2SliEntityTypes,
+					Description:  "Time based SLI of a website which is calculated from the metric configuration",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							SliConfigV2FieldWebsiteID:        newSliConfigV2StringSchema(true, "The ID of the website"),
+							SliConfigV2FieldBeaconType:       sliConfigV2BeaconTypeSchema,
+							SliConfigV2FieldFilterExpression: newSliConfigV2TagFilterSchema(false, "The tag filter expression which restricts the beacons of the website"),
+						},
+					},
+				},
+			},
+		},
+	}
+)
+
+//NewSliConfigV2ResourceHandle creates the resource handle for SLI configurations of version 2 of the Instana API
+func NewSliConfigV2ResourceHandle() ResourceHandle {
+	return &sliConfigV2Resource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSliConfigV2,
+			Schema: map[string]*schema.Schema{
+				SliConfigFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+					Description:  "The name of the SLI config",
+				},
+				SliConfigFieldFullName: SliConfigFullName,
+				SliConfigFieldInitialEvaluationTimestamp: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     0,
+					Description: "Initial evaluation timestamp for the SLI config",
+				},
+				SliConfigFieldMetricConfiguration: SliConfigV2MetricConfiguration,
+				SliConfigFieldSliEntity:           SliConfigV2SliEntity,
+			},
+			SchemaVersion: 0,
+		},
+	}
+}
+
+type sliConfigV2Resource struct {
+	metaData ResourceMetaData
+}
+
+func (r *sliConfigV2Resource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *sliConfigV2Resource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *sliConfigV2Resource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.SliConfigsV2()
+}
+
+func (r *sliConfigV2Resource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *sliConfigV2Resource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	sliConfig := obj.(*restapi.SliConfigV2)
+
+	sliEntity, err := r.mapSliEntityToState(sliConfig.SliEntity)
+	if err != nil {
+		return err
+	}
+
+	metricConfiguration := make([]interface{}, 0)
+	if sliConfig.MetricConfiguration != nil {
+		metricConfiguration = append(metricConfiguration, map[string]interface{}{
+			SliConfigFieldMetricName:        sliConfig.MetricConfiguration.Name,
+			SliConfigFieldMetricAggregation: sliConfig.MetricConfiguration.Aggregation,
+			SliConfigFieldMetricThreshold:   sliConfig.MetricConfiguration.Threshold,
+		})
+	}
+
+	d.Set(SliConfigFieldName, formatter.UndoFormat(sliConfig.Name))
+	d.Set(SliConfigFieldFullName, sliConfig.Name)
+	d.Set(SliConfigFieldInitialEvaluationTimestamp, sliConfig.InitialEvaluationTimestamp)
+	d.Set(SliConfigFieldMetricConfiguration, metricConfiguration)
+	d.Set(SliConfigFieldSliEntity, []interface{}{sliEntity})
+
+	d.SetId(sliConfig.ID)
+	return nil
+}
+
+func (r *sliConfigV2Resource) mapSliEntityToState(entity restapi.SliEntityV2) (map[string]interface{}, error) {
+	switch entity.Type {
+	case restapi.SliTypeApplication:
+		return map[string]interface{}{
+			SliConfigV2FieldApplicationTimeBased: []interface{}{
+				map[string]interface{}{
+					SliConfigFieldApplicationID: r.stringValue(entity.ApplicationID),
+					SliConfigFieldServiceID:     r.stringValue(entity.ServiceID),
+					SliConfigFieldEndpointID:    r.stringValue(entity.EndpointID),
+					SliConfigFieldBoundaryScope: r.boundaryScopeValue(entity.BoundaryScope),
+				},
+			},
+		}, nil
+	case restapi.SliTypeAvailability:
+		goodEventFilter, badEventFilter, err := r.mapEventFilterExpressionsToState(entity)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			SliConfigV2FieldApplicationEventBased: []interface{}{
+				map[string]interface{}{
+					SliConfigFieldApplicationID:               r.stringValue(entity.ApplicationID),
+					SliConfigFieldServiceID:                   r.stringValue(entity.ServiceID),
+					SliConfigFieldEndpointID:                  r.stringValue(entity.EndpointID),
+					SliConfigFieldBoundaryScope:               r.boundaryScopeValue(entity.BoundaryScope),
+					SliConfigV2FieldGoodEventFilterExpression: goodEventFilter,
+					SliConfigV2FieldBadEventFilterExpression:  badEventFilter,
+					SliConfigV2FieldIncludeInternal:           entity.IncludeInternal != nil && *entity.IncludeInternal,
+					SliConfigV2FieldIncludeSynthetic:          entity.IncludeSynthetic != nil && *entity.IncludeSynthetic,
+				},
+			},
+		}, nil
+	case restapi.SliTypeWebsiteEventBased:
+		goodEventFilter, badEventFilter, err := r.mapEventFilterExpressionsToState(entity)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			SliConfigV2FieldWebsiteEventBased: []interface{}{
+				map[string]interface{}{
+					SliConfigV2FieldWebsiteID:                 r.stringValue(entity.WebsiteID),
+					SliConfigV2FieldBeaconType:                r.beaconTypeValue(entity.BeaconType),
+					SliConfigV2FieldGoodEventFilterExpression: goodEventFilter,
+					SliConfigV2FieldBadEventFilterExpression:  badEventFilter,
+				},
+			},
+		}, nil
+	case restapi.SliTypeWebsiteTimeBased:
+		filter, err := r.mapTagFilterToState(entity.FilterExpression)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			SliConfigV2FieldWebsiteTimeBased: []interface{}{
+				map[string]interface{}{
+					SliConfigV2FieldWebsiteID:        r.stringValue(entity.WebsiteID),
+					SliConfigV2FieldBeaconType:       r.beaconTypeValue(entity.BeaconType),
+					SliConfigV2FieldFilterExpression: filter,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("sli type %s is not supported", entity.Type)
+	}
+}
+
+func (r *sliConfigV2Resource) mapEventFilterExpressionsToState(entity restapi.SliEntityV2) (string, string, error) {
+	goodEventFilter, err := r.mapTagFilterToState(entity.GoodEventFilterExpression)
+	if err != nil {
+		return "", "", err
+	}
+	badEventFilter, err := r.mapTagFilterToState(entity.BadEventFilterExpression)
+	if err != nil {
+		return "", "", err
+	}
+	return goodEventFilter, badEventFilter, nil
+}
+
+func (r *sliConfigV2Resource) mapTagFilterToState(expression interface{}) (string, error) {
+	if expression == nil {
+		return "", nil
+	}
+	normalized, err := tagfilter.MapTagFilterToNormalizedString(expression.(restapi.TagFilterExpressionElement))
+	if err != nil {
+		return "", err
+	}
+	return r.stringValue(normalized), nil
+}
+
+func (r *sliConfigV2Resource) stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func (r *sliConfigV2Resource) boundaryScopeValue(value *restapi.BoundaryScope) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
+
+func (r *sliConfigV2Resource) beaconTypeValue(value *restapi.BeaconType) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
+
+func (r *sliConfigV2Resource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	var metricConfiguration *restapi.MetricConfiguration
+	metricConfigurationsStateObject := d.Get(SliConfigFieldMetricConfiguration).([]interface{})
+	if len(metricConfigurationsStateObject) > 0 && metricConfigurationsStateObject[0] != nil {
+		metricConfigurationState := metricConfigurationsStateObject[0].(map[string]interface{})
+		metricConfiguration = &restapi.MetricConfiguration{
+			Name:        metricConfigurationState[SliConfigFieldMetricName].(string),
+			Aggregation: metricConfigurationState[SliConfigFieldMetricAggregation].(string),
+			Threshold:   metricConfigurationState[SliConfigFieldMetricThreshold].(float64),
+		}
+	}
+
+	sliEntity, err := r.mapSliEntityFromState(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restapi.SliConfigV2{
+		ID:                         d.Id(),
+		Name:                       r.computeFullSliConfigNameString(d, formatter),
+		InitialEvaluationTimestamp: d.Get(SliConfigFieldInitialEvaluationTimestamp).(int),
+		MetricConfiguration:        metricConfiguration,
+		SliEntity:                  sliEntity,
+	}, nil
+}
+
+func (r *sliConfigV2Resource) mapSliEntityFromState(d *schema.ResourceData) (restapi.SliEntityV2, error) {
+	sliEntitiesStateObject := d.Get(SliConfigFieldSliEntity).([]interface{})
+	if len(sliEntitiesStateObject) == 0 || sliEntitiesStateObject[0] == nil {
+		return restapi.SliEntityV2{}, fmt.Errorf("exactly one sli entity type must be defined")
+	}
+	sliEntityState := sliEntitiesStateObject[0].(map[string]interface{})
+
+	if state, ok := r.getNestedState(sliEntityState, SliConfigV2FieldApplicationTimeBased); ok {
+		return restapi.SliEntityV2{
+			Type:          restapi.SliTypeApplication,
+			ApplicationID: r.optionalString(state, SliConfigFieldApplicationID),
+			ServiceID:     r.optionalString(state, SliConfigFieldServiceID),
+			EndpointID:    r.optionalString(state, SliConfigFieldEndpointID),
+			BoundaryScope: r.boundaryScopeFromState(state),
+		}, nil
+	}
+	if state, ok := r.getNestedState(sliEntityState, SliConfigV2FieldApplicationEventBased); ok {
+		entity := restapi.SliEntityV2{
+			Type:             restapi.SliTypeAvailability,
+			ApplicationID:    r.optionalString(state, SliConfigFieldApplicationID),
+			ServiceID:        r.optionalString(state, SliConfigFieldServiceID),
+			EndpointID:       r.optionalString(state, SliConfigFieldEndpointID),
+			BoundaryScope:    r.boundaryScopeFromState(state),
+			IncludeInternal:  utils.BoolPtr(state[SliConfigV2FieldIncludeInternal].(bool)),
+			IncludeSynthetic: utils.BoolPtr(state[SliConfigV2FieldIncludeSynthetic].(bool)),
+		}
+		return r.mapEventFilterExpressionsFromState(state, entity)
+	}
+	if state, ok := r.getNestedState(sliEntityState, SliConfigV2FieldWebsiteEventBased); ok {
+		entity := restapi.SliEntityV2{
+			Type:       restapi.SliTypeWebsiteEventBased,
+			WebsiteID:  r.optionalString(state, SliConfigV2FieldWebsiteID),
+			BeaconType: r.beaconTypeFromState(state),
+		}
+		return r.mapEventFilterExpressionsFromState(state, entity)
+	}
+	if state, ok := r.getNestedState(sliEntityState, SliConfigV2FieldWebsiteTimeBased); ok {
+		filter, err := r.mapTagFilterFromState(state, SliConfigV2FieldFilterExpression)
+		if err != nil {
+			return restapi.SliEntityV2{}, err
+		}
+		return restapi.SliEntityV2{
+			Type:             restapi.SliTypeWebsiteTimeBased,
+			WebsiteID:        r.optionalString(state, SliConfigV2FieldWebsiteID),
+			BeaconType:       r.beaconTypeFromState(state),
+			FilterExpression: filter,
+		}, nil
+	}
+	return restapi.SliEntityV2{}, fmt.Errorf("exactly one sli entity type must be defined")
+}
+
+func (r *sliConfigV2Resource) getNestedState(state map[string]interface{}, field string) (map[string]interface{}, bool) {
+	list, ok := state[field].([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil, false
+	}
+	return list[0].(map[string]interface{}), true
+}
+
+func (r *sliConfigV2Resource) mapEventFilterExpressionsFromState(state map[string]interface{}, entity restapi.SliEntityV2) (restapi.SliEntityV2, error) {
+	goodEventFilter, err := r.mapTagFilterFromState(state, SliConfigV2FieldGoodEventFilterExpression)
+	if err != nil {
+		return restapi.SliEntityV2{}, err
+	}
+	badEventFilter, err := r.mapTagFilterFromState(state, SliConfigV2FieldBadEventFilterExpression)
+	if err != nil {
+		return restapi.SliEntityV2{}, err
+	}
+	entity.GoodEventFilterExpression = goodEventFilter
+	entity.BadEventFilterExpression = badEventFilter
+	return entity, nil
+}
+
+func (r *sliConfigV2Resource) mapTagFilterFromState(state map[string]interface{}, field string) (interface{}, error) {
+	input, ok := state[field].(string)
+	if !ok || len(input) == 0 {
+		return nil, nil
+	}
+	expr, err := tagfilter.NewParser().Parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return tagfilter.NewMapper().ToAPIModel(expr), nil
+}
+
+func (r *sliConfigV2Resource) optionalString(state map[string]interface{}, field string) *string {
+	value, ok := state[field].(string)
+	if !ok || len(value) == 0 {
+		return nil
+	}
+	return &value
+}
+
+func (r *sliConfigV2Resource) boundaryScopeFromState(state map[string]interface{}) *restapi.BoundaryScope {
+	boundaryScope := restapi.BoundaryScope(state[SliConfigFieldBoundaryScope].(string))
+	return &boundaryScope
+}
+
+func (r *sliConfigV2Resource) beaconTypeFromState(state map[string]interface{}) *restapi.BeaconType {
+	beaconType := restapi.BeaconType(state[SliConfigV2FieldBeaconType].(string))
+	return &beaconType
+}
+
+func (r *sliConfigV2Resource) computeFullSliConfigNameString(d *schema.ResourceData, formatter utils.ResourceNameFormatter) string {
+	if d.HasChange(SliConfigFieldName) {
+		return formatter.Format(d.Get(SliConfigFieldName).(string))
+	}
+	return d.Get(SliConfigFieldFullName).(string)
+}
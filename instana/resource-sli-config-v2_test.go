@@ -0,0 +1,352 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const sliConfigV2TerraformTemplate = `
+resource "instana_sli_config_v2" "example" {
+	name = "name %d"
+	sli_entity {
+		website_event_based {
+			website_id                   = "website-id"
+			beacon_type                  = "PAGELOAD"
+			good_event_filter_expression = "beacon.page.name@na EQUALS 'page-%d'"
+			bad_event_filter_expression  = "beacon.error.message@na NOT_EMPTY"
+		}
+	}
+}
+`
+
+const (
+	sliConfigV2Definition          = "instana_sli_config_v2.example"
+	sliConfigV2WebsiteEventBased   = SliConfigFieldSliEntity + ".0." + SliConfigV2FieldWebsiteEventBased + ".0."
+	sliConfigV2ApplicationTimeBase = SliConfigFieldSliEntity + ".0." + SliConfigV2FieldApplicationTimeBased + ".0."
+)
+
+func TestCRUDOfSliConfigV2ResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForSliConfigV2()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSliConfigV2ResourceTestStep(httpServer.GetPort(), 0),
+			testStepImport(sliConfigV2Definition),
+			createSliConfigV2ResourceTestStep(httpServer.GetPort(), 1),
+			testStepImport(sliConfigV2Definition),
+		},
+	})
+}
+
+//createMockHttpServerForSliConfigV2 creates a mock server which stores the posted SLI configs in memory. The API does not support updates
+func createMockHttpServerForSliConfigV2() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	configs := make(map[string][]byte)
+	pathTemplate := restapi.SliConfigV2ResourcePath + "/{id}"
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.SliConfigV2ResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		config := restapi.SliConfigV2{}
+		if err := json.Unmarshal(body, &config); err != nil || config.ID == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		configs[config.ID] = body
+		httpServer.WriteJSONResponse(w, body)
+	})
+	httpServer.AddRoute(http.MethodGet, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		config, ok := configs[mux.Vars(r)["id"]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		httpServer.WriteJSONResponse(w, config)
+	})
+	httpServer.AddRoute(http.MethodDelete, pathTemplate, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(configs, mux.Vars(r)["id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
+func createSliConfigV2ResourceTestStep(httpPort int, iteration int) resource.TestStep {
+	return resource.TestStep{
+		Config: appendProviderConfig(fmt.Sprintf(sliConfigV2TerraformTemplate, iteration, iteration), httpPort),
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttrSet(sliConfigV2Definition, "id"),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, SliConfigFieldName, formatResourceName(iteration)),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, SliConfigFieldFullName, formatResourceFullName(iteration)),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, SliConfigFieldMetricConfiguration+".#", "0"),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, sliConfigV2WebsiteEventBased+SliConfigV2FieldWebsiteID, "website-id"),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, sliConfigV2WebsiteEventBased+SliConfigV2FieldBeaconType, "PAGELOAD"),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, sliConfigV2WebsiteEventBased+SliConfigV2FieldGoodEventFilterExpression, fmt.Sprintf("beacon.page.name@na EQUALS 'page-%d'", iteration)),
+			resource.TestCheckResourceAttr(sliConfigV2Definition, sliConfigV2WebsiteEventBased+SliConfigV2FieldBadEventFilterExpression, "beacon.error.message@na NOT_EMPTY"),
+		),
+	}
+}
+
+func TestResourceSliConfigV2Definition(t *testing.T) {
+	schemaMap := NewSliConfigV2ResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(schemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigFieldName)
+	schemaAssert.AssertSchemaIsComputedAndOfTypeString(SliConfigFieldFullName)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeInt(SliConfigFieldInitialEvaluationTimestamp)
+	require.Equal(t, schema.TypeList, schemaMap[SliConfigFieldMetricConfiguration].Type)
+	require.True(t, schemaMap[SliConfigFieldMetricConfiguration].Optional)
+	require.Equal(t, schema.TypeList, schemaMap[SliConfigFieldSliEntity].Type)
+	require.True(t, schemaMap[SliConfigFieldSliEntity].Required)
+
+	sliEntitySchemaMap := schemaMap[SliConfigFieldSliEntity].Elem.(*schema.Resource).Schema
+	for _, field := range []string{SliConfigV2FieldApplicationTimeBased, SliConfigV2FieldApplicationEventBased, SliConfigV2FieldWebsiteEventBased, SliConfigV2FieldWebsiteTimeBased} {
+		require.Equal(t, schema.TypeList, sliEntitySchemaMap[field].Type)
+		require.True(t, sliEntitySchemaMap[field].Optional)
+		require.Equal(t, 1, sliEntitySchemaMap[field].MaxItems)
+		require.Len(t, sliEntitySchemaMap[field].ExactlyOneOf, 4)
+	}
+
+	applicationEventBasedSchemaMap := sliEntitySchemaMap[SliConfigV2FieldApplicationEventBased].Elem.(*schema.Resource).Schema
+	schemaAssert = testutils.NewTerraformSchemaAssert(applicationEventBasedSchemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigFieldApplicationID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SliConfigFieldServiceID)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SliConfigFieldEndpointID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigFieldBoundaryScope)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigV2FieldGoodEventFilterExpression)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigV2FieldBadEventFilterExpression)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SliConfigV2FieldIncludeInternal, false)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SliConfigV2FieldIncludeSynthetic, false)
+
+	websiteTimeBasedSchemaMap := sliEntitySchemaMap[SliConfigV2FieldWebsiteTimeBased].Elem.(*schema.Resource).Schema
+	schemaAssert = testutils.NewTerraformSchemaAssert(websiteTimeBasedSchemaMap, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigV2FieldWebsiteID)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(SliConfigV2FieldBeaconType)
+	schemaAssert.AssertSchemaIsOptionalAndOfTypeString(SliConfigV2FieldFilterExpression)
+}
+
+func TestSliConfigV2ShouldRequireReplacementForAllChanges(t *testing.T) {
+	schemaMap := NewSliConfigV2ResourceHandle().MetaData().Schema
+
+	for field, s := range schemaMap {
+		if !s.Computed {
+			require.True(t, s.ForceNew, "expected field %s to force a replacement", field)
+		}
+	}
+	sliEntitySchemaMap := schemaMap[SliConfigFieldSliEntity].Elem.(*schema.Resource).Schema
+	for entityType, entitySchema := range sliEntitySchemaMap {
+		for field, s := range entitySchema.Elem.(*schema.Resource).Schema {
+			require.True(t, s.ForceNew, "expected field %s.%s to force a replacement", entityType, field)
+		}
+	}
+}
+
+func TestShouldReturnCorrectResourceNameForSliConfigV2(t *testing.T) {
+	name := NewSliConfigV2ResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_sli_config_v2", name)
+}
+
+func TestSliConfigV2ResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewSliConfigV2ResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+}
+
+func TestShouldUpdateResourceStateForApplicationTimeBasedSliConfigV2(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	boundaryScope := restapi.BoundaryScopeInbound
+	data := &restapi.SliConfigV2{
+		ID:                         sliConfigID,
+		Name:                       sliConfigFullName,
+		InitialEvaluationTimestamp: 1234,
+		MetricConfiguration:        &restapi.MetricConfiguration{Name: sliConfigMetricName, Aggregation: sliConfigMetricAggregation, Threshold: sliConfigMetricThreshold},
+		SliEntity: restapi.SliEntityV2{
+			Type:          restapi.SliTypeApplication,
+			ApplicationID: utils.StringPtr(sliConfigEntityApplicationID),
+			ServiceID:     utils.StringPtr(sliConfigEntityServiceID),
+			BoundaryScope: &boundaryScope,
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, sliConfigID, resourceData.Id())
+	require.Equal(t, sliConfigName, resourceData.Get(SliConfigFieldName))
+	require.Equal(t, sliConfigFullName, resourceData.Get(SliConfigFieldFullName))
+	require.Equal(t, 1234, resourceData.Get(SliConfigFieldInitialEvaluationTimestamp))
+	require.Equal(t, sliConfigMetricName, resourceData.Get(SliConfigFieldMetricConfiguration+".0."+SliConfigFieldMetricName))
+	require.Equal(t, sliConfigMetricAggregation, resourceData.Get(SliConfigFieldMetricConfiguration+".0."+SliConfigFieldMetricAggregation))
+	require.Equal(t, sliConfigMetricThreshold, resourceData.Get(SliConfigFieldMetricConfiguration+".0."+SliConfigFieldMetricThreshold))
+	require.Equal(t, sliConfigEntityApplicationID, resourceData.Get(sliConfigV2ApplicationTimeBase+SliConfigFieldApplicationID))
+	require.Equal(t, sliConfigEntityServiceID, resourceData.Get(sliConfigV2ApplicationTimeBase+SliConfigFieldServiceID))
+	require.Equal(t, "", resourceData.Get(sliConfigV2ApplicationTimeBase+SliConfigFieldEndpointID))
+	require.Equal(t, "INBOUND", resourceData.Get(sliConfigV2ApplicationTimeBase+SliConfigFieldBoundaryScope))
+	require.Len(t, resourceData.Get(SliConfigFieldSliEntity+".0."+SliConfigV2FieldWebsiteEventBased), 0)
+}
+
+func TestShouldUpdateResourceStateForApplicationEventBasedSliConfigV2WithNormalizedTagFilters(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	boundaryScope := restapi.BoundaryScopeAll
+	data := &restapi.SliConfigV2{
+		ID:   sliConfigID,
+		Name: sliConfigFullName,
+		SliEntity: restapi.SliEntityV2{
+			Type:                      restapi.SliTypeAvailability,
+			ApplicationID:             utils.StringPtr(sliConfigEntityApplicationID),
+			BoundaryScope:             &boundaryScope,
+			IncludeInternal:           utils.BoolPtr(true),
+			GoodEventFilterExpression: restapi.NewNumberTagFilter(restapi.TagFilterEntityNotApplicable, "call.http.status", restapi.LessThanOperator, 500),
+			BadEventFilterExpression:  restapi.NewUnaryTagFilter(restapi.TagFilterEntityNotApplicable, "call.erroneous", restapi.IsEmptyOperator),
+		},
+	}
+
+	err := resourceHandle.UpdateState(resourceData, data, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	prefix := SliConfigFieldSliEntity + ".0." + SliConfigV2FieldApplicationEventBased + ".0."
+	require.Len(t, resourceData.Get(SliConfigFieldMetricConfiguration), 0)
+	require.Equal(t, sliConfigEntityApplicationID, resourceData.Get(prefix+SliConfigFieldApplicationID))
+	require.Equal(t, "ALL", resourceData.Get(prefix+SliConfigFieldBoundaryScope))
+	require.Equal(t, "call.http.status@na LESS_THAN 500", resourceData.Get(prefix+SliConfigV2FieldGoodEventFilterExpression))
+	require.Equal(t, "call.erroneous@na IS_EMPTY", resourceData.Get(prefix+SliConfigV2FieldBadEventFilterExpression))
+	require.Equal(t, true, resourceData.Get(prefix+SliConfigV2FieldIncludeInternal))
+	require.Equal(t, false, resourceData.Get(prefix+SliConfigV2FieldIncludeSynthetic))
+}
+
+func TestShouldFailToUpdateResourceStateForSliConfigV2WhenSliTypeIsNotSupported(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	data := &restapi.SliConfigV2{ID: sliConfigID, Name: sliConfigFullName, SliEntity: restapi.SliEntityV2{Type: restapi.SliType("custom")}}
+
+	err := resourceHandle.UpdateState(resourceData, data, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sli type custom is not supported")
+}
+
+func TestShouldConvertStateOfWebsiteTimeBasedSliConfigV2ToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(sliConfigID)
+	resourceData.Set(SliConfigFieldName, sliConfigName)
+	resourceData.Set(SliConfigFieldFullName, sliConfigFullName)
+	resourceData.Set(SliConfigFieldMetricConfiguration, []interface{}{
+		map[string]interface{}{
+			SliConfigFieldMetricName:        sliConfigMetricName,
+			SliConfigFieldMetricAggregation: sliConfigMetricAggregation,
+			SliConfigFieldMetricThreshold:   sliConfigMetricThreshold,
+		},
+	})
+	resourceData.Set(SliConfigFieldSliEntity, []interface{}{
+		map[string]interface{}{
+			SliConfigV2FieldWebsiteTimeBased: []interface{}{
+				map[string]interface{}{
+					SliConfigV2FieldWebsiteID:        "website-id",
+					SliConfigV2FieldBeaconType:       "HTTPREQUEST",
+					SliConfigV2FieldFilterExpression: "beacon.page.name@na EQUALS 'checkout'",
+				},
+			},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	beaconType := restapi.BeaconTypeHTTPRequest
+	require.Equal(t, &restapi.SliConfigV2{
+		ID:                  sliConfigID,
+		Name:                sliConfigFullName,
+		MetricConfiguration: &restapi.MetricConfiguration{Name: sliConfigMetricName, Aggregation: sliConfigMetricAggregation, Threshold: sliConfigMetricThreshold},
+		SliEntity: restapi.SliEntityV2{
+			Type:             restapi.SliTypeWebsiteTimeBased,
+			WebsiteID:        utils.StringPtr("website-id"),
+			BeaconType:       &beaconType,
+			FilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "beacon.page.name", restapi.EqualsOperator, "checkout"),
+		},
+	}, result)
+}
+
+func TestShouldConvertStateOfApplicationEventBasedSliConfigV2ToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(sliConfigID)
+	resourceData.Set(SliConfigFieldName, sliConfigName)
+	resourceData.Set(SliConfigFieldFullName, sliConfigFullName)
+	resourceData.Set(SliConfigFieldSliEntity, []interface{}{
+		map[string]interface{}{
+			SliConfigV2FieldApplicationEventBased: []interface{}{
+				map[string]interface{}{
+					SliConfigFieldApplicationID:               sliConfigEntityApplicationID,
+					SliConfigFieldEndpointID:                  sliConfigEntityEndpointID,
+					SliConfigFieldBoundaryScope:               "INBOUND",
+					SliConfigV2FieldGoodEventFilterExpression: "call.http.status@na EQUALS '200'",
+					SliConfigV2FieldBadEventFilterExpression:  "call.http.status@na EQUALS '500'",
+					SliConfigV2FieldIncludeSynthetic:          true,
+				},
+			},
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	boundaryScope := restapi.BoundaryScopeInbound
+	require.Equal(t, &restapi.SliConfigV2{
+		ID:   sliConfigID,
+		Name: sliConfigFullName,
+		SliEntity: restapi.SliEntityV2{
+			Type:                      restapi.SliTypeAvailability,
+			ApplicationID:             utils.StringPtr(sliConfigEntityApplicationID),
+			EndpointID:                utils.StringPtr(sliConfigEntityEndpointID),
+			BoundaryScope:             &boundaryScope,
+			IncludeInternal:           utils.BoolPtr(false),
+			IncludeSynthetic:          utils.BoolPtr(true),
+			GoodEventFilterExpression: restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.http.status", restapi.EqualsOperator, "200"),
+			BadEventFilterExpression:  restapi.NewStringTagFilter(restapi.TagFilterEntityNotApplicable, "call.http.status", restapi.EqualsOperator, "500"),
+		},
+	}, result)
+}
+
+func TestShouldFailToConvertStateOfSliConfigV2ToDataModelWhenNoSliEntityTypeIsDefined(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSliConfigV2ResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(sliConfigID)
+	resourceData.Set(SliConfigFieldName, sliConfigName)
+
+	_, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exactly one sli entity type must be defined")
+}
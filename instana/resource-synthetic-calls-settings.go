@@ -0,0 +1,175 @@
+package instana
+
+import (
+	"github.com/gessnerfl/terraform-provider-instana/instana/filterexpression"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaSyntheticCallsSettings the name of the terraform-provider-instana resource to manage the tenant wide settings of synthetic calls
+const ResourceInstanaSyntheticCallsSettings = "instana_synthetic_calls_settings"
+
+const (
+	//SyntheticCallsSettingsFieldDefaultRulesEnabled constant value for the schema field default_rules_enabled
+	SyntheticCallsSettingsFieldDefaultRulesEnabled = "default_rules_enabled"
+	//SyntheticCallsSettingsFieldCustomRule constant value for the schema field custom_rule
+	SyntheticCallsSettingsFieldCustomRule = "custom_rule"
+	//SyntheticCallsSettingsFieldCustomRuleName constant value for the schema field custom_rule.name
+	SyntheticCallsSettingsFieldCustomRuleName = "name"
+	//SyntheticCallsSettingsFieldCustomRuleDescription constant value for the schema field custom_rule.description
+	SyntheticCallsSettingsFieldCustomRuleDescription = "description"
+	//SyntheticCallsSettingsFieldCustomRuleEnabled constant value for the schema field custom_rule.enabled
+	SyntheticCallsSettingsFieldCustomRuleEnabled = "enabled"
+	//SyntheticCallsSettingsFieldCustomRuleMatchSpecification constant value for the schema field custom_rule.match_specification
+	SyntheticCallsSettingsFieldCustomRuleMatchSpecification = "match_specification"
+)
+
+var (
+	//SyntheticCallsSettingsDefaultRulesEnabled schema field definition of instana_synthetic_calls_settings field default_rules_enabled
+	SyntheticCallsSettingsDefaultRulesEnabled = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Flag to indicate if the default rules of Instana for synthetic calls are enabled",
+	}
+	//SyntheticCallsSettingsCustomRule schema field definition of instana_synthetic_calls_settings field custom_rule
+	SyntheticCallsSettingsCustomRule = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    restapi.MaxSyntheticCallCustomRules,
+		Description: "The custom rules which define which calls are considered as synthetic calls",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				SyntheticCallsSettingsFieldCustomRuleName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+					Description:  "The name of the custom rule",
+				},
+				SyntheticCallsSettingsFieldCustomRuleDescription: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 2048),
+					Description:  "The description of the custom rule",
+				},
+				SyntheticCallsSettingsFieldCustomRuleEnabled: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Flag to indicate if the custom rule is enabled",
+				},
+				SyntheticCallsSettingsFieldCustomRuleMatchSpecification: newMatchExpressionSchema(&schema.Schema{
+					Required:    true,
+					Description: "The match specification which defines the calls matched by the custom rule, e.g. call.http.path STARTS_WITH '/health'",
+				}),
+			},
+		},
+	}
+)
+
+//NewSyntheticCallsSettingsResourceHandle creates the resource handle for the tenant wide settings of synthetic calls.
+//The settings exist exactly once per tenant. Deleting the resource resets the settings to the defaults of Instana.
+func NewSyntheticCallsSettingsResourceHandle() ResourceHandle {
+	return &syntheticCallsSettingsResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaSyntheticCallsSettings,
+			Schema: map[string]*schema.Schema{
+				SyntheticCallsSettingsFieldDefaultRulesEnabled: SyntheticCallsSettingsDefaultRulesEnabled,
+				SyntheticCallsSettingsFieldCustomRule:          SyntheticCallsSettingsCustomRule,
+			},
+			SingletonID: restapi.SyntheticCallsSettingsPathElement,
+		},
+	}
+}
+
+type syntheticCallsSettingsResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *syntheticCallsSettingsResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *syntheticCallsSettingsResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *syntheticCallsSettingsResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.SyntheticCallsSettings()
+}
+
+func (r *syntheticCallsSettingsResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *syntheticCallsSettingsResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	settings := obj.(*restapi.SyntheticCallsSettings)
+	customRules, err := r.mapCustomRulesToSchema(settings.CustomRules)
+	if err != nil {
+		return err
+	}
+	d.Set(SyntheticCallsSettingsFieldDefaultRulesEnabled, settings.DefaultRulesEnabled)
+	d.Set(SyntheticCallsSettingsFieldCustomRule, customRules)
+	d.SetId(settings.GetIDForResourcePath())
+	return nil
+}
+
+func (r *syntheticCallsSettingsResource) mapCustomRulesToSchema(rules []restapi.SyntheticCallRule) ([]interface{}, error) {
+	mapper := filterexpression.NewMatchExpressionMapper()
+	result := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		expression, err := mapper.FromAPIModel(rule.MatchSpecification)
+		if err != nil {
+			return nil, err
+		}
+		description := ""
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+		result[i] = map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               rule.Name,
+			SyntheticCallsSettingsFieldCustomRuleDescription:        description,
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            rule.Enabled,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: expression.Render(),
+		}
+	}
+	return result, nil
+}
+
+func (r *syntheticCallsSettingsResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	customRules, err := r.mapCustomRulesFromSchema(d)
+	if err != nil {
+		return &restapi.SyntheticCallsSettings{}, err
+	}
+	return &restapi.SyntheticCallsSettings{
+		CustomRules:         customRules,
+		DefaultRulesEnabled: d.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool),
+	}, nil
+}
+
+func (r *syntheticCallsSettingsResource) mapCustomRulesFromSchema(d *schema.ResourceData) ([]restapi.SyntheticCallRule, error) {
+	parser := filterexpression.NewParser()
+	mapper := filterexpression.NewMatchExpressionMapper()
+	rawRules := d.Get(SyntheticCallsSettingsFieldCustomRule).([]interface{})
+	result := make([]restapi.SyntheticCallRule, len(rawRules))
+	for i, v := range rawRules {
+		rule := v.(map[string]interface{})
+		expression, err := parser.Parse(rule[SyntheticCallsSettingsFieldCustomRuleMatchSpecification].(string))
+		if err != nil {
+			return nil, err
+		}
+		var description *string
+		if value, ok := rule[SyntheticCallsSettingsFieldCustomRuleDescription].(string); ok && len(value) > 0 {
+			description = &value
+		}
+		result[i] = restapi.SyntheticCallRule{
+			Name:               rule[SyntheticCallsSettingsFieldCustomRuleName].(string),
+			Description:        description,
+			Enabled:            rule[SyntheticCallsSettingsFieldCustomRuleEnabled].(bool),
+			MatchSpecification: mapper.ToAPIModel(expression),
+		}
+	}
+	return result, nil
+}
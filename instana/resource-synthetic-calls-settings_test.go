@@ -0,0 +1,260 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const resourceSyntheticCallsSettingsDefinitionTemplate = `
+resource "instana_synthetic_calls_settings" "example" {
+  default_rules_enabled = %t
+
+  custom_rule {
+    name                = "health checks %d"
+    description         = "description"
+    match_specification = "call.http.path STARTS_WITH '/health' AND call.http.method EQUALS 'GET'"
+  }
+
+  custom_rule {
+    name                = "load tests"
+    enabled             = false
+    match_specification = "call.http.header.x-load-test NOT_EMPTY"
+  }
+}
+`
+
+const (
+	testSyntheticCallsSettingsDefinition = "instana_synthetic_calls_settings.example"
+	syntheticCallHealthCheckExpression   = "call.http.path@dest STARTS_WITH '/health' AND call.http.method@dest EQUALS 'GET'"
+	syntheticCallLoadTestExpression      = "call.http.header.x-load-test@dest NOT_EMPTY"
+)
+
+func TestCRUDOfSyntheticCallsSettingsResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForSyntheticCallsSettings()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createSyntheticCallsSettingsResourceTestStep(httpServer.GetPort(), true, 0),
+			testStepImport(testSyntheticCallsSettingsDefinition),
+			createSyntheticCallsSettingsResourceTestStep(httpServer.GetPort(), false, 1),
+			testStepImport(testSyntheticCallsSettingsDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForSyntheticCallsSettings creates a mock server which keeps the custom rules in memory and always
+//returns a default rule. The update endpoint does not return a response body
+func createMockHttpServerForSyntheticCallsSettings() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	defaultRules := []byte(`[{"name":"default","enabled":true,"matchSpecification":{"type":"LEAF","key":"call.http.header.x-synthetic","entity":"DESTINATION","operator":"NOT_EMPTY"}}]`)
+	settings := map[string]json.RawMessage{"customRules": []byte("[]"), "defaultRulesEnabled": []byte("true")}
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		settings["defaultRules"] = defaultRules
+		data, _ := json.Marshal(settings)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodPut, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &settings); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.SyntheticCallsSettingsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		settings = map[string]json.RawMessage{"customRules": []byte("[]"), "defaultRulesEnabled": []byte("true")}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httpServer
+}
+
+func createSyntheticCallsSettingsResourceTestStep(httpPort int, defaultRulesEnabled bool, iteration int) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceSyntheticCallsSettingsDefinitionTemplate, defaultRulesEnabled, iteration), httpPort)
+	customRuleField := func(index int, field string) string {
+		return fmt.Sprintf("%s.%d.%s", SyntheticCallsSettingsFieldCustomRule, index, field)
+	}
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, "id", restapi.SyntheticCallsSettingsPathElement),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, SyntheticCallsSettingsFieldDefaultRulesEnabled, fmt.Sprintf("%t", defaultRulesEnabled)),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, fmt.Sprintf("%s.#", SyntheticCallsSettingsFieldCustomRule), "2"),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(0, SyntheticCallsSettingsFieldCustomRuleName), fmt.Sprintf("health checks %d", iteration)),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(0, SyntheticCallsSettingsFieldCustomRuleDescription), "description"),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(0, SyntheticCallsSettingsFieldCustomRuleEnabled), trueAsString),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(0, SyntheticCallsSettingsFieldCustomRuleMatchSpecification), syntheticCallHealthCheckExpression),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(1, SyntheticCallsSettingsFieldCustomRuleName), "load tests"),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(1, SyntheticCallsSettingsFieldCustomRuleEnabled), falseAsString),
+			resource.TestCheckResourceAttr(testSyntheticCallsSettingsDefinition, customRuleField(1, SyntheticCallsSettingsFieldCustomRuleMatchSpecification), syntheticCallLoadTestExpression),
+		),
+	}
+}
+
+func TestSyntheticCallsSettingsSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewSyntheticCallsSettingsResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticCallsSettingsFieldDefaultRulesEnabled, true)
+	require.Equal(t, schema.TypeList, resourceSchema[SyntheticCallsSettingsFieldCustomRule].Type)
+	require.True(t, resourceSchema[SyntheticCallsSettingsFieldCustomRule].Optional)
+	require.Equal(t, 500, resourceSchema[SyntheticCallsSettingsFieldCustomRule].MaxItems)
+
+	customRuleSchemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema[SyntheticCallsSettingsFieldCustomRule].Elem.(*schema.Resource).Schema, t)
+	customRuleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallsSettingsFieldCustomRuleName)
+	customRuleSchemaAssert.AssertSchemaIsOptionalAndOfTypeString(SyntheticCallsSettingsFieldCustomRuleDescription)
+	customRuleSchemaAssert.AssertSchemaIsOfTypeBooleanWithDefault(SyntheticCallsSettingsFieldCustomRuleEnabled, true)
+	customRuleSchemaAssert.AssertSchemaIsRequiredAndOfTypeString(SyntheticCallsSettingsFieldCustomRuleMatchSpecification)
+}
+
+func TestShouldValidateAndNormalizeMatchSpecificationOfSyntheticCallRule(t *testing.T) {
+	matchSpecificationSchema := NewSyntheticCallsSettingsResourceHandle().MetaData().Schema[SyntheticCallsSettingsFieldCustomRule].Elem.(*schema.Resource).Schema[SyntheticCallsSettingsFieldCustomRuleMatchSpecification]
+
+	_, errs := matchSpecificationSchema.ValidateFunc("call.http.path STARTS_WITH '/health'", SyntheticCallsSettingsFieldCustomRuleMatchSpecification)
+	require.Empty(t, errs)
+	_, errs = matchSpecificationSchema.ValidateFunc("invalid expression", SyntheticCallsSettingsFieldCustomRuleMatchSpecification)
+	require.Len(t, errs, 1)
+
+	require.Equal(t, syntheticCallLoadTestExpression, matchSpecificationSchema.StateFunc("call.http.header.x-load-test NOT_EMPTY"))
+	require.True(t, matchSpecificationSchema.DiffSuppressFunc(SyntheticCallsSettingsFieldCustomRuleMatchSpecification, syntheticCallLoadTestExpression, "call.http.header.x-load-test  NOT_EMPTY", nil))
+	require.False(t, matchSpecificationSchema.DiffSuppressFunc(SyntheticCallsSettingsFieldCustomRuleMatchSpecification, syntheticCallLoadTestExpression, "call.http.header.x-load-test IS_EMPTY", nil))
+}
+
+func TestShouldReturnCorrectResourceNameForSyntheticCallsSettingsResource(t *testing.T) {
+	name := NewSyntheticCallsSettingsResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_synthetic_calls_settings", name)
+}
+
+func TestSyntheticCallsSettingsResourceShouldBeASingletonWithSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.Equal(t, restapi.SyntheticCallsSettingsPathElement, resourceHandle.MetaData().SingletonID)
+}
+
+func TestShouldUpdateSyntheticCallsSettingsTerraformResourceStateFromModel(t *testing.T) {
+	settings := &restapi.SyntheticCallsSettings{
+		DefaultRulesEnabled: true,
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:               "health checks",
+				Description:        utils.StringPtr("description"),
+				Enabled:            true,
+				MatchSpecification: restapi.NewComparisonExpression("call.http.path", restapi.MatcherExpressionEntityDestination, restapi.StartsWithOperator, "/health"),
+			},
+			{
+				Name:               "load tests",
+				MatchSpecification: restapi.NewUnaryOperationExpression("call.http.header.x-load-test", restapi.MatcherExpressionEntityDestination, restapi.NotEmptyOperator),
+			},
+		},
+		DefaultRules: []restapi.SyntheticCallRule{
+			{Name: "default", MatchSpecification: restapi.NewUnaryOperationExpression("call.http.header.x-synthetic", restapi.MatcherExpressionEntityDestination, restapi.NotEmptyOperator)},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, settings, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, restapi.SyntheticCallsSettingsPathElement, resourceData.Id())
+	require.True(t, resourceData.Get(SyntheticCallsSettingsFieldDefaultRulesEnabled).(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+			SyntheticCallsSettingsFieldCustomRuleDescription:        "description",
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            true,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.path@dest STARTS_WITH '/health'",
+		},
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               "load tests",
+			SyntheticCallsSettingsFieldCustomRuleDescription:        "",
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            false,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: syntheticCallLoadTestExpression,
+		},
+	}, resourceData.Get(SyntheticCallsSettingsFieldCustomRule))
+}
+
+func TestShouldFailToUpdateSyntheticCallsSettingsTerraformResourceStateWhenMatchSpecificationIsNotSupported(t *testing.T) {
+	settings := &restapi.SyntheticCallsSettings{
+		CustomRules: []restapi.SyntheticCallRule{
+			{Name: "invalid", MatchSpecification: &restapi.TagMatcherExpression{Dtype: restapi.LeafExpressionType, Key: "key", Entity: restapi.MatcherExpressionEntityDestination, Operator: "INVALID"}},
+		},
+	}
+
+	testHelper := NewTestHelper(t)
+	sut := NewSyntheticCallsSettingsResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, settings, testHelper.ResourceFormatter())
+
+	require.Error(t, err)
+}
+
+func TestShouldSuccessfullyConvertSyntheticCallsSettingsStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewSyntheticCallsSettingsResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(restapi.SyntheticCallsSettingsPathElement)
+	resourceData.Set(SyntheticCallsSettingsFieldDefaultRulesEnabled, false)
+	resourceData.Set(SyntheticCallsSettingsFieldCustomRule, []interface{}{
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               "health checks",
+			SyntheticCallsSettingsFieldCustomRuleDescription:        "description",
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            true,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: "call.http.path STARTS_WITH '/health'",
+		},
+		map[string]interface{}{
+			SyntheticCallsSettingsFieldCustomRuleName:               "load tests",
+			SyntheticCallsSettingsFieldCustomRuleEnabled:            false,
+			SyntheticCallsSettingsFieldCustomRuleMatchSpecification: syntheticCallLoadTestExpression,
+		},
+	})
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.SyntheticCallsSettings{
+		DefaultRulesEnabled: false,
+		CustomRules: []restapi.SyntheticCallRule{
+			{
+				Name:               "health checks",
+				Description:        utils.StringPtr("description"),
+				Enabled:            true,
+				MatchSpecification: restapi.NewComparisonExpression("call.http.path", restapi.MatcherExpressionEntityDestination, restapi.StartsWithOperator, "/health"),
+			},
+			{
+				Name:               "load tests",
+				Enabled:            false,
+				MatchSpecification: restapi.NewUnaryOperationExpression("call.http.header.x-load-test", restapi.MatcherExpressionEntityDestination, restapi.NotEmptyOperator),
+			},
+		},
+	}, result)
+}
@@ -0,0 +1,92 @@
+package instana
+
+import (
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+//ResourceInstanaUserInvitation the name of the terraform-provider-instana resource to manage invitations of users
+const ResourceInstanaUserInvitation = "instana_user_invitation"
+
+const (
+	//UserInvitationFieldEmail constant value for the schema field email
+	UserInvitationFieldEmail = "email"
+	//UserInvitationFieldGroupID constant value for the schema field group_id
+	UserInvitationFieldGroupID = "group_id"
+)
+
+var (
+	//UserInvitationEmail schema field definition of instana_user_invitation field email
+	UserInvitationEmail = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return strings.EqualFold(old, new)
+		},
+		Description: "The email address of the invited user",
+	}
+	//UserInvitationGroupID schema field definition of instana_user_invitation field group_id
+	UserInvitationGroupID = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the group (instana_rbac_group) to which the user is assigned when the invitation is accepted",
+	}
+)
+
+//NewUserInvitationResourceHandle creates the resource handle for invitations of users. Instana only reports pending
+//invitations. Therefore, invitations are removed from the terraform state once they are accepted.
+func NewUserInvitationResourceHandle() ResourceHandle {
+	return &userInvitationResource{
+		metaData: ResourceMetaData{
+			ResourceName: ResourceInstanaUserInvitation,
+			Schema: map[string]*schema.Schema{
+				UserInvitationFieldEmail:   UserInvitationEmail,
+				UserInvitationFieldGroupID: UserInvitationGroupID,
+			},
+			SkipIDGeneration: true,
+		},
+	}
+}
+
+type userInvitationResource struct {
+	metaData ResourceMetaData
+}
+
+func (r *userInvitationResource) MetaData() *ResourceMetaData {
+	return &r.metaData
+}
+
+func (r *userInvitationResource) StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{}
+}
+
+func (r *userInvitationResource) GetRestResource(api restapi.InstanaAPI) restapi.RestResource {
+	return api.Invitations()
+}
+
+func (r *userInvitationResource) SetComputedFields(d *schema.ResourceData) {
+	//No computed fields defined
+}
+
+func (r *userInvitationResource) UpdateState(d *schema.ResourceData, obj restapi.InstanaDataObject, formatter utils.ResourceNameFormatter) error {
+	invitation := obj.(*restapi.Invitation)
+	d.Set(UserInvitationFieldEmail, invitation.Email)
+	d.Set(UserInvitationFieldGroupID, invitation.GroupID)
+	d.SetId(invitation.Email)
+	return nil
+}
+
+func (r *userInvitationResource) MapStateToDataObject(d *schema.ResourceData, formatter utils.ResourceNameFormatter) (restapi.InstanaDataObject, error) {
+	return &restapi.Invitation{
+		Email:   d.Get(UserInvitationFieldEmail).(string),
+		GroupID: d.Get(UserInvitationFieldGroupID).(string),
+	}, nil
+}
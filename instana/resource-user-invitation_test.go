@@ -0,0 +1,162 @@
+package instana_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana"
+	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+)
+
+const resourceUserInvitationDefinitionTemplate = `
+resource "instana_user_invitation" "example" {
+  email    = "%s"
+  group_id = "group-id"
+}
+`
+
+const (
+	testUserInvitationDefinition = "instana_user_invitation.example"
+	userInvitationEmail          = "user@example.com"
+	userInvitationGroupID        = "group-id"
+)
+
+func TestCRUDOfUserInvitationResourceWithMockServer(t *testing.T) {
+	httpServer := createMockHttpServerForUserInvitation()
+	httpServer.Start()
+	defer httpServer.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			createUserInvitationResourceTestStep(httpServer.GetPort(), userInvitationEmail),
+			testStepImport(testUserInvitationDefinition),
+			createUserInvitationResourceTestStep(httpServer.GetPort(), "other@example.com"),
+			testStepImport(testUserInvitationDefinition),
+		},
+	})
+}
+
+//createMockHttpServerForUserInvitation creates a mock server which keeps the pending invitations in memory. Invitations
+//are revoked by the email query parameter of the delete request.
+func createMockHttpServerForUserInvitation() testutils.TestHTTPServer {
+	var lock sync.Mutex
+	invitations := make(map[string]restapi.Invitation)
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPost, restapi.InvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		body, _ := io.ReadAll(r.Body)
+		request := make([]restapi.Invitation, 0)
+		if err := json.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, invitation := range request {
+			invitation.ID = "id-" + invitation.Email
+			invitations[strings.ToLower(invitation.Email)] = invitation
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.InvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		result := make([]restapi.Invitation, 0)
+		for _, invitation := range invitations {
+			result = append(result, invitation)
+		}
+		data, _ := json.Marshal(result)
+		httpServer.WriteJSONResponse(w, data)
+	})
+	httpServer.AddRoute(http.MethodDelete, restapi.InvitationsResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		delete(invitations, strings.ToLower(r.URL.Query().Get("email")))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	httpServer.AddRoute(http.MethodGet, restapi.UsersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		httpServer.WriteJSONResponse(w, []byte("[]"))
+	})
+	return httpServer
+}
+
+func createUserInvitationResourceTestStep(httpPort int, email string) resource.TestStep {
+	config := appendProviderConfig(fmt.Sprintf(resourceUserInvitationDefinitionTemplate, email), httpPort)
+	return resource.TestStep{
+		Config: config,
+		Check: resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(testUserInvitationDefinition, "id", email),
+			resource.TestCheckResourceAttr(testUserInvitationDefinition, UserInvitationFieldEmail, email),
+			resource.TestCheckResourceAttr(testUserInvitationDefinition, UserInvitationFieldGroupID, userInvitationGroupID),
+		),
+	}
+}
+
+func TestUserInvitationSchemaDefinitionIsValid(t *testing.T) {
+	resourceSchema := NewUserInvitationResourceHandle().MetaData().Schema
+
+	schemaAssert := testutils.NewTerraformSchemaAssert(resourceSchema, t)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserInvitationFieldEmail)
+	schemaAssert.AssertSchemaIsRequiredAndOfTypeString(UserInvitationFieldGroupID)
+	require.True(t, resourceSchema[UserInvitationFieldEmail].ForceNew)
+	require.True(t, resourceSchema[UserInvitationFieldGroupID].ForceNew)
+}
+
+func TestShouldSuppressDiffOfUserInvitationEmailWhenOnlyCaseIsDifferent(t *testing.T) {
+	emailSchema := NewUserInvitationResourceHandle().MetaData().Schema[UserInvitationFieldEmail]
+
+	require.True(t, emailSchema.DiffSuppressFunc(UserInvitationFieldEmail, "User@Example.com", userInvitationEmail, nil))
+	require.False(t, emailSchema.DiffSuppressFunc(UserInvitationFieldEmail, "other@example.com", userInvitationEmail, nil))
+}
+
+func TestShouldReturnCorrectResourceNameForUserInvitationResource(t *testing.T) {
+	name := NewUserInvitationResourceHandle().MetaData().ResourceName
+
+	require.Equal(t, "instana_user_invitation", name)
+}
+
+func TestUserInvitationResourceShouldHaveSchemaVersionZeroAndNoStateUpgraders(t *testing.T) {
+	resourceHandle := NewUserInvitationResourceHandle()
+
+	require.Equal(t, 0, resourceHandle.MetaData().SchemaVersion)
+	require.Empty(t, resourceHandle.StateUpgraders())
+	require.True(t, resourceHandle.MetaData().SkipIDGeneration)
+}
+
+func TestShouldUpdateUserInvitationTerraformResourceStateFromModel(t *testing.T) {
+	invitation := &restapi.Invitation{ID: "invitation-id", Email: userInvitationEmail, GroupID: userInvitationGroupID}
+
+	testHelper := NewTestHelper(t)
+	sut := NewUserInvitationResourceHandle()
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(sut)
+
+	err := sut.UpdateState(resourceData, invitation, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, userInvitationEmail, resourceData.Id())
+	require.Equal(t, userInvitationEmail, resourceData.Get(UserInvitationFieldEmail))
+	require.Equal(t, userInvitationGroupID, resourceData.Get(UserInvitationFieldGroupID))
+}
+
+func TestShouldSuccessfullyConvertUserInvitationStateToDataModel(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	resourceHandle := NewUserInvitationResourceHandle()
+
+	resourceData := testHelper.CreateEmptyResourceDataForResourceHandle(resourceHandle)
+	resourceData.SetId(userInvitationEmail)
+	resourceData.Set(UserInvitationFieldEmail, userInvitationEmail)
+
+	result, err := resourceHandle.MapStateToDataObject(resourceData, testHelper.ResourceFormatter())
+
+	require.NoError(t, err)
+	require.Equal(t, &restapi.Invitation{Email: userInvitationEmail}, result)
+}
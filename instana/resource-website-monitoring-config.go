@@ -4,6 +4,7 @@ import (
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 //ResourceInstanaWebsiteMonitoringConfig the name of the terraform-provider-instana resource to manage website monitoring configurations
@@ -41,6 +42,18 @@ var WebsiteMonitoringConfigSchemaAppName = &schema.Schema{
 	Description: "Configures the calculated app name of the website monitoring configuration",
 }
 
+//WebsiteMonitoringSettingsFieldWebsiteID constant value for the schema field website_id of the resources which manage settings of a website monitoring configuration
+const WebsiteMonitoringSettingsFieldWebsiteID = "website_id"
+
+//WebsiteMonitoringSettingsWebsiteID schema field definition of the field website_id of the resources which manage settings of a website monitoring configuration
+var WebsiteMonitoringSettingsWebsiteID = &schema.Schema{
+	Type:         schema.TypeString,
+	Required:     true,
+	ForceNew:     true,
+	ValidateFunc: validation.StringIsNotWhiteSpace,
+	Description:  "The ID of the website monitoring configuration",
+}
+
 //NewWebsiteMonitoringConfigResourceHandle creates the resource handle for Alerting Configuration
 func NewWebsiteMonitoringConfigResourceHandle() ResourceHandle {
 	return &websiteMonitoringConfigResource{
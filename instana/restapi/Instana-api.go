@@ -1,5 +1,11 @@
 package restapi
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
 const (
 	//InstanaAPIBasePath path to Instana RESTful API
 	InstanaAPIBasePath = "/api"
@@ -15,6 +21,8 @@ const (
 	RBACSettingsBasePath = SettingsBasePath + "/rbac"
 	//WebsiteMonitoringResourcePath path to website monitoring
 	WebsiteMonitoringResourcePath = InstanaAPIBasePath + "/website-monitoring"
+	//MobileAppMonitoringResourcePath path to mobile app monitoring
+	MobileAppMonitoringResourcePath = InstanaAPIBasePath + "/mobile-app-monitoring"
 )
 
 //InstanaAPI is the interface to all resources of the Instana Rest API
@@ -23,21 +31,48 @@ type InstanaAPI interface {
 	BuiltinEventSpecifications() ReadOnlyRestResource
 	APITokens() RestResource
 	ApplicationConfigs() RestResource
+	ApplicationHTTPEndpointConfigs() RestResource
+	ServiceConfigs() RestResource
+	ServiceConfigOrder() RestResource
+	ManualServiceConfigs() RestResource
 	ApplicationAlertConfigs() RestResource
 	GlobalApplicationAlertConfigs() RestResource
 	AlertingChannels() RestResource
 	AlertingConfigurations() RestResource
 	SliConfigs() RestResource
+	SliConfigsV2() RestResource
 	WebsiteMonitoringConfig() RestResource
+	WebsiteGeoLocationConfiguration() RestResource
+	WebsiteGeoMappingRules() RestResource
+	WebsiteIPMaskingConfiguration() RestResource
 	WebsiteAlertConfig() RestResource
+	MobileAppConfig() RestResource
+	MobileAppGeoLocationConfiguration() RestResource
+	MobileAppGeoMappingRules() RestResource
+	MobileAppIPMaskingConfiguration() RestResource
 	Groups() RestResource
+	GroupMappings() RestResource
+	GroupMemberships() RestResource
+	Invitations() RestResource
+	SessionSettings() RestResource
+	SyntheticCallsSettings() RestResource
+	GlobalCustomPayloadConfiguration() RestResource
 	CustomDashboards() RestResource
+	MaintenanceWindowConfigs() RestResource
+	Releases() RestResource
+	//SliReport requests the report of the SLI for the given time window and service level objective
+	SliReport(ctx context.Context, query SliReportQuery) (*SliReport, error)
+	//InstanaVersion requests the version information of the Instana backend
+	InstanaVersion(ctx context.Context) (*InstanaVersionInfo, error)
 }
 
 //NewInstanaAPI creates a new instance of the instana API
-func NewInstanaAPI(apiToken string, endpoint string, skipTlsVerification bool) InstanaAPI {
-	client := NewClient(apiToken, endpoint, skipTlsVerification)
-	return &baseInstanaAPI{client: client}
+func NewInstanaAPI(apiToken string, endpoint string, config ClientConfig) (InstanaAPI, error) {
+	client, err := NewClient(apiToken, endpoint, config)
+	if err != nil {
+		return nil, err
+	}
+	return &baseInstanaAPI{client: client}, nil
 }
 
 type baseInstanaAPI struct {
@@ -64,6 +99,26 @@ func (api *baseInstanaAPI) ApplicationConfigs() RestResource {
 	return NewCreatePUTUpdatePUTRestResource(ApplicationConfigsResourcePath, NewApplicationConfigUnmarshaller(), api.client)
 }
 
+//ApplicationHTTPEndpointConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ApplicationHTTPEndpointConfigs() RestResource {
+	return NewCreatePOSTUpdatePUTRestResource(ApplicationHTTPEndpointConfigResourcePath, NewDefaultJSONUnmarshaller(&ApplicationHTTPEndpointConfig{}), api.client)
+}
+
+//ServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigs() RestResource {
+	return NewCreatePOSTUpdatePUTRestResource(ServiceConfigsResourcePath, NewDefaultJSONUnmarshaller(&ServiceConfig{}), api.client)
+}
+
+//ServiceConfigOrder implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ServiceConfigOrder() RestResource {
+	return NewServiceConfigOrderRestResource(api.client)
+}
+
+//ManualServiceConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) ManualServiceConfigs() RestResource {
+	return NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), api.client)
+}
+
 //ApplicationAlertConfigs implementation of InstanaAPI interface
 func (api *baseInstanaAPI) ApplicationAlertConfigs() RestResource {
 	return NewCreatePOSTUpdatePOSTRestResource(ApplicationAlertConfigsResourcePath, NewApplicationAlertConfigUnmarshaller(), api.client)
@@ -88,18 +143,118 @@ func (api *baseInstanaAPI) SliConfigs() RestResource {
 	return NewCreatePUTUpdatePUTRestResource(SliConfigResourcePath, NewDefaultJSONUnmarshaller(&SliConfig{}), api.client)
 }
 
+//SliConfigsV2 implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SliConfigsV2() RestResource {
+	return NewSliConfigV2RestResource(api.client)
+}
+
 func (api *baseInstanaAPI) WebsiteMonitoringConfig() RestResource {
 	return NewWebsiteMonitoringConfigRestResource(NewDefaultJSONUnmarshaller(&WebsiteMonitoringConfig{}), api.client)
 }
 
+//WebsiteGeoLocationConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteGeoLocationConfiguration() RestResource {
+	return NewMonitoringConfigSettingsRestResource(WebsiteMonitoringConfigResourcePath, GeoLocationConfigurationPathElement, func(id string) MonitoringConfigSettings {
+		return &GeoLocationConfiguration{MonitoringConfigID: id}
+	}, api.client)
+}
+
+//WebsiteGeoMappingRules implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteGeoMappingRules() RestResource {
+	return NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, api.client)
+}
+
+//WebsiteIPMaskingConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) WebsiteIPMaskingConfiguration() RestResource {
+	return NewMonitoringConfigSettingsRestResource(WebsiteMonitoringConfigResourcePath, IPMaskingConfigurationPathElement, func(id string) MonitoringConfigSettings {
+		return &IPMaskingConfiguration{MonitoringConfigID: id}
+	}, api.client)
+}
+
 func (api *baseInstanaAPI) WebsiteAlertConfig() RestResource {
 	return NewCreatePOSTUpdatePOSTRestResource(WebsiteAlertConfigResourcePath, NewWebsiteAlertConfigUnmarshaller(), api.client)
 }
 
+//MobileAppConfig implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppConfig() RestResource {
+	return NewMobileAppConfigRestResource(NewDefaultJSONUnmarshaller(&MobileAppConfig{}), api.client)
+}
+
+//MobileAppGeoLocationConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppGeoLocationConfiguration() RestResource {
+	return NewMonitoringConfigSettingsRestResource(MobileAppConfigResourcePath, GeoLocationConfigurationPathElement, func(id string) MonitoringConfigSettings {
+		return &GeoLocationConfiguration{MonitoringConfigID: id}
+	}, api.client)
+}
+
+//MobileAppGeoMappingRules implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppGeoMappingRules() RestResource {
+	return NewGeoMappingRulesRestResource(MobileAppConfigResourcePath, api.client)
+}
+
+//MobileAppIPMaskingConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MobileAppIPMaskingConfiguration() RestResource {
+	return NewMonitoringConfigSettingsRestResource(MobileAppConfigResourcePath, IPMaskingConfigurationPathElement, func(id string) MonitoringConfigSettings {
+		return &IPMaskingConfiguration{MonitoringConfigID: id}
+	}, api.client)
+}
+
 func (api *baseInstanaAPI) Groups() RestResource {
-	return NewCreatePOSTUpdatePUTRestResource(GroupsResourcePath, NewDefaultJSONUnmarshaller(&Group{}), api.client)
+	return NewGroupRestResource(api.client)
+}
+
+//GroupMappings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMappings() RestResource {
+	return NewGroupMappingRestResource(api.client)
+}
+
+//GroupMemberships implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GroupMemberships() RestResource {
+	return NewGroupMembershipRestResource(api.client)
+}
+
+//Invitations implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Invitations() RestResource {
+	return NewInvitationRestResource(api.client)
+}
+
+//SessionSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SessionSettings() RestResource {
+	return NewSingletonRestResource(SettingsBasePath, SessionSettingsPathElement, NewDefaultJSONUnmarshaller(&SessionSettings{}), api.client)
+}
+
+//SyntheticCallsSettings implementation of InstanaAPI interface
+func (api *baseInstanaAPI) SyntheticCallsSettings() RestResource {
+	return NewSingletonRestResource(SettingsBasePath, SyntheticCallsSettingsPathElement, NewSyntheticCallsSettingsUnmarshaller(), api.client)
+}
+
+//GlobalCustomPayloadConfiguration implementation of InstanaAPI interface
+func (api *baseInstanaAPI) GlobalCustomPayloadConfiguration() RestResource {
+	return NewGlobalCustomPayloadConfigurationRestResource(api.client)
 }
 
 func (api *baseInstanaAPI) CustomDashboards() RestResource {
 	return NewCreatePOSTUpdatePUTRestResource(CustomDashboardsResourcePath, NewDefaultJSONUnmarshaller(&CustomDashboard{}), api.client)
 }
+
+//MaintenanceWindowConfigs implementation of InstanaAPI interface
+func (api *baseInstanaAPI) MaintenanceWindowConfigs() RestResource {
+	return NewCreatePUTUpdatePUTRestResource(MaintenanceWindowConfigResourcePath, NewDefaultJSONUnmarshaller(&MaintenanceWindowConfig{}), api.client)
+}
+
+//Releases implementation of InstanaAPI interface
+func (api *baseInstanaAPI) Releases() RestResource {
+	return NewReleaseRestResource(api.client)
+}
+
+func (api *baseInstanaAPI) InstanaVersion(ctx context.Context) (*InstanaVersionInfo, error) {
+	data, err := api.client.Get(ctx, InstanaVersionResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	versionInfo := &InstanaVersionInfo{}
+	if err := json.Unmarshal(data, versionInfo); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	return versionInfo, nil
+}
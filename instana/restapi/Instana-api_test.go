@@ -8,7 +8,8 @@ import (
 )
 
 func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
-	api := NewInstanaAPI("api-token", "endpoint", false)
+	api, err := NewInstanaAPI("api-token", "endpoint", DefaultClientConfig)
+	require.NoError(t, err)
 
 	t.Run("Should return CustomEventSpecification instance", func(t *testing.T) {
 		resource := api.CustomEventSpecifications()
@@ -31,6 +32,26 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return ApplicationHTTPEndpointConfig instance", func(t *testing.T) {
+		resource := api.ApplicationHTTPEndpointConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfig instance", func(t *testing.T) {
+		resource := api.ServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ServiceConfigOrder instance", func(t *testing.T) {
+		resource := api.ServiceConfigOrder()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return ManualServiceConfig instance", func(t *testing.T) {
+		resource := api.ManualServiceConfigs()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return ApplicationAlertConfig instance", func(t *testing.T) {
 		resource := api.ApplicationAlertConfigs()
 
@@ -56,24 +77,104 @@ func TestShouldReturnResourcesFromInstanaAPI(t *testing.T) {
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return SliConfigsV2 instance", func(t *testing.T) {
+		resource := api.SliConfigsV2()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return WebsiteMonitoringConfig instance", func(t *testing.T) {
 		resource := api.WebsiteMonitoringConfig()
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return WebsiteGeoLocationConfiguration instance", func(t *testing.T) {
+		resource := api.WebsiteGeoLocationConfiguration()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteGeoMappingRules instance", func(t *testing.T) {
+		resource := api.WebsiteGeoMappingRules()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return WebsiteIPMaskingConfiguration instance", func(t *testing.T) {
+		resource := api.WebsiteIPMaskingConfiguration()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return WebsiteAlertConfig instance", func(t *testing.T) {
 		resource := api.WebsiteAlertConfig()
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return MobileAppConfig instance", func(t *testing.T) {
+		resource := api.MobileAppConfig()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppGeoLocationConfiguration instance", func(t *testing.T) {
+		resource := api.MobileAppGeoLocationConfiguration()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppGeoMappingRules instance", func(t *testing.T) {
+		resource := api.MobileAppGeoMappingRules()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MobileAppIPMaskingConfiguration instance", func(t *testing.T) {
+		resource := api.MobileAppIPMaskingConfiguration()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return Groups instance", func(t *testing.T) {
 		resource := api.Groups()
 
 		require.NotNil(t, resource)
 	})
+	t.Run("Should return Group Mappings instance", func(t *testing.T) {
+		resource := api.GroupMappings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Group Memberships instance", func(t *testing.T) {
+		resource := api.GroupMemberships()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Invitations instance", func(t *testing.T) {
+		resource := api.Invitations()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SessionSettings instance", func(t *testing.T) {
+		resource := api.SessionSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return SyntheticCallsSettings instance", func(t *testing.T) {
+		resource := api.SyntheticCallsSettings()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return GlobalCustomPayloadConfiguration instance", func(t *testing.T) {
+		resource := api.GlobalCustomPayloadConfiguration()
+
+		require.NotNil(t, resource)
+	})
 	t.Run("Should return Custom Dashboard instance", func(t *testing.T) {
 		resource := api.CustomDashboards()
 
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return MaintenanceWindowConfig instance", func(t *testing.T) {
+		resource := api.MaintenanceWindowConfigs()
+
+		require.NotNil(t, resource)
+	})
+	t.Run("Should return Release instance", func(t *testing.T) {
+		resource := api.Releases()
+
 		require.NotNil(t, resource)
 	})
 }
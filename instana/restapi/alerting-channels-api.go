@@ -10,6 +10,9 @@ import (
 //AlertingChannelsResourcePath path to Alerting channels resource of Instana RESTful API
 const AlertingChannelsResourcePath = EventSettingsBasePath + "/alertingChannels"
 
+//alertingChannelSecretFields the JSON fields of alerting channels which contain secrets like webhook URLs, API keys and service keys
+var alertingChannelSecretFields = []string{"webhookUrl", "webhookUrls", "apiKey", "routingKey", "serviceIntegrationKey", "url", "token", "headers"}
+
 //AlertingChannelType type of the alerting channel
 type AlertingChannelType string
 
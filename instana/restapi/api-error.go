@@ -0,0 +1,125 @@
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const maxErrorBodyLength = 1024
+
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+var requestIDHeaders = []string{"X-Request-Id", "X-Instana-Request-Id", "X-Instana-T", "Traceparent"}
+
+//APIError the error which is returned when the Instana API responds with a status code other than 2xx
+type APIError struct {
+	//Method the HTTP method of the failed request
+	Method string
+	//URL the URL of the failed request
+	URL string
+	//StatusCode the HTTP status code returned by the Instana API
+	StatusCode int
+	//Message the error message provided by the Instana API. Falls back to the (truncated) response body when the body does not contain a JSON error message
+	Message string
+	//RequestID the request or trace ID provided by the Instana API which can be used to correlate the request with the Instana support
+	RequestID string
+	//Headers the response headers where sensitive headers such as Authorization are redacted
+	Headers http.Header
+}
+
+//Error implementation of the error interface
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("failed to send HTTP %s request to Instana API; status code = %d", e.Method, e.StatusCode))
+	if statusText := http.StatusText(e.StatusCode); len(statusText) > 0 {
+		sb.WriteString(fmt.Sprintf(" (%s)", statusText))
+	}
+	sb.WriteString(fmt.Sprintf("; URL = %s", e.URL))
+	if len(e.Message) > 0 {
+		sb.WriteString(fmt.Sprintf("; message = %s", e.Message))
+	}
+	if len(e.RequestID) > 0 {
+		sb.WriteString(fmt.Sprintf("; request ID = %s", e.RequestID))
+	}
+	return sb.String()
+}
+
+//Is returns true when the target is ErrEntityNotFound and the Instana API responded with HTTP 404. This keeps errors.Is(err, ErrEntityNotFound) working for APIErrors
+func (e *APIError) Is(target error) bool {
+	return target == ErrEntityNotFound && e.StatusCode == http.StatusNotFound
+}
+
+//IsConflict returns true when the Instana API rejected the request because of a conflict with the current state of the resource (HTTP 409)
+func (e *APIError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+//IsValidationError returns true when the Instana API rejected the request because of invalid data (HTTP 400 or 422)
+func (e *APIError) IsValidationError() bool {
+	return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+}
+
+func newAPIError(method string, url string, statusCode int, headers http.Header, body []byte) *APIError {
+	return &APIError{
+		Method:     method,
+		URL:        url,
+		StatusCode: statusCode,
+		Message:    parseErrorMessage(body),
+		RequestID:  readRequestID(headers),
+		Headers:    redactHeaders(headers),
+	}
+}
+
+//instanaErrorResponse the structure of the error responses of the Instana API. Depending on the endpoint the message is provided in different fields
+type instanaErrorResponse struct {
+	Message string   `json:"message"`
+	Error   string   `json:"error"`
+	Errors  []string `json:"errors"`
+}
+
+func parseErrorMessage(body []byte) string {
+	trimmedBody := strings.TrimSpace(string(body))
+	if len(trimmedBody) == 0 {
+		return ""
+	}
+	errorResponse := instanaErrorResponse{}
+	if err := json.Unmarshal(body, &errorResponse); err == nil {
+		if len(errorResponse.Message) > 0 {
+			return errorResponse.Message
+		}
+		if len(errorResponse.Errors) > 0 {
+			return strings.Join(errorResponse.Errors, "; ")
+		}
+		if len(errorResponse.Error) > 0 {
+			return errorResponse.Error
+		}
+	}
+	if len(trimmedBody) > maxErrorBodyLength {
+		return trimmedBody[:maxErrorBodyLength] + "..."
+	}
+	return trimmedBody
+}
+
+func readRequestID(headers http.Header) string {
+	for _, header := range requestIDHeaders {
+		if value := headers.Get(header); len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	if redacted == nil {
+		return http.Header{}
+	}
+	for _, header := range sensitiveHeaders {
+		if len(redacted.Values(header)) > 0 {
+			redacted.Set(header, redactedValue)
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,106 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldCreateReadableErrorMessageOfAPIError(t *testing.T) {
+	err := &APIError{
+		Method:     http.MethodPost,
+		URL:        "https://instana.example.com/api/test",
+		StatusCode: http.StatusConflict,
+		Message:    "entity already exists",
+		RequestID:  "request-1234",
+	}
+
+	require.Equal(t, "failed to send HTTP POST request to Instana API; status code = 409 (Conflict); URL = https://instana.example.com/api/test; message = entity already exists; request ID = request-1234", err.Error())
+}
+
+func TestShouldOmitEmptyMessageAndRequestIDInErrorMessageOfAPIError(t *testing.T) {
+	err := &APIError{Method: http.MethodGet, URL: "https://instana.example.com/api/test", StatusCode: http.StatusInternalServerError}
+
+	require.Equal(t, "failed to send HTTP GET request to Instana API; status code = 500 (Internal Server Error); URL = https://instana.example.com/api/test", err.Error())
+}
+
+func TestShouldMatchErrEntityNotFoundWhenAPIErrorHasStatusNotFound(t *testing.T) {
+	require.True(t, errors.Is(&APIError{StatusCode: http.StatusNotFound}, ErrEntityNotFound))
+	require.False(t, errors.Is(&APIError{StatusCode: http.StatusBadRequest}, ErrEntityNotFound))
+}
+
+func TestShouldClassifyStatusCodeOfAPIError(t *testing.T) {
+	require.True(t, (&APIError{StatusCode: http.StatusConflict}).IsConflict())
+	require.False(t, (&APIError{StatusCode: http.StatusBadRequest}).IsConflict())
+	require.True(t, (&APIError{StatusCode: http.StatusBadRequest}).IsValidationError())
+	require.True(t, (&APIError{StatusCode: http.StatusUnprocessableEntity}).IsValidationError())
+	require.False(t, (&APIError{StatusCode: http.StatusConflict}).IsValidationError())
+}
+
+func TestShouldReturnAPIErrorWithParsedMessageFromJsonBody(t *testing.T) {
+	testCases := map[string]string{
+		`{"code":409,"message":"entity already exists"}`: "entity already exists",
+		`{"errors":["name is missing","id is invalid"]}`: "name is missing; id is invalid",
+		`{"error":"Bad Request"}`:                        "Bad Request",
+		`plain text error`:                               "plain text error",
+		``:                                               "",
+	}
+
+	for body, expectedMessage := range testCases {
+		t.Run("Should parse message of body "+body, func(t *testing.T) {
+			apiError := executeRequestAndGetAPIError(t, http.StatusConflict, http.Header{}, body)
+
+			require.Equal(t, expectedMessage, apiError.Message)
+			require.Equal(t, http.StatusConflict, apiError.StatusCode)
+			require.Equal(t, http.MethodGet, apiError.Method)
+			require.True(t, strings.HasSuffix(apiError.URL, testPath))
+		})
+	}
+}
+
+func TestShouldTruncateLongNonJsonBodyInMessageOfAPIError(t *testing.T) {
+	apiError := executeRequestAndGetAPIError(t, http.StatusBadRequest, http.Header{}, strings.Repeat("x", 2000))
+
+	require.Equal(t, strings.Repeat("x", 1024)+"...", apiError.Message)
+}
+
+func TestShouldReturnAPIErrorWithRequestIDAndRedactedHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Request-Id", "request-1234")
+	headers.Set("Set-Cookie", "session=secret")
+	headers.Set("Authorization", "apiToken secret")
+
+	apiError := executeRequestAndGetAPIError(t, http.StatusUnprocessableEntity, headers, `{"message":"invalid"}`)
+
+	require.Equal(t, "request-1234", apiError.RequestID)
+	require.Equal(t, "REDACTED", apiError.Headers.Get("Set-Cookie"))
+	require.Equal(t, "REDACTED", apiError.Headers.Get("Authorization"))
+	require.NotContains(t, apiError.Error(), "secret")
+}
+
+func executeRequestAndGetAPIError(t *testing.T, statusCode int, headers http.Header, body string) *APIError {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, testPath, func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range headers {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	_, err := createSut(httpServer).Get(context.Background(), testPath)
+
+	var apiError *APIError
+	require.ErrorAs(t, err, &apiError)
+	return apiError
+}
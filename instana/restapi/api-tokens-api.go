@@ -9,6 +9,9 @@ import (
 //APITokensResourcePath path to API Tokens resource of Instana RESTful API
 const APITokensResourcePath = SettingsBasePath + "/api-tokens"
 
+//apiTokenSecretFields the JSON fields of API tokens which contain secrets
+var apiTokenSecretFields = []string{"accessGrantingToken"}
+
 //APIToken is the representation of a API Token in Instana
 type APIToken struct {
 	ID                                   string `json:"id"`
@@ -31,25 +31,11 @@ func (u *applicationAlertConfigUnmarshaller) Unmarshal(data []byte) (interface{}
 	}
 	temp.TagFilterExpression = tagFilter
 	for i, v := range temp.CustomerPayloadFields {
-		temp.CustomerPayloadFields[i] = u.mapCustomPayloadField(v)
-	}
-	return temp, nil
-}
-
-func (u *applicationAlertConfigUnmarshaller) mapCustomPayloadField(field CustomPayloadField[any]) CustomPayloadField[any] {
-	if field.Type == DynamicCustomPayloadType {
-		data := field.Value.(map[string]interface{})
-		var keyPtr *string
-		if val, ok := data["key"]; ok {
-			key := val.(string)
-			keyPtr = &key
-		}
-		field.Value = DynamicCustomPayloadFieldValue{
-			TagName: data["tagName"].(string),
-			Key:     keyPtr,
+		field, err := unmarshalCustomPayloadFieldValue(v)
+		if err != nil {
+			return &ApplicationAlertConfig{}, err
 		}
-	} else {
-		field.Value = StaticStringCustomPayloadFieldValue(field.Value.(string))
+		temp.CustomerPayloadFields[i] = field
 	}
-	return field
+	return temp, nil
 }
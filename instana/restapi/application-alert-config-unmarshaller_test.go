@@ -157,3 +157,12 @@ func TestShouldFailToUnmarshalApplicationAlertConfigWhenTagFilterIsNotValid(t *t
 
 	require.Error(t, err)
 }
+
+func TestShouldFailToUnmarshalApplicationAlertConfigWhenCustomPayloadFieldIsNotValid(t *testing.T) {
+	response := `{ "id": "1234", "customPayloadFields": [ { "type": "staticString", "key": "team", "value": null } ] }`
+
+	_, err := NewApplicationAlertConfigUnmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "value of static custom payload field team is not a string")
+}
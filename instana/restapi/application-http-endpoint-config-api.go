@@ -0,0 +1,129 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//ApplicationHTTPEndpointConfigResourcePath path to application http endpoint config resource of Instana RESTful API
+const ApplicationHTTPEndpointConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/http-endpoint"
+
+//MaxHTTPEndpointRules the maximum number of rules of a HTTP endpoint config supported by the Instana API
+const MaxHTTPEndpointRules = 500
+
+//MaxHTTPEndpointRulePathSegments the maximum number of path segments of a HTTP endpoint rule supported by the Instana API
+const MaxHTTPEndpointRulePathSegments = 16
+
+//HTTPPathSegmentMatchingType type definition of the matching type of a path segment of a HTTP endpoint rule
+type HTTPPathSegmentMatchingType string
+
+//HTTPPathSegmentMatchingTypes type definition of slice of HTTPPathSegmentMatchingType
+type HTTPPathSegmentMatchingTypes []HTTPPathSegmentMatchingType
+
+//IsSupported checks if the given HTTPPathSegmentMatchingType is defined as a supported HTTPPathSegmentMatchingType of the underlying slice
+func (types HTTPPathSegmentMatchingTypes) IsSupported(t HTTPPathSegmentMatchingType) bool {
+	for _, v := range types {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	//HTTPPathSegmentMatchingTypeFixed constant for the path segment type FIXED. The segment matches the configured name exactly
+	HTTPPathSegmentMatchingTypeFixed = HTTPPathSegmentMatchingType("FIXED")
+	//HTTPPathSegmentMatchingTypeParameter constant for the path segment type PARAMETER. The segment matches any value and is represented by the configured name in the endpoint name
+	HTTPPathSegmentMatchingTypeParameter = HTTPPathSegmentMatchingType("PARAMETER")
+	//HTTPPathSegmentMatchingTypeMatchAll constant for the path segment type MATCH_ALL. The segment matches all remaining path segments
+	HTTPPathSegmentMatchingTypeMatchAll = HTTPPathSegmentMatchingType("MATCH_ALL")
+)
+
+//SupportedHTTPPathSegmentMatchingTypes supported HTTPPathSegmentMatchingTypes of the Instana Web REST API
+var SupportedHTTPPathSegmentMatchingTypes = HTTPPathSegmentMatchingTypes{
+	HTTPPathSegmentMatchingTypeFixed,
+	HTTPPathSegmentMatchingTypeParameter,
+	HTTPPathSegmentMatchingTypeMatchAll,
+}
+
+//HTTPPathSegmentMatchingRule represents a single path segment of a HTTP endpoint rule
+type HTTPPathSegmentMatchingRule struct {
+	Type HTTPPathSegmentMatchingType `json:"type"`
+	Name *string                     `json:"name,omitempty"`
+}
+
+//Validate validates the path segment
+func (s HTTPPathSegmentMatchingRule) Validate() error {
+	if !SupportedHTTPPathSegmentMatchingTypes.IsSupported(s.Type) {
+		return fmt.Errorf("path segment type '%s' is not supported", s.Type)
+	}
+	if s.Type == HTTPPathSegmentMatchingTypeMatchAll {
+		if s.Name != nil {
+			return errors.New("name is not allowed for path segments of type MATCH_ALL")
+		}
+	} else if s.Name == nil || utils.IsBlank(*s.Name) {
+		return fmt.Errorf("name is missing for path segment of type %s", s.Type)
+	}
+	return nil
+}
+
+//HTTPEndpointRule represents a path template rule of the HTTP endpoint config which is used to derive endpoint names from HTTP paths
+type HTTPEndpointRule struct {
+	Enabled      bool                          `json:"enabled"`
+	PathSegments []HTTPPathSegmentMatchingRule `json:"pathSegments"`
+	TestCases    []string                      `json:"testCases"`
+}
+
+//Validate validates the HTTP endpoint rule
+func (r HTTPEndpointRule) Validate() error {
+	if len(r.PathSegments) == 0 {
+		return errors.New("path segments are missing")
+	}
+	if len(r.PathSegments) > MaxHTTPEndpointRulePathSegments {
+		return fmt.Errorf("a maximum of %d path segments is supported", MaxHTTPEndpointRulePathSegments)
+	}
+	for i, segment := range r.PathSegments {
+		if err := segment.Validate(); err != nil {
+			return err
+		}
+		if segment.Type == HTTPPathSegmentMatchingTypeMatchAll && i < len(r.PathSegments)-1 {
+			return errors.New("path segments of type MATCH_ALL are only allowed as last path segment")
+		}
+	}
+	return nil
+}
+
+//ApplicationHTTPEndpointConfig represents the REST resource of application http endpoint configuration at Instana. A
+//HTTP endpoint configuration exists per service; therefore, the service ID is used as identifier of the configuration
+type ApplicationHTTPEndpointConfig struct {
+	ServiceID                                      string             `json:"serviceId"`
+	EndpointNameByFirstPathSegmentRuleEnabled      bool               `json:"endpointNameByFirstPathSegmentRuleEnabled"`
+	EndpointNameByCollectedPathTemplateRuleEnabled bool               `json:"endpointNameByCollectedPathTemplateRuleEnabled"`
+	Rules                                          []HTTPEndpointRule `json:"rules"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ApplicationHTTPEndpointConfig) GetIDForResourcePath() string {
+	return c.ServiceID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *ApplicationHTTPEndpointConfig) Validate() error {
+	if utils.IsBlank(c.ServiceID) {
+		return errors.New("service id is missing")
+	}
+	if c.Rules == nil {
+		return errors.New("rules are missing")
+	}
+	if len(c.Rules) > MaxHTTPEndpointRules {
+		return fmt.Errorf("a maximum of %d rules is supported", MaxHTTPEndpointRules)
+	}
+	for _, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
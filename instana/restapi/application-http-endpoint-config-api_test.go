@@ -0,0 +1,163 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const httpEndpointConfigServiceID = "http-endpoint-config-service-id"
+
+func TestShouldReturnServiceIDOfApplicationHTTPEndpointConfigAsIDForResourcePath(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+
+	require.Equal(t, httpEndpointConfigServiceID, config.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateApplicationHTTPEndpointConfig(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldSuccessfullyValidateApplicationHTTPEndpointConfigWithoutRules(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules = []HTTPEndpointRule{}
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenRulesAreMissing(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules = nil
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rules are missing")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenTooManyRulesAreProvided(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	rules := make([]HTTPEndpointRule, MaxHTTPEndpointRules+1)
+	for i := range rules {
+		rules[i] = config.Rules[0]
+	}
+	config.Rules = rules
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maximum of 500 rules")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenServiceIDIsMissing(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.ServiceID = " "
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "service id")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenRuleHasNoPathSegments(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules[0].PathSegments = []HTTPPathSegmentMatchingRule{}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "path segments are missing")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenRuleHasTooManyPathSegments(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	pathSegments := make([]HTTPPathSegmentMatchingRule, MaxHTTPEndpointRulePathSegments+1)
+	for i := range pathSegments {
+		pathSegments[i] = HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeFixed, Name: utils.StringPtr("foo")}
+	}
+	config.Rules[0].PathSegments = pathSegments
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maximum of 16 path segments")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenPathSegmentTypeIsNotSupported(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules[0].PathSegments[0].Type = HTTPPathSegmentMatchingType("INVALID")
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "INVALID")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenNameOfPathSegmentIsMissing(t *testing.T) {
+	for _, segmentType := range []HTTPPathSegmentMatchingType{HTTPPathSegmentMatchingTypeFixed, HTTPPathSegmentMatchingTypeParameter} {
+		t.Run(string(segmentType), func(t *testing.T) {
+			config := createValidApplicationHTTPEndpointConfig()
+			config.Rules[0].PathSegments[0] = HTTPPathSegmentMatchingRule{Type: segmentType}
+
+			err := config.Validate()
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "name is missing")
+		})
+	}
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenNameIsProvidedForMatchAllPathSegment(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules[0].PathSegments[2].Name = utils.StringPtr("foo")
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "name is not allowed")
+}
+
+func TestShouldFailToValidateApplicationHTTPEndpointConfigWhenMatchAllPathSegmentIsNotTheLastSegment(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+	config.Rules[0].PathSegments = append(config.Rules[0].PathSegments, HTTPPathSegmentMatchingRule{Type: HTTPPathSegmentMatchingTypeFixed, Name: utils.StringPtr("foo")})
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only allowed as last path segment")
+}
+
+func TestShouldSuccessfullyUnmarshalApplicationHTTPEndpointConfig(t *testing.T) {
+	config := createValidApplicationHTTPEndpointConfig()
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewDefaultJSONUnmarshaller(&ApplicationHTTPEndpointConfig{}).Unmarshal(serializedJSON)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func createValidApplicationHTTPEndpointConfig() *ApplicationHTTPEndpointConfig {
+	return &ApplicationHTTPEndpointConfig{
+		ServiceID: httpEndpointConfigServiceID,
+		EndpointNameByFirstPathSegmentRuleEnabled:      true,
+		EndpointNameByCollectedPathTemplateRuleEnabled: false,
+		Rules: []HTTPEndpointRule{
+			{
+				Enabled: true,
+				PathSegments: []HTTPPathSegmentMatchingRule{
+					{Type: HTTPPathSegmentMatchingTypeFixed, Name: utils.StringPtr("api")},
+					{Type: HTTPPathSegmentMatchingTypeParameter, Name: utils.StringPtr("id")},
+					{Type: HTTPPathSegmentMatchingTypeMatchAll},
+				},
+				TestCases: []string{"/api/1234/foo"},
+			},
+		},
+	}
+}
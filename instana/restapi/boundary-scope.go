@@ -39,3 +39,6 @@ var SupportedApplicationConfigBoundaryScopes = BoundaryScopes{BoundaryScopeAll,
 
 //SupportedApplicationAlertConfigBoundaryScopes supported BoundaryScopes of the Instana Web REST API
 var SupportedApplicationAlertConfigBoundaryScopes = BoundaryScopes{BoundaryScopeAll, BoundaryScopeInbound}
+
+//SupportedSliBoundaryScopes supported BoundaryScopes of application SLI entities of the Instana Web REST API
+var SupportedSliBoundaryScopes = BoundaryScopes{BoundaryScopeAll, BoundaryScopeInbound}
@@ -1,5 +1,7 @@
 package restapi
 
+import "fmt"
+
 //CustomPayloadType custom type for the type of a custom payload
 type CustomPayloadType string
 
@@ -50,3 +52,38 @@ type CustomPayloadField[T any] struct {
 	Key   string            `json:"key"`
 	Value T                 `json:"value"`
 }
+
+//unmarshalCustomPayloadFieldValue converts the generic JSON value of a custom payload field into the value type of the
+//custom payload type, either DynamicCustomPayloadFieldValue or StaticStringCustomPayloadFieldValue. The key of dynamic
+//values is optional and may be null or missing
+func unmarshalCustomPayloadFieldValue(field CustomPayloadField[any]) (CustomPayloadField[any], error) {
+	if field.Type == DynamicCustomPayloadType {
+		data, ok := field.Value.(map[string]interface{})
+		if !ok {
+			return field, fmt.Errorf("value of dynamic custom payload field %s is not an object", field.Key)
+		}
+		var keyPtr *string
+		if val, ok := data["key"]; ok && val != nil {
+			key, ok := val.(string)
+			if !ok {
+				return field, fmt.Errorf("key of dynamic custom payload field %s is not a string", field.Key)
+			}
+			keyPtr = &key
+		}
+		tagName, ok := data["tagName"].(string)
+		if !ok {
+			return field, fmt.Errorf("tag name of dynamic custom payload field %s is missing", field.Key)
+		}
+		field.Value = DynamicCustomPayloadFieldValue{
+			TagName: tagName,
+			Key:     keyPtr,
+		}
+		return field, nil
+	}
+	value, ok := field.Value.(string)
+	if !ok {
+		return field, fmt.Errorf("value of static custom payload field %s is not a string", field.Key)
+	}
+	field.Value = StaticStringCustomPayloadFieldValue(value)
+	return field, nil
+}
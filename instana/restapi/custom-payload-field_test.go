@@ -22,3 +22,45 @@ func TestShouldReturnSupportedCustomPayloadTypesAsStringSlice(t *testing.T) {
 	expected := []string{"staticString", "dynamic"}
 	require.Equal(t, expected, SupportedCustomPayloadTypes.ToStringSlice())
 }
+
+func TestShouldUnmarshalDynamicCustomPayloadFieldValueWithoutKey(t *testing.T) {
+	for _, value := range []map[string]interface{}{{"tagName": "tag"}, {"tagName": "tag", "key": nil}} {
+		result, err := unmarshalCustomPayloadFieldValue(CustomPayloadField[any]{Type: DynamicCustomPayloadType, Key: "field", Value: value})
+
+		require.NoError(t, err)
+		require.Equal(t, DynamicCustomPayloadFieldValue{TagName: "tag"}, result.Value)
+	}
+}
+
+func TestShouldUnmarshalDynamicCustomPayloadFieldValueWithKey(t *testing.T) {
+	result, err := unmarshalCustomPayloadFieldValue(CustomPayloadField[any]{Type: DynamicCustomPayloadType, Key: "field", Value: map[string]interface{}{"tagName": "tag", "key": "key"}})
+
+	require.NoError(t, err)
+	key := "key"
+	require.Equal(t, DynamicCustomPayloadFieldValue{TagName: "tag", Key: &key}, result.Value)
+}
+
+func TestShouldUnmarshalStaticCustomPayloadFieldValue(t *testing.T) {
+	result, err := unmarshalCustomPayloadFieldValue(CustomPayloadField[any]{Type: StaticCustomPayloadType, Key: "field", Value: "value"})
+
+	require.NoError(t, err)
+	require.Equal(t, StaticStringCustomPayloadFieldValue("value"), result.Value)
+}
+
+func TestShouldFailToUnmarshalInvalidCustomPayloadFieldValues(t *testing.T) {
+	testCases := map[string]CustomPayloadField[any]{
+		"value of dynamic custom payload field field is not an object": {Type: DynamicCustomPayloadType, Key: "field", Value: nil},
+		"tag name of dynamic custom payload field field is missing":    {Type: DynamicCustomPayloadType, Key: "field", Value: map[string]interface{}{"key": "key"}},
+		"key of dynamic custom payload field field is not a string":    {Type: DynamicCustomPayloadType, Key: "field", Value: map[string]interface{}{"tagName": "tag", "key": 1.0}},
+		"value of static custom payload field field is not a string":   {Type: StaticCustomPayloadType, Key: "field", Value: nil},
+	}
+
+	for expectedError, field := range testCases {
+		t.Run(expectedError, func(t *testing.T) {
+			_, err := unmarshalCustomPayloadFieldValue(field)
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err.Error())
+		})
+	}
+}
@@ -24,8 +24,9 @@ type defaultJSONUnmarshaller struct {
 
 //Unmarshal JSONUnmarshaller interface implementation
 func (u *defaultJSONUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
-	target := u.objectType
-	if err := json.Unmarshal(data, &target); err != nil {
+	//create a new instance for every call so that unmarshalled objects do not share state
+	target := reflect.New(reflect.TypeOf(u.objectType).Elem()).Interface()
+	if err := json.Unmarshal(data, target); err != nil {
 		return target, fmt.Errorf("failed to parse json; %s", err)
 	}
 	return target, nil
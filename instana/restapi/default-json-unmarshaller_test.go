@@ -106,3 +106,15 @@ type TestObject struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
+
+func TestShouldCreateNewObjectForEveryUnmarshalledObject(t *testing.T) {
+	sut := NewDefaultJSONUnmarshaller(&TestObject{})
+
+	result1, err := sut.Unmarshal([]byte(`{"id":"id1","name":"name1"}`))
+	require.NoError(t, err)
+	result2, err := sut.Unmarshal([]byte(`{"id":"id2"}`))
+	require.NoError(t, err)
+
+	require.Equal(t, &TestObject{ID: "id1", Name: "name1"}, result1)
+	require.Equal(t, &TestObject{ID: "id2"}, result2)
+}
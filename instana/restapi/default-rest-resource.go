@@ -1,6 +1,11 @@
 package restapi
 
-import "errors"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
 
 //NewCreatePUTUpdatePUTRestResource creates a new REST resource using the provided unmarshaller function to convert the response from the REST API to the corresponding InstanaDataObject. The REST resource is using PUT as operation for create and update
 func NewCreatePUTUpdatePUTRestResource(resourcePath string, unmarshaller JSONUnmarshaller, client RestClient) RestResource {
@@ -35,7 +40,7 @@ func NewCreatePOSTUpdatePOSTRestResource(resourcePath string, unmarshaller JSONU
 //DefaultRestResourceMode custom type for create/update behavior of the defaultRestResource
 type DefaultRestResourceMode string
 
-type restClientOperation func(InstanaDataObject, string) ([]byte, error)
+type restClientOperation func(context.Context, InstanaDataObject, string) ([]byte, error)
 
 const (
 	//DefaultRestResourceModeCreateAndUpdatePUT constant value for the DefaultRestResourceMode CREATE_PUT_UPDATE_PUT where create and update is implemented as an upsert using HTTP PUT method only
@@ -53,33 +58,63 @@ type defaultRestResource struct {
 	client       RestClient
 }
 
-func (r *defaultRestResource) GetOne(id string) (InstanaDataObject, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+func (r *defaultRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalArray(data, r.unmarshaller)
+}
+
+//unmarshalArray converts the provided JSON array into InstanaDataObjects using the unmarshaller of the single object. Objects
+//are not validated as lists are used to enumerate all objects, including objects which are not managed by terraform
+func unmarshalArray(data []byte, unmarshaller JSONUnmarshaller) (*[]InstanaDataObject, error) {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return nil, fmt.Errorf("failed to parse json array; %s", err)
+	}
+	result := make([]InstanaDataObject, len(elements))
+	for i, element := range elements {
+		object, err := unmarshaller.Unmarshal(element)
+		if err != nil {
+			return nil, err
+		}
+		dataObject, ok := object.(InstanaDataObject)
+		if !ok {
+			return nil, errors.New("unmarshalled object does not implement InstanaDataObject")
+		}
+		result[i] = dataObject
+	}
+	return &result, nil
+}
+
+func (r *defaultRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
 	return r.validateResponseAndConvertToStruct(data)
 }
 
-func (r *defaultRestResource) Create(data InstanaDataObject) (InstanaDataObject, error) {
+func (r *defaultRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
 	if r.mode == DefaultRestResourceModeCreateAndUpdatePUT {
-		return r.upsert(data, r.client.Put)
+		return r.upsert(ctx, data, r.client.Put)
 	}
-	return r.upsert(data, r.client.Post)
+	return r.upsert(ctx, data, r.client.Post)
 }
 
-func (r *defaultRestResource) Update(data InstanaDataObject) (InstanaDataObject, error) {
+func (r *defaultRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
 	if r.mode == DefaultRestResourceModeCreateAndUpdatePOST {
-		return r.upsert(data, r.client.PostWithID)
+		return r.upsert(ctx, data, r.client.PostWithID)
 	}
-	return r.upsert(data, r.client.Put)
+	return r.upsert(ctx, data, r.client.Put)
 }
 
-func (r *defaultRestResource) upsert(data InstanaDataObject, operation restClientOperation) (InstanaDataObject, error) {
+func (r *defaultRestResource) upsert(ctx context.Context, data InstanaDataObject, operation restClientOperation) (InstanaDataObject, error) {
 	if err := data.Validate(); err != nil {
 		return data, err
 	}
-	response, err := operation(data, r.resourcePath)
+	response, err := operation(ctx, data, r.resourcePath)
 	if err != nil {
 		return data, err
 	}
@@ -102,10 +137,10 @@ func (r *defaultRestResource) validateResponseAndConvertToStruct(data []byte) (I
 	return dataObject, nil
 }
 
-func (r *defaultRestResource) Delete(data InstanaDataObject) error {
-	return r.DeleteByID(data.GetIDForResourcePath())
+func (r *defaultRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
 }
 
-func (r *defaultRestResource) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *defaultRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
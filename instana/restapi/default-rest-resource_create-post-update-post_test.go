@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -16,11 +17,11 @@ func TestSuccessfulCreateOfTestObjectThroughCreatePOSTUpdatePOSTRestResource(t *
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Create(testObject)
+		result, err := sut.Create(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -31,12 +32,12 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePOSTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -47,12 +48,12 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -64,12 +65,12 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 		testObject := makeTestObject()
 		response := []byte("{ \"invalid\" : \"testObject\" }")
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -83,12 +84,12 @@ func TestShouldFailedToCreateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWh
 			Name: "invalid name",
 		}
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -98,12 +99,12 @@ func TestShouldFailedToCreateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWh
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePOSTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		object := makeTestObject()
 
-		client.EXPECT().Post(gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(&testObject{ID: object.ID, Name: "invalid"}, nil)
 
-		_, err := sut.Create(object)
+		_, err := sut.Create(context.Background(), object)
 
 		assert.Error(t, err)
 	})
@@ -114,12 +115,12 @@ func TestSuccessfulUpdateOfTestObjectThroughCreatePOSTUpdatePOSTRestResource(t *
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().PostWithID(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Update(testObject)
+		result, err := sut.Update(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -130,12 +131,12 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePOSTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().PostWithID(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -146,12 +147,12 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().PostWithID(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -163,12 +164,12 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWhen
 		testObject := makeTestObject()
 		response := []byte("{ \"invalid\" : \"testObject\" }")
 
-		client.EXPECT().PostWithID(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -182,12 +183,12 @@ func TestShouldFailedToUpdateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWh
 			Name: "invalid name",
 		}
 
-		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -197,12 +198,12 @@ func TestShouldFailedToUpdateTestObjectThroughCreatePOSTUpdatePOSTRestResourceWh
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePOSTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		object := makeTestObject()
 
-		client.EXPECT().PostWithID(gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().PostWithID(gomock.Any(), gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(&testObject{ID: object.ID, Name: "invalid"}, nil)
 
-		_, err := sut.Update(object)
+		_, err := sut.Update(context.Background(), object)
 
 		assert.Error(t, err)
 	})
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -20,10 +21,10 @@ func TestSuccessfulCreateOfTestObjectThroughCreatePOSTUpdatePUTRestResource(t *t
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Create(testObject)
+		result, err := sut.Create(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -34,11 +35,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenE
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePUTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -49,11 +50,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenR
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -65,11 +66,11 @@ func TestShouldFailToCreateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenT
 		testObject := makeTestObject()
 		response := []byte("{ \"invalid\" : \"testObject\" }")
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -83,11 +84,11 @@ func TestShouldFailedToCreateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhe
 			Name: "invalid name",
 		}
 
-		client.EXPECT().Post(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Create(testObject)
+		_, err := sut.Create(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -97,11 +98,11 @@ func TestShouldFailedToCreateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhe
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePUTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		object := makeTestObject()
 
-		client.EXPECT().Post(gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
-		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(&testObject{ID: object.ID, Name: "invalid"}, nil)
 
-		_, err := sut.Create(object)
+		_, err := sut.Create(context.Background(), object)
 
 		assert.Error(t, err)
 	})
@@ -112,11 +113,11 @@ func TestSuccessfulUpdateOfTestObjectThroughCreatePOSTUpdatePUTRestResource(t *t
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := sut.Update(testObject)
+		result, err := sut.Update(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -127,11 +128,11 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenE
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePUTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -142,11 +143,11 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenR
 		testObject := makeTestObject()
 		expectedError := errors.New("test")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(invalidResponse, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(nil, expectedError)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -158,11 +159,11 @@ func TestShouldFailToUpdateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhenT
 		testObject := makeTestObject()
 		response := []byte("{ \"invalid\" : \"testObject\" }")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -176,11 +177,11 @@ func TestShouldFailedToUpdateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhe
 			Name: "invalid name",
 		}
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.Update(testObject)
+		_, err := sut.Update(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -190,11 +191,11 @@ func TestShouldFailedToUpdateTestObjectThroughCreatePOSTUpdatePUTRestResourceWhe
 	executeCreateOrUpdateOperationThroughCreatePOSTUpdatePUTRestResourceTest(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		object := makeTestObject()
 
-		client.EXPECT().Put(gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
-		client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(invalidResponse, nil)
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(invalidResponse).Times(1).Return(&testObject{ID: object.ID, Name: "invalid"}, nil)
 
-		_, err := sut.Update(object)
+		_, err := sut.Update(context.Background(), object)
 
 		assert.Error(t, err)
 	})
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,10 +18,10 @@ func TestSuccessfulCreateOrUpdateOfTestObjectThroughCreatePUTUpdatePUTRestResour
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		result, err := resourceFunc(testObject)
+		result, err := resourceFunc(context.Background(), testObject)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, result)
@@ -31,10 +32,10 @@ func TestShouldFailToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestResour
 	executeCreateOrUpdateOperationThroughCreatePUTUpdatePUTRestResourceTest(t, func(t *testing.T, resourceFunc createUpdateFunc, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("Error during test"))
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -46,10 +47,10 @@ func TestShouldFailToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestResour
 		response := []byte("invalid response")
 		expectedError := errors.New("test")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(nil, expectedError)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -61,10 +62,10 @@ func TestShouldFailToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestResour
 		testObject := makeTestObject()
 		response := []byte("{ \"invalid\" : \"testObject\" }")
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -78,10 +79,10 @@ func TestShouldFailedToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestReso
 			Name: "invalid name",
 		}
 
-		client.EXPECT().Put(gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(testObject), gomock.Eq(testObjectResourcePath)).Times(0)
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := resourceFunc(testObject)
+		_, err := resourceFunc(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
@@ -92,16 +93,16 @@ func TestShouldFailedToCreateOrUpdateTestObjectThroughCreatePUTUpdatePUTRestReso
 		object := makeTestObject()
 		response := []byte("invalid response")
 
-		client.EXPECT().Put(gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(response, nil)
+		client.EXPECT().Put(gomock.Any(), gomock.Eq(object), gomock.Eq(testObjectResourcePath)).Times(1).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&testObject{ID: object.ID, Name: "invalid"}, nil)
 
-		_, err := resourceFunc(object)
+		_, err := resourceFunc(context.Background(), object)
 
 		assert.Error(t, err)
 	})
 }
 
-type createUpdateFunc func(data InstanaDataObject) (InstanaDataObject, error)
+type createUpdateFunc func(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error)
 type createPutUpdatePutContext struct {
 	operation           string
 	resourceFuncFactory func(RestResource) createUpdateFunc
@@ -124,7 +125,7 @@ func executeCreateOrUpdateOperationThroughCreatePUTUpdatePUTRestResourceTest(t *
 
 			sut := NewCreatePUTUpdatePUTRestResource(testObjectResourcePath, unmarshaller, client)
 
-			client.EXPECT().Post(gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
+			client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Eq(testObjectResourcePath)).Times(0)
 			testFunction(t, context.resourceFuncFactory(sut), client, unmarshaller)
 		})
 	}
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,15 +40,81 @@ func makeTestObject() *testObject {
 	return &testObject{ID: testObjectID, Name: testObjectName}
 }
 
+func TestSuccessfulGetAllTestObjectsThroughDefaultRestResource(t *testing.T) {
+	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
+		object1 := &testObject{ID: "id1", Name: testObjectName}
+		object2 := &testObject{ID: "id2", Name: "other-name"}
+		serializedObject1, _ := json.Marshal(object1)
+		serializedObject2, _ := json.Marshal(object2)
+		response := []byte(fmt.Sprintf("[%s,%s]", serializedObject1, serializedObject2))
+
+		client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedObject1)).Times(1).Return(object1, nil)
+		unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedObject2)).Times(1).Return(object2, nil)
+
+		data, err := sut.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &[]InstanaDataObject{object1, object2}, data)
+	})
+}
+
+func TestShouldReturnEmptyListWhenNoObjectIsReturnedByDefaultRestResource(t *testing.T) {
+	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
+		client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return([]byte("[]"), nil)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		data, err := sut.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, *data)
+	})
+}
+
+func TestShouldFailToGetAllTestObjectsThroughDefaultRestResourceWhenErrorIsRetrievedFromRestClient(t *testing.T) {
+	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
+		client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		_, err := sut.GetAll(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestShouldFailToGetAllTestObjectsThroughDefaultRestResourceWhenResponseIsNotAJsonArray(t *testing.T) {
+	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
+		client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return([]byte("{ \"invalid\" : \"data\" }"), nil)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+		_, err := sut.GetAll(context.Background())
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse json array")
+	})
+}
+
+func TestShouldFailToGetAllTestObjectsThroughDefaultRestResourceWhenElementCannotBeUnmarshalled(t *testing.T) {
+	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
+		expectedError := errors.New("test")
+		client.EXPECT().Get(gomock.Any(), gomock.Eq(testObjectResourcePath)).Return([]byte("[{ \"invalid\" : \"data\" }]"), nil)
+		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(1).Return(nil, expectedError)
+
+		_, err := sut.GetAll(context.Background())
+
+		assert.Equal(t, expectedError, err)
+	})
+}
+
 func TestSuccessfulGetOneTestObjectThroughDefaultRestResource(t *testing.T) {
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 		serializedJSON, _ := json.Marshal(testObject)
 
-		client.EXPECT().GetOne(gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObject.ID), gomock.Eq(testObjectResourcePath)).Return(serializedJSON, nil)
 		unmarshaller.EXPECT().Unmarshal(serializedJSON).Times(1).Return(testObject, nil)
 
-		data, err := sut.GetOne(testObject.ID)
+		data, err := sut.GetOne(context.Background(), testObject.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, testObject, data)
@@ -56,10 +123,10 @@ func TestSuccessfulGetOneTestObjectThroughDefaultRestResource(t *testing.T) {
 
 func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenErrorIsRetrievedFromRestClient(t *testing.T) {
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil, errors.New("error during test"))
 		unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 	})
@@ -70,10 +137,10 @@ func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenResponseCanno
 		expectedError := errors.New("test")
 		response := []byte("[{ \"invalid\" : \"data\" }]")
 
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(nil, expectedError)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedError, err)
@@ -86,10 +153,10 @@ func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenUnmarshalledO
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		response := []byte("[{ \"some\" : \"data\" }]")
 
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(&InvalidInstanaDataObject{}, nil)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unmarshalled object does not implement InstanaDataObject")
@@ -102,10 +169,10 @@ func TestShouldFailToGetOneTestObjectThroughDefaultRestResourceWhenUnmarshalledO
 		object := makeTestObject()
 		object.Name = "invalid"
 
-		client.EXPECT().GetOne(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
+		client.EXPECT().GetOne(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(response, nil)
 		unmarshaller.EXPECT().Unmarshal(response).Times(1).Return(object, nil)
 
-		_, err := sut.GetOne(testObjectID)
+		_, err := sut.GetOne(context.Background(), testObjectID)
 
 		assert.Error(t, err)
 	})
@@ -115,9 +182,9 @@ func TestSuccessfulDeleteOfTestObjectByObjectThroughDefaultRestResource(t *testi
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
+		client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(nil)
 
-		err := sut.Delete(testObject)
+		err := sut.Delete(context.Background(), testObject)
 
 		assert.NoError(t, err)
 	})
@@ -127,9 +194,9 @@ func TestShouldFailToDeleteTestObjectThroughDefaultRestResourceWhenErrorIsRetrun
 	executeForAllImplementationsOfDefaultRestResource(t, func(t *testing.T, sut RestResource, client *mocks.MockRestClient, unmarshaller *mocks.MockJSONUnmarshaller) {
 		testObject := makeTestObject()
 
-		client.EXPECT().Delete(gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
+		client.EXPECT().Delete(gomock.Any(), gomock.Eq(testObjectID), gomock.Eq(testObjectResourcePath)).Return(errors.New("Error during test"))
 
-		err := sut.Delete(testObject)
+		err := sut.Delete(context.Background(), testObject)
 
 		assert.Error(t, err)
 	})
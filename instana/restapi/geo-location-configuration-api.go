@@ -0,0 +1,83 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+//GeoLocationConfigurationPathElement path element of the geo location configuration of website and mobile app monitoring configs
+const GeoLocationConfigurationPathElement = "geo-location"
+
+//GeoDetailRemoval custom type for the geo location details which are removed from the beacons
+type GeoDetailRemoval string
+
+//GeoDetailRemovals custom type for a slice of GeoDetailRemoval
+type GeoDetailRemovals []GeoDetailRemoval
+
+//IsSupported check if the provided GeoDetailRemoval is supported
+func (removals GeoDetailRemovals) IsSupported(removal GeoDetailRemoval) bool {
+	for _, r := range removals {
+		if r == removal {
+			return true
+		}
+	}
+	return false
+}
+
+//ToStringSlice Returns the corresponding string representations
+func (removals GeoDetailRemovals) ToStringSlice() []string {
+	result := make([]string, len(removals))
+	for i, v := range removals {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//GeoDetailRemovalNoRemoval constant value for GeoDetailRemoval NO_REMOVAL
+	GeoDetailRemovalNoRemoval = GeoDetailRemoval("NO_REMOVAL")
+	//GeoDetailRemovalRemoveCoordinates constant value for GeoDetailRemoval REMOVE_COORDINATES
+	GeoDetailRemovalRemoveCoordinates = GeoDetailRemoval("REMOVE_COORDINATES")
+	//GeoDetailRemovalRemoveCity constant value for GeoDetailRemoval REMOVE_CITY
+	GeoDetailRemovalRemoveCity = GeoDetailRemoval("REMOVE_CITY")
+	//GeoDetailRemovalRemoveAll constant value for GeoDetailRemoval REMOVE_ALL
+	GeoDetailRemovalRemoveAll = GeoDetailRemoval("REMOVE_ALL")
+)
+
+//SupportedGeoDetailRemovals list of all supported GeoDetailRemoval
+var SupportedGeoDetailRemovals = GeoDetailRemovals{GeoDetailRemovalNoRemoval, GeoDetailRemovalRemoveCoordinates, GeoDetailRemovalRemoveCity, GeoDetailRemovalRemoveAll}
+
+//GeoLocationConfiguration data structure for the Instana API model of the geo location configuration of a website or
+//mobile app monitoring config. The geo mapping rules are managed separately and are therefore kept as they are
+type GeoLocationConfiguration struct {
+	MonitoringConfigID string           `json:"-"`
+	GeoDetailRemoval   GeoDetailRemoval `json:"geoDetailRemoval"`
+	GeoMappingRules    json.RawMessage  `json:"geoMappingRules,omitempty"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the ID of the monitoring config
+func (c *GeoLocationConfiguration) GetIDForResourcePath() string {
+	return c.MonitoringConfigID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *GeoLocationConfiguration) Validate() error {
+	if len(c.MonitoringConfigID) == 0 {
+		return errors.New("ID of monitoring config is missing")
+	}
+	if !SupportedGeoDetailRemovals.IsSupported(c.GeoDetailRemoval) {
+		return fmt.Errorf("geo detail removal %s is not supported", c.GeoDetailRemoval)
+	}
+	return nil
+}
+
+//Defaults implementation of the interface MonitoringConfigSettings. Geo location details are not removed by default
+func (c *GeoLocationConfiguration) Defaults() MonitoringConfigSettings {
+	return &GeoLocationConfiguration{MonitoringConfigID: c.MonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalNoRemoval}
+}
+
+//KeepUnmanagedSettings implementation of the interface MonitoringConfigSettings. Keeps the current geo mapping rules
+func (c *GeoLocationConfiguration) KeepUnmanagedSettings(current MonitoringConfigSettings) {
+	c.GeoMappingRules = current.(*GeoLocationConfiguration).GeoMappingRules
+}
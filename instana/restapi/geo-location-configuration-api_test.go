@@ -0,0 +1,85 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const testMonitoringConfigID = "monitoring-config-id"
+
+func TestShouldReturnTrueForAllSupportedGeoDetailRemovals(t *testing.T) {
+	for _, v := range SupportedGeoDetailRemovals {
+		require.True(t, SupportedGeoDetailRemovals.IsSupported(v))
+	}
+}
+
+func TestShouldReturnFalseForAllNonSupportedGeoDetailRemovals(t *testing.T) {
+	for _, v := range []string{"FOO", "BAR", "INVALID"} {
+		require.False(t, SupportedGeoDetailRemovals.IsSupported(GeoDetailRemoval(v)))
+	}
+}
+
+func TestShouldReturnSupportedGeoDetailRemovalsAsStringSlice(t *testing.T) {
+	expected := []string{"NO_REMOVAL", "REMOVE_COORDINATES", "REMOVE_CITY", "REMOVE_ALL"}
+	require.Equal(t, expected, SupportedGeoDetailRemovals.ToStringSlice())
+}
+
+func TestShouldReturnMonitoringConfigIDAsIDOfGeoLocationConfiguration(t *testing.T) {
+	config := GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID}
+
+	require.Equal(t, testMonitoringConfigID, config.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateGeoLocationConfiguration(t *testing.T) {
+	for _, removal := range SupportedGeoDetailRemovals {
+		config := GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: removal}
+
+		require.NoError(t, config.Validate())
+	}
+}
+
+func TestShouldFailToValidateGeoLocationConfigurationWhenMonitoringConfigIDIsMissing(t *testing.T) {
+	config := GeoLocationConfiguration{GeoDetailRemoval: GeoDetailRemovalNoRemoval}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ID")
+}
+
+func TestShouldFailToValidateGeoLocationConfigurationWhenGeoDetailRemovalIsNotSupported(t *testing.T) {
+	config := GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemoval("INVALID")}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "INVALID")
+}
+
+func TestShouldReturnDefaultsOfGeoLocationConfigurationForTheSameMonitoringConfig(t *testing.T) {
+	config := GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll}
+
+	require.Equal(t, &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalNoRemoval}, config.Defaults())
+}
+
+func TestShouldKeepGeoMappingRulesOfCurrentGeoLocationConfiguration(t *testing.T) {
+	rules := json.RawMessage(`[{"cidr":"10.0.0.0/8","subdivisions":[]}]`)
+	current := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalNoRemoval, GeoMappingRules: rules}
+	config := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveCity}
+
+	config.KeepUnmanagedSettings(current)
+
+	require.Equal(t, &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveCity, GeoMappingRules: rules}, config)
+}
+
+func TestShouldNotSerializeMonitoringConfigIDOfGeoLocationConfiguration(t *testing.T) {
+	config := GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveCity}
+
+	data, err := json.Marshal(&config)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"geoDetailRemoval":"REMOVE_CITY"}`, string(data))
+}
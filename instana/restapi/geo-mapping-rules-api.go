@@ -0,0 +1,390 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const (
+	//GeoMappingRulesPathElement path element of the custom geo mapping rules of website and mobile app monitoring configs
+	GeoMappingRulesPathElement = "geo-mapping-rules"
+	//MaxGeoMappingRules the maximum number of custom geo mapping rules of a monitoring config
+	MaxGeoMappingRules = 512
+)
+
+//MaxGeoSubdivisions the maximum number of subdivisions of a custom geo mapping rule
+const MaxGeoSubdivisions = 8
+
+//The Instana API does not publish the layout of the CSV representation of the geo mapping rules. The column names are
+//therefore the property names of the GeoMappingRule model of the documented geo location configuration API of website
+//and mobile app monitoring configs (.../geo-location). The list of subdivisions is represented by indexed
+//columns (subdivisions.0.code, subdivisions.0.name, subdivisions.1.code, ...) using the zero based position of the
+//subdivision in the list.
+const (
+	geoMappingRulesCSVColumnCIDR                     = "cidr"
+	geoMappingRulesCSVColumnLatitude                 = "latitude"
+	geoMappingRulesCSVColumnLongitude                = "longitude"
+	geoMappingRulesCSVColumnAccuracyRadius           = "accuracyRadius"
+	geoMappingRulesCSVColumnCity                     = "city"
+	geoMappingRulesCSVColumnCountryCode              = "countryCode"
+	geoMappingRulesCSVColumnCountry                  = "country"
+	geoMappingRulesCSVColumnContinentCode            = "continentCode"
+	geoMappingRulesCSVColumnContinent                = "continent"
+	geoMappingRulesCSVColumnSubdivisions             = "subdivisions"
+	geoMappingRulesCSVColumnLeastSpecificSubdivision = "leastSpecificSubdivision"
+	geoSubdivisionCSVColumnCode                      = "code"
+	geoSubdivisionCSVColumnName                      = "name"
+)
+
+//geoMappingRulesCSVColumns the columns of the CSV representation of the geo mapping rules in the order used by the
+//provider. The columns of the subdivisions are appended to these columns
+var geoMappingRulesCSVColumns = []string{
+	geoMappingRulesCSVColumnCIDR,
+	geoMappingRulesCSVColumnLatitude,
+	geoMappingRulesCSVColumnLongitude,
+	geoMappingRulesCSVColumnAccuracyRadius,
+	geoMappingRulesCSVColumnCity,
+	geoMappingRulesCSVColumnCountryCode,
+	geoMappingRulesCSVColumnCountry,
+	geoMappingRulesCSVColumnContinentCode,
+	geoMappingRulesCSVColumnContinent,
+}
+
+//buildGeoMappingRulesCSVColumns creates the columns of the CSV representation of the geo mapping rules including the
+//columns of the given number of subdivisions
+func buildGeoMappingRulesCSVColumns(numberOfSubdivisions int) []string {
+	columns := make([]string, 0, len(geoMappingRulesCSVColumns)+2*numberOfSubdivisions)
+	columns = append(columns, geoMappingRulesCSVColumns...)
+	for i := 0; i < numberOfSubdivisions; i++ {
+		columns = append(columns, buildGeoSubdivisionCSVColumn(i, geoSubdivisionCSVColumnCode), buildGeoSubdivisionCSVColumn(i, geoSubdivisionCSVColumnName))
+	}
+	return columns
+}
+
+func buildGeoSubdivisionCSVColumn(index int, field string) string {
+	return fmt.Sprintf("%s.%d.%s", geoMappingRulesCSVColumnSubdivisions, index, field)
+}
+
+//GeoSubdivision data structure for a subdivision (e.g. state or province) of a custom geo mapping rule
+type GeoSubdivision struct {
+	Code string
+	Name string
+}
+
+//GeoMappingRule data structure for a single custom geo mapping rule which maps the IP addresses of the given CIDR to a location
+type GeoMappingRule struct {
+	CIDR           string
+	Latitude       *float64
+	Longitude      *float64
+	AccuracyRadius *int64
+	City           string
+	Subdivisions   []GeoSubdivision
+	CountryCode    string
+	Country        string
+	ContinentCode  string
+	Continent      string
+}
+
+//Validate verifies if the geo mapping rule is correct
+func (r GeoMappingRule) Validate() error {
+	if _, _, err := net.ParseCIDR(r.CIDR); err != nil {
+		return fmt.Errorf("cidr %s of geo mapping rule is not valid", r.CIDR)
+	}
+	if r.Latitude != nil && (*r.Latitude < -90 || *r.Latitude > 90) {
+		return fmt.Errorf("latitude of geo mapping rule %s must be between -90 and 90", r.CIDR)
+	}
+	if r.Longitude != nil && (*r.Longitude < -180 || *r.Longitude > 180) {
+		return fmt.Errorf("longitude of geo mapping rule %s must be between -180 and 180", r.CIDR)
+	}
+	if r.AccuracyRadius != nil && *r.AccuracyRadius < -1 {
+		return fmt.Errorf("accuracy radius of geo mapping rule %s must be at least -1", r.CIDR)
+	}
+	if len(r.Subdivisions) > MaxGeoSubdivisions {
+		return fmt.Errorf("geo mapping rule %s supports at most %d subdivisions", r.CIDR, MaxGeoSubdivisions)
+	}
+	for i, subdivision := range r.Subdivisions {
+		if utils.IsBlank(subdivision.Name) {
+			return fmt.Errorf("name of subdivision %d of geo mapping rule %s is missing", i, r.CIDR)
+		}
+	}
+	return nil
+}
+
+func (r GeoMappingRule) toCSVRecord(numberOfSubdivisions int) []string {
+	record := make([]string, 0, len(geoMappingRulesCSVColumns)+2*numberOfSubdivisions)
+	record = append(record,
+		r.CIDR,
+		formatOptionalFloat(r.Latitude),
+		formatOptionalFloat(r.Longitude),
+		formatOptionalInt(r.AccuracyRadius),
+		r.City,
+		r.CountryCode,
+		r.Country,
+		r.ContinentCode,
+		r.Continent,
+	)
+	for i := 0; i < numberOfSubdivisions; i++ {
+		if i < len(r.Subdivisions) {
+			record = append(record, r.Subdivisions[i].Code, r.Subdivisions[i].Name)
+		} else {
+			record = append(record, "", "")
+		}
+	}
+	return record
+}
+
+func formatOptionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
+
+func formatOptionalInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+//GeoMappingRules data structure for the custom geo mapping rules of a website or mobile app monitoring config. The
+//Instana API represents the rules as CSV document
+type GeoMappingRules struct {
+	MonitoringConfigID string
+	Rules              []GeoMappingRule
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the ID of the monitoring config
+func (r *GeoMappingRules) GetIDForResourcePath() string {
+	return r.MonitoringConfigID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (r *GeoMappingRules) Validate() error {
+	if len(r.MonitoringConfigID) == 0 {
+		return errors.New("ID of monitoring config is missing")
+	}
+	if len(r.Rules) > MaxGeoMappingRules {
+		return fmt.Errorf("at most %d geo mapping rules are supported", MaxGeoMappingRules)
+	}
+	for _, rule := range r.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//MarshalCSV converts the geo mapping rules into the CSV representation of the Instana API including a header line.
+//The header contains the columns of as many subdivisions as the rule with the most subdivisions defines
+func (r *GeoMappingRules) MarshalCSV() ([]byte, error) {
+	numberOfSubdivisions := 0
+	for _, rule := range r.Rules {
+		if len(rule.Subdivisions) > numberOfSubdivisions {
+			numberOfSubdivisions = len(rule.Subdivisions)
+		}
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(buildGeoMappingRulesCSVColumns(numberOfSubdivisions)); err != nil {
+		return nil, err
+	}
+	for _, rule := range r.Rules {
+		if err := writer.Write(rule.toCSVRecord(numberOfSubdivisions)); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+//UnmarshalGeoMappingRulesCSV converts the CSV representation of the geo mapping rules of the Instana API into the data
+//structure of the given monitoring config. When the CSV document contains a header line (a line with a cidr column)
+//the columns are mapped by name, otherwise the column order of the provider including the columns of the maximum
+//number of subdivisions is assumed. An error is returned for unknown columns
+func UnmarshalGeoMappingRulesCSV(monitoringConfigID string, data []byte) (*GeoMappingRules, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse geo mapping rules; %s", err)
+	}
+
+	columns := buildGeoMappingRulesCSVColumns(MaxGeoSubdivisions)
+	if len(records) > 0 && isGeoMappingRulesCSVHeader(records[0]) {
+		columns = records[0]
+		records = records[1:]
+	}
+	setters, err := mapCSVColumnsToGeoMappingRuleSetters(columns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse geo mapping rules; %s", err)
+	}
+
+	rules := make([]GeoMappingRule, 0, len(records))
+	for i, record := range records {
+		rule, err := mapCSVRecordToGeoMappingRule(setters, record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse geo mapping rule %d; %s", i+1, err)
+		}
+		rules = append(rules, rule)
+	}
+	return &GeoMappingRules{MonitoringConfigID: monitoringConfigID, Rules: rules}, nil
+}
+
+func isGeoMappingRulesCSVHeader(record []string) bool {
+	for _, column := range record {
+		if strings.EqualFold(strings.TrimSpace(column), geoMappingRulesCSVColumnCIDR) {
+			return true
+		}
+	}
+	return false
+}
+
+//geoMappingRuleSetter sets the value of a single CSV column at the given geo mapping rule
+type geoMappingRuleSetter func(rule *GeoMappingRule, value string) error
+
+func mapCSVColumnsToGeoMappingRuleSetters(columns []string) ([]geoMappingRuleSetter, error) {
+	setters := make([]geoMappingRuleSetter, len(columns))
+	for i, column := range columns {
+		setter, err := mapCSVColumnToGeoMappingRuleSetter(strings.TrimSpace(column))
+		if err != nil {
+			return nil, err
+		}
+		setters[i] = setter
+	}
+	return setters, nil
+}
+
+//geoMappingRuleCSVStringColumns provides access to the string fields of a geo mapping rule by the lower case name of the CSV column
+var geoMappingRuleCSVStringColumns = map[string]func(rule *GeoMappingRule) *string{
+	strings.ToLower(geoMappingRulesCSVColumnCIDR):          func(rule *GeoMappingRule) *string { return &rule.CIDR },
+	strings.ToLower(geoMappingRulesCSVColumnCity):          func(rule *GeoMappingRule) *string { return &rule.City },
+	strings.ToLower(geoMappingRulesCSVColumnCountryCode):   func(rule *GeoMappingRule) *string { return &rule.CountryCode },
+	strings.ToLower(geoMappingRulesCSVColumnCountry):       func(rule *GeoMappingRule) *string { return &rule.Country },
+	strings.ToLower(geoMappingRulesCSVColumnContinentCode): func(rule *GeoMappingRule) *string { return &rule.ContinentCode },
+	strings.ToLower(geoMappingRulesCSVColumnContinent):     func(rule *GeoMappingRule) *string { return &rule.Continent },
+}
+
+func mapCSVColumnToGeoMappingRuleSetter(column string) (geoMappingRuleSetter, error) {
+	lowerCaseColumn := strings.ToLower(column)
+	if field, ok := geoMappingRuleCSVStringColumns[lowerCaseColumn]; ok {
+		return func(rule *GeoMappingRule, value string) error {
+			*field(rule) = value
+			return nil
+		}, nil
+	}
+	switch lowerCaseColumn {
+	case strings.ToLower(geoMappingRulesCSVColumnLatitude):
+		return func(rule *GeoMappingRule, value string) error {
+			var err error
+			rule.Latitude, err = parseOptionalFloat(value)
+			return err
+		}, nil
+	case strings.ToLower(geoMappingRulesCSVColumnLongitude):
+		return func(rule *GeoMappingRule, value string) error {
+			var err error
+			rule.Longitude, err = parseOptionalFloat(value)
+			return err
+		}, nil
+	case strings.ToLower(geoMappingRulesCSVColumnAccuracyRadius):
+		return func(rule *GeoMappingRule, value string) error {
+			var err error
+			rule.AccuracyRadius, err = parseOptionalInt(value)
+			return err
+		}, nil
+	}
+	return mapCSVSubdivisionColumnToGeoMappingRuleSetter(column)
+}
+
+//mapCSVSubdivisionColumnToGeoMappingRuleSetter maps the indexed columns of the subdivisions. The columns of the least
+//specific subdivision are accepted but ignored as the provider manages the complete list of subdivisions
+func mapCSVSubdivisionColumnToGeoMappingRuleSetter(column string) (geoMappingRuleSetter, error) {
+	parts := strings.Split(strings.ToLower(column), ".")
+	if len(parts) == 2 && parts[0] == strings.ToLower(geoMappingRulesCSVColumnLeastSpecificSubdivision) && isGeoSubdivisionCSVField(parts[1]) {
+		return func(rule *GeoMappingRule, value string) error { return nil }, nil
+	}
+	if len(parts) != 3 || parts[0] != geoMappingRulesCSVColumnSubdivisions || !isGeoSubdivisionCSVField(parts[2]) {
+		return nil, fmt.Errorf("unknown column %s", column)
+	}
+	index, err := strconv.Atoi(parts[1])
+	if err != nil || index < 0 || index >= MaxGeoSubdivisions {
+		return nil, fmt.Errorf("unknown column %s; subdivisions are indexed from 0 to %d", column, MaxGeoSubdivisions-1)
+	}
+	field := parts[2]
+	return func(rule *GeoMappingRule, value string) error {
+		for len(rule.Subdivisions) <= index {
+			rule.Subdivisions = append(rule.Subdivisions, GeoSubdivision{})
+		}
+		if field == geoSubdivisionCSVColumnCode {
+			rule.Subdivisions[index].Code = value
+		} else {
+			rule.Subdivisions[index].Name = value
+		}
+		return nil
+	}, nil
+}
+
+func isGeoSubdivisionCSVField(field string) bool {
+	return field == geoSubdivisionCSVColumnCode || field == geoSubdivisionCSVColumnName
+}
+
+func mapCSVRecordToGeoMappingRule(setters []geoMappingRuleSetter, record []string) (GeoMappingRule, error) {
+	rule := GeoMappingRule{}
+	if len(record) > len(setters) {
+		return rule, fmt.Errorf("record has %d values but only %d columns are defined", len(record), len(setters))
+	}
+	for i, value := range record {
+		if err := setters[i](&rule, strings.TrimSpace(value)); err != nil {
+			return rule, err
+		}
+	}
+	rule.Subdivisions = trimEmptyGeoSubdivisions(rule.Subdivisions)
+	return rule, nil
+}
+
+//trimEmptyGeoSubdivisions removes the trailing empty subdivisions which are written for rules with less subdivisions
+//than other rules of the same CSV document
+func trimEmptyGeoSubdivisions(subdivisions []GeoSubdivision) []GeoSubdivision {
+	length := len(subdivisions)
+	for length > 0 && subdivisions[length-1] == (GeoSubdivision{}) {
+		length--
+	}
+	if length == 0 {
+		return nil
+	}
+	return subdivisions[:length]
+}
+
+func parseOptionalFloat(value string) (*float64, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func parseOptionalInt(value string) (*int64, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
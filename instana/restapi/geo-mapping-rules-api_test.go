@@ -0,0 +1,208 @@
+package restapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const geoMappingRulesCSV = `cidr,latitude,longitude,accuracyRadius,city,countryCode,country,continentCode,continent,subdivisions.0.code,subdivisions.0.name,subdivisions.1.code,subdivisions.1.name
+10.0.0.0/8,48.137,11.575,10,Munich,DE,Germany,EU,Europe,BY,Bavaria,091,Upper Bavaria
+192.168.0.0/16,,,,,US,"United States, of America",NA,North America,,,,
+`
+
+func createGeoMappingRulesForTest() *GeoMappingRules {
+	return &GeoMappingRules{
+		MonitoringConfigID: testMonitoringConfigID,
+		Rules: []GeoMappingRule{
+			{
+				CIDR:           "10.0.0.0/8",
+				Latitude:       utils.Float64Ptr(48.137),
+				Longitude:      utils.Float64Ptr(11.575),
+				AccuracyRadius: utils.Int64Ptr(10),
+				City:           "Munich",
+				Subdivisions:   []GeoSubdivision{{Code: "BY", Name: "Bavaria"}, {Code: "091", Name: "Upper Bavaria"}},
+				CountryCode:    "DE",
+				Country:        "Germany",
+				ContinentCode:  "EU",
+				Continent:      "Europe",
+			},
+			{
+				CIDR:          "192.168.0.0/16",
+				CountryCode:   "US",
+				Country:       "United States, of America",
+				ContinentCode: "NA",
+				Continent:     "North America",
+			},
+		},
+	}
+}
+
+func TestShouldReturnMonitoringConfigIDAsIDOfGeoMappingRules(t *testing.T) {
+	rules := GeoMappingRules{MonitoringConfigID: testMonitoringConfigID}
+
+	require.Equal(t, testMonitoringConfigID, rules.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateGeoMappingRules(t *testing.T) {
+	require.NoError(t, createGeoMappingRulesForTest().Validate())
+	require.NoError(t, (&GeoMappingRules{MonitoringConfigID: testMonitoringConfigID}).Validate())
+	require.NoError(t, (&GeoMappingRules{MonitoringConfigID: testMonitoringConfigID, Rules: []GeoMappingRule{{CIDR: "2001:db8::/32", AccuracyRadius: utils.Int64Ptr(-1)}}}).Validate())
+}
+
+func TestShouldFailToValidateGeoMappingRulesWhenMonitoringConfigIDIsMissing(t *testing.T) {
+	rules := createGeoMappingRulesForTest()
+	rules.MonitoringConfigID = ""
+
+	require.Error(t, rules.Validate())
+}
+
+func TestShouldFailToValidateGeoMappingRulesWhenTooManyRulesAreProvided(t *testing.T) {
+	rules := make([]GeoMappingRule, MaxGeoMappingRules+1)
+	for i := range rules {
+		rules[i] = GeoMappingRule{CIDR: fmt.Sprintf("10.%d.%d.0/24", i/256, i%256)}
+	}
+
+	err := (&GeoMappingRules{MonitoringConfigID: testMonitoringConfigID, Rules: rules}).Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at most 512")
+}
+
+func TestShouldFailToValidateGeoMappingRulesWhenRuleIsNotValid(t *testing.T) {
+	testCases := map[string]GeoMappingRule{
+		"cidr is missing":                 {},
+		"cidr is not valid":               {CIDR: "10.0.0.0"},
+		"latitude is less than -90":       {CIDR: "10.0.0.0/8", Latitude: utils.Float64Ptr(-90.1)},
+		"latitude is greater than 90":     {CIDR: "10.0.0.0/8", Latitude: utils.Float64Ptr(90.1)},
+		"longitude is less than -180":     {CIDR: "10.0.0.0/8", Longitude: utils.Float64Ptr(-180.1)},
+		"longitude is greater than 180":   {CIDR: "10.0.0.0/8", Longitude: utils.Float64Ptr(180.1)},
+		"accuracy radius is less than -1": {CIDR: "10.0.0.0/8", AccuracyRadius: utils.Int64Ptr(-2)},
+		"name of subdivision is missing":  {CIDR: "10.0.0.0/8", Subdivisions: []GeoSubdivision{{Code: "BY"}}},
+		"too many subdivisions are provided": {CIDR: "10.0.0.0/8", Subdivisions: []GeoSubdivision{
+			{Name: "1"}, {Name: "2"}, {Name: "3"}, {Name: "4"}, {Name: "5"}, {Name: "6"}, {Name: "7"}, {Name: "8"}, {Name: "9"},
+		}},
+	}
+	for name, rule := range testCases {
+		t.Run("Should fail when "+name, func(t *testing.T) {
+			rules := &GeoMappingRules{MonitoringConfigID: testMonitoringConfigID, Rules: []GeoMappingRule{rule}}
+
+			require.Error(t, rules.Validate())
+		})
+	}
+}
+
+func TestShouldMarshalGeoMappingRulesToCSVWithHeader(t *testing.T) {
+	data, err := createGeoMappingRulesForTest().MarshalCSV()
+
+	require.NoError(t, err)
+	require.Equal(t, geoMappingRulesCSV, string(data))
+}
+
+func TestShouldMarshalEmptyGeoMappingRulesToCSVHeaderOnly(t *testing.T) {
+	data, err := (&GeoMappingRules{MonitoringConfigID: testMonitoringConfigID}).MarshalCSV()
+
+	require.NoError(t, err)
+	require.Equal(t, "cidr,latitude,longitude,accuracyRadius,city,countryCode,country,continentCode,continent\n", string(data))
+}
+
+func TestShouldUnmarshalGeoMappingRulesFromCSVWithHeader(t *testing.T) {
+	result, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(geoMappingRulesCSV))
+
+	require.NoError(t, err)
+	require.Equal(t, createGeoMappingRulesForTest(), result)
+}
+
+func TestShouldUnmarshalGeoMappingRulesFromCSVWithColumnsInDifferentOrder(t *testing.T) {
+	data := "City, Subdivisions.0.Name, CIDR, Latitude, subdivisions.0.code\nMunich, Bavaria, 10.0.0.0/8, 48.137, BY\n"
+
+	result, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+	require.NoError(t, err)
+	require.Equal(t, &GeoMappingRules{
+		MonitoringConfigID: testMonitoringConfigID,
+		Rules:              []GeoMappingRule{{CIDR: "10.0.0.0/8", City: "Munich", Latitude: utils.Float64Ptr(48.137), Subdivisions: []GeoSubdivision{{Code: "BY", Name: "Bavaria"}}}},
+	}, result)
+}
+
+func TestShouldIgnoreLeastSpecificSubdivisionWhenUnmarshallingGeoMappingRulesFromCSV(t *testing.T) {
+	data := "cidr,leastSpecificSubdivision.code,leastSpecificSubdivision.name,subdivisions.0.code,subdivisions.0.name\n10.0.0.0/8,BY,Bavaria,BY,Bavaria\n"
+
+	result, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+	require.NoError(t, err)
+	require.Equal(t, &GeoMappingRules{
+		MonitoringConfigID: testMonitoringConfigID,
+		Rules:              []GeoMappingRule{{CIDR: "10.0.0.0/8", Subdivisions: []GeoSubdivision{{Code: "BY", Name: "Bavaria"}}}},
+	}, result)
+}
+
+func TestShouldFailToUnmarshalGeoMappingRulesWhenCSVContainsUnknownColumn(t *testing.T) {
+	for _, column := range []string{"unknown", "subdivision", "subdivisions.8.name", "subdivisions.0.label", "subdivisions.first.name", "leastSpecificSubdivision"} {
+		t.Run("Should fail for column "+column, func(t *testing.T) {
+			data := fmt.Sprintf("cidr,%s\n10.0.0.0/8,foo\n", column)
+
+			_, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "unknown column "+column)
+		})
+	}
+}
+
+func TestShouldFailToUnmarshalGeoMappingRulesWhenRecordHasMoreValuesThanColumns(t *testing.T) {
+	_, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte("cidr,city\n10.0.0.0/8,Munich,foo\n"))
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "geo mapping rule 1")
+}
+
+func TestShouldUnmarshalGeoMappingRulesFromCSVWithoutHeaderUsingDefaultColumnOrder(t *testing.T) {
+	data := "10.0.0.0/8,48.137,11.575,10,Munich,DE,Germany,EU,Europe,BY,Bavaria\n"
+
+	result, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+	require.NoError(t, err)
+	require.Equal(t, &GeoMappingRules{
+		MonitoringConfigID: testMonitoringConfigID,
+		Rules: []GeoMappingRule{{
+			CIDR:           "10.0.0.0/8",
+			Latitude:       utils.Float64Ptr(48.137),
+			Longitude:      utils.Float64Ptr(11.575),
+			AccuracyRadius: utils.Int64Ptr(10),
+			City:           "Munich",
+			Subdivisions:   []GeoSubdivision{{Code: "BY", Name: "Bavaria"}},
+			CountryCode:    "DE",
+			Country:        "Germany",
+			ContinentCode:  "EU",
+			Continent:      "Europe",
+		}},
+	}, result)
+}
+
+func TestShouldUnmarshalEmptyCSVToEmptyGeoMappingRules(t *testing.T) {
+	for _, data := range []string{"", "cidr,latitude,longitude\n"} {
+		result, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+		require.NoError(t, err)
+		require.Equal(t, &GeoMappingRules{MonitoringConfigID: testMonitoringConfigID, Rules: []GeoMappingRule{}}, result)
+	}
+}
+
+func TestShouldFailToUnmarshalGeoMappingRulesWhenNumericValueIsNotValid(t *testing.T) {
+	for _, data := range []string{"cidr,latitude\n10.0.0.0/8,north\n", "cidr,accuracyRadius\n10.0.0.0/8,1.5\n"} {
+		_, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte(data))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "geo mapping rule 1")
+	}
+}
+
+func TestShouldFailToUnmarshalGeoMappingRulesWhenCSVIsNotValid(t *testing.T) {
+	_, err := UnmarshalGeoMappingRulesCSV(testMonitoringConfigID, []byte("cidr\n\"10.0.0.0/8\n"))
+
+	require.Error(t, err)
+}
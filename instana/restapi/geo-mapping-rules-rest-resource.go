@@ -0,0 +1,83 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+//NewGeoMappingRulesRestResource creates a new REST resource for the custom geo mapping rules of website or mobile app
+//monitoring configs. The rules are available as CSV document below the monitoring config, e.g.
+///api/website-monitoring/config/{websiteId}/geo-mapping-rules. The ID of the rules is the ID of the monitoring config.
+//Create and update replace all rules. Delete removes all rules.
+func NewGeoMappingRulesRestResource(configResourcePath string, client RestClient) RestResource {
+	return &geoMappingRulesRestResource{
+		configResourcePath: configResourcePath,
+		client:             client,
+	}
+}
+
+type geoMappingRulesRestResource struct {
+	configResourcePath string
+	client             RestClient
+}
+
+func (r *geoMappingRulesRestResource) GetAll(_ context.Context) (*[]InstanaDataObject, error) {
+	return nil, errors.New("geo mapping rules can only be requested for a single monitoring config")
+}
+
+func (r *geoMappingRulesRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.GetCSV(ctx, r.buildResourcePath(id))
+	if err != nil {
+		return nil, err
+	}
+	return r.validateResponseAndConvertToStruct(id, data)
+}
+
+func (r *geoMappingRulesRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Update(ctx, data)
+}
+
+func (r *geoMappingRulesRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	rules := data.(*GeoMappingRules)
+	csvData, err := rules.MarshalCSV()
+	if err != nil {
+		return data, err
+	}
+	response, err := r.client.PutCSV(ctx, csvData, r.buildResourcePath(rules.MonitoringConfigID))
+	if err != nil {
+		return data, err
+	}
+	if len(response) == 0 {
+		return r.GetOne(ctx, rules.MonitoringConfigID)
+	}
+	return r.validateResponseAndConvertToStruct(rules.MonitoringConfigID, response)
+}
+
+func (r *geoMappingRulesRestResource) validateResponseAndConvertToStruct(id string, data []byte) (InstanaDataObject, error) {
+	rules, err := UnmarshalGeoMappingRulesCSV(id, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := rules.Validate(); err != nil {
+		return rules, err
+	}
+	return rules, nil
+}
+
+func (r *geoMappingRulesRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID removes all geo mapping rules of the monitoring config with the given ID
+func (r *geoMappingRulesRestResource) DeleteByID(ctx context.Context, id string) error {
+	_, err := r.Update(ctx, &GeoMappingRules{MonitoringConfigID: id, Rules: []GeoMappingRule{}})
+	return err
+}
+
+func (r *geoMappingRulesRestResource) buildResourcePath(id string) string {
+	return fmt.Sprintf("%s/%s/%s", r.configResourcePath, id, GeoMappingRulesPathElement)
+}
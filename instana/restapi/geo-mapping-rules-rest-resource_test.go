@@ -0,0 +1,130 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGeoMappingRulesPath = WebsiteMonitoringConfigResourcePath + "/" + testMonitoringConfigID + "/" + GeoMappingRulesPathElement
+	emptyGeoMappingRulesCSV = "cidr,latitude,longitude,accuracyRadius,city,countryCode,country,continentCode,continent\n"
+)
+
+func TestShouldReadGeoMappingRulesOfTheGivenMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetCSV(gomock.Any(), testGeoMappingRulesPath).Times(1).Return([]byte(geoMappingRulesCSV), nil)
+
+	result, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).GetOne(context.Background(), testMonitoringConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, createGeoMappingRulesForTest(), result)
+}
+
+func TestShouldFailToReadGeoMappingRulesWhenRestClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetCSV(gomock.Any(), testGeoMappingRulesPath).Times(1).Return(nil, ErrEntityNotFound)
+
+	_, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).GetOne(context.Background(), testMonitoringConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToReadGeoMappingRulesWhenResponseContainsInvalidRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetCSV(gomock.Any(), testGeoMappingRulesPath).Times(1).Return([]byte("cidr\ninvalid\n"), nil)
+
+	_, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).GetOne(context.Background(), testMonitoringConfigID)
+
+	require.Error(t, err)
+}
+
+func TestShouldNotSupportToReadAllGeoMappingRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	_, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).GetAll(context.Background())
+
+	require.Error(t, err)
+}
+
+func TestShouldReplaceGeoMappingRulesAsCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutCSV(gomock.Any(), []byte(geoMappingRulesCSV), testGeoMappingRulesPath).Times(1).Return([]byte(geoMappingRulesCSV), nil)
+
+	result, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).Create(context.Background(), createGeoMappingRulesForTest())
+
+	require.NoError(t, err)
+	require.Equal(t, createGeoMappingRulesForTest(), result)
+}
+
+func TestShouldReadGeoMappingRulesAfterUpdateWhenResponseIsEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	gomock.InOrder(
+		client.EXPECT().PutCSV(gomock.Any(), []byte(geoMappingRulesCSV), testGeoMappingRulesPath).Times(1).Return([]byte{}, nil),
+		client.EXPECT().GetCSV(gomock.Any(), testGeoMappingRulesPath).Times(1).Return([]byte(geoMappingRulesCSV), nil),
+	)
+
+	result, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).Update(context.Background(), createGeoMappingRulesForTest())
+
+	require.NoError(t, err)
+	require.Equal(t, createGeoMappingRulesForTest(), result)
+}
+
+func TestShouldFailToUpdateGeoMappingRulesWhenRulesAreNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutCSV(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).Update(context.Background(), &GeoMappingRules{MonitoringConfigID: testMonitoringConfigID, Rules: []GeoMappingRule{{CIDR: "invalid"}}})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUpdateGeoMappingRulesWhenPutRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().PutCSV(gomock.Any(), gomock.Any(), testGeoMappingRulesPath).Times(1).Return(nil, expectedError)
+
+	_, err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).Update(context.Background(), createGeoMappingRulesForTest())
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldRemoveAllGeoMappingRulesWhenDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().PutCSV(gomock.Any(), []byte(emptyGeoMappingRulesCSV), testGeoMappingRulesPath).Times(1).Return([]byte(emptyGeoMappingRulesCSV), nil)
+
+	err := NewGeoMappingRulesRestResource(WebsiteMonitoringConfigResourcePath, client).Delete(context.Background(), createGeoMappingRulesForTest())
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const (
+	//GlobalCustomPayloadConfigurationPathElement path element of the global custom payload configuration resource of the Instana RESTful API
+	GlobalCustomPayloadConfigurationPathElement = "custom-payload-configurations"
+	//GlobalCustomPayloadConfigurationResourcePath path to the global custom payload configuration resource of the Instana RESTful API
+	GlobalCustomPayloadConfigurationResourcePath = EventSettingsBasePath + "/" + GlobalCustomPayloadConfigurationPathElement
+	//CustomPayloadTagCatalogResourcePath path to the catalog of tags which can be used in dynamic custom payload fields
+	CustomPayloadTagCatalogResourcePath = GlobalCustomPayloadConfigurationResourcePath + "/catalog"
+	//MaxCustomPayloadFields the maximum number of custom payload fields
+	MaxCustomPayloadFields = 20
+)
+
+//GlobalCustomPayloadConfiguration data structure for the Instana API model of the tenant wide custom payload which is
+//attached to every alert. The configuration exists exactly once per tenant
+type GlobalCustomPayloadConfiguration struct {
+	Fields []CustomPayloadField[any] `json:"fields"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the path element of the global custom payload configuration
+func (c *GlobalCustomPayloadConfiguration) GetIDForResourcePath() string {
+	return GlobalCustomPayloadConfigurationPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *GlobalCustomPayloadConfiguration) Validate() error {
+	if len(c.Fields) > MaxCustomPayloadFields {
+		return fmt.Errorf("at most %d custom payload fields are supported", MaxCustomPayloadFields)
+	}
+	keys := make(map[string]bool)
+	for _, field := range c.Fields {
+		if utils.IsBlank(field.Key) {
+			return errors.New("key of custom payload field is missing")
+		}
+		if keys[field.Key] {
+			return fmt.Errorf("key %s of custom payload field is not unique", field.Key)
+		}
+		keys[field.Key] = true
+		if err := c.validateValue(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *GlobalCustomPayloadConfiguration) validateValue(field CustomPayloadField[any]) error {
+	switch value := field.Value.(type) {
+	case StaticStringCustomPayloadFieldValue:
+		if field.Type != StaticCustomPayloadType {
+			return fmt.Errorf("static value of custom payload field %s requires type %s", field.Key, StaticCustomPayloadType)
+		}
+	case DynamicCustomPayloadFieldValue:
+		if field.Type != DynamicCustomPayloadType {
+			return fmt.Errorf("dynamic value of custom payload field %s requires type %s", field.Key, DynamicCustomPayloadType)
+		}
+		if utils.IsBlank(value.TagName) {
+			return fmt.Errorf("tag name of dynamic custom payload field %s is missing", field.Key)
+		}
+	default:
+		return fmt.Errorf("value of custom payload field %s is not supported", field.Key)
+	}
+	return nil
+}
+
+//TagCatalog data structure for the Instana API model of the tag catalog
+type TagCatalog struct {
+	Tags []TagCatalogTag `json:"tags"`
+}
+
+//TagCatalogTag data structure for the Instana API model of a single tag of the tag catalog
+type TagCatalogTag struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+//ContainsTag checks if the catalog contains a tag with the given name
+func (c *TagCatalog) ContainsTag(name string) bool {
+	for _, tag := range c.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
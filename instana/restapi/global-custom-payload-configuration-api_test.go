@@ -0,0 +1,89 @@
+package restapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func createStaticCustomPayloadField(key string, value string) CustomPayloadField[any] {
+	return CustomPayloadField[any]{Type: StaticCustomPayloadType, Key: key, Value: StaticStringCustomPayloadFieldValue(value)}
+}
+
+func createDynamicCustomPayloadField(key string, tagName string, tagKey *string) CustomPayloadField[any] {
+	return CustomPayloadField[any]{Type: DynamicCustomPayloadType, Key: key, Value: DynamicCustomPayloadFieldValue{TagName: tagName, Key: tagKey}}
+}
+
+func TestShouldReturnPathElementAsIDOfGlobalCustomPayloadConfiguration(t *testing.T) {
+	config := GlobalCustomPayloadConfiguration{}
+
+	require.Equal(t, GlobalCustomPayloadConfigurationPathElement, config.GetIDForResourcePath())
+	require.Equal(t, "/api/events/settings/custom-payload-configurations", GlobalCustomPayloadConfigurationResourcePath)
+	require.Equal(t, "/api/events/settings/custom-payload-configurations/catalog", CustomPayloadTagCatalogResourcePath)
+}
+
+func TestShouldSuccessfullyValidateGlobalCustomPayloadConfiguration(t *testing.T) {
+	for _, config := range []GlobalCustomPayloadConfiguration{
+		{Fields: []CustomPayloadField[any]{}},
+		{Fields: []CustomPayloadField[any]{
+			createStaticCustomPayloadField("team", "platform"),
+			createDynamicCustomPayloadField("zone", "agent.zone", nil),
+			createDynamicCustomPayloadField("stage", "agent.tag", utils.StringPtr("stage")),
+		}},
+	} {
+		require.NoError(t, config.Validate())
+	}
+}
+
+func TestShouldFailToValidateGlobalCustomPayloadConfigurationWhenTooManyFieldsAreProvided(t *testing.T) {
+	fields := make([]CustomPayloadField[any], MaxCustomPayloadFields+1)
+	for i := range fields {
+		fields[i] = createStaticCustomPayloadField(fmt.Sprintf("key%d", i), "value")
+	}
+	config := GlobalCustomPayloadConfiguration{Fields: fields}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at most 20")
+}
+
+func TestShouldFailToValidateGlobalCustomPayloadConfigurationWhenFieldIsNotValid(t *testing.T) {
+	testCases := map[string]CustomPayloadField[any]{
+		"key is missing":                       createStaticCustomPayloadField(" ", "value"),
+		"static value with dynamic type":       {Type: DynamicCustomPayloadType, Key: "key", Value: StaticStringCustomPayloadFieldValue("value")},
+		"dynamic value with static type":       {Type: StaticCustomPayloadType, Key: "key", Value: DynamicCustomPayloadFieldValue{TagName: "agent.zone"}},
+		"tag name of dynamic value is missing": createDynamicCustomPayloadField("key", " ", nil),
+		"value type is not supported":          {Type: StaticCustomPayloadType, Key: "key", Value: "plain string"},
+	}
+	for name, field := range testCases {
+		t.Run("Should fail when "+name, func(t *testing.T) {
+			config := GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{field}}
+
+			require.Error(t, config.Validate())
+		})
+	}
+}
+
+func TestShouldFailToValidateGlobalCustomPayloadConfigurationWhenKeysAreNotUnique(t *testing.T) {
+	config := GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{
+		createStaticCustomPayloadField("key", "value"),
+		createDynamicCustomPayloadField("key", "agent.zone", nil),
+	}}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not unique")
+}
+
+func TestShouldCheckIfTagCatalogContainsTag(t *testing.T) {
+	catalog := TagCatalog{Tags: []TagCatalogTag{{Name: "agent.zone", Type: "STRING"}, {Name: "agent.tag", Type: "KEY_VALUE_PAIR"}}}
+
+	require.True(t, catalog.ContainsTag("agent.zone"))
+	require.True(t, catalog.ContainsTag("agent.tag"))
+	require.False(t, catalog.ContainsTag("agent.unknown"))
+}
@@ -0,0 +1,72 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+//NewGlobalCustomPayloadConfigurationRestResource creates a new REST resource for the global custom payload
+//configuration. The configuration exists exactly once per tenant. Before the configuration is updated, the tag names of
+//dynamic fields are verified against the tag catalog of the custom payload. As the update endpoint returns the history
+//of the configuration, the configuration is read again after the update.
+func NewGlobalCustomPayloadConfigurationRestResource(client RestClient) RestResource {
+	return &globalCustomPayloadConfigurationRestResource{
+		RestResource: NewSingletonRestResource(EventSettingsBasePath, GlobalCustomPayloadConfigurationPathElement, NewGlobalCustomPayloadConfigurationUnmarshaller(), client),
+		client:       client,
+	}
+}
+
+type globalCustomPayloadConfigurationRestResource struct {
+	RestResource
+	client RestClient
+}
+
+func (r *globalCustomPayloadConfigurationRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Update(ctx, data)
+}
+
+func (r *globalCustomPayloadConfigurationRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	if err := r.verifyTagNamesOfDynamicFields(ctx, data.(*GlobalCustomPayloadConfiguration)); err != nil {
+		return data, err
+	}
+	if _, err := r.client.Put(ctx, data, EventSettingsBasePath); err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, data.GetIDForResourcePath())
+}
+
+func (r *globalCustomPayloadConfigurationRestResource) verifyTagNamesOfDynamicFields(ctx context.Context, config *GlobalCustomPayloadConfiguration) error {
+	var catalog *TagCatalog
+	for _, field := range config.Fields {
+		if field.Type != DynamicCustomPayloadType {
+			continue
+		}
+		if catalog == nil {
+			var err error
+			if catalog, err = r.readTagCatalog(ctx); err != nil {
+				return err
+			}
+		}
+		tagName := field.Value.(DynamicCustomPayloadFieldValue).TagName
+		if !catalog.ContainsTag(tagName) {
+			return fmt.Errorf("tag %s of custom payload field %s does not exist in the tag catalog of Instana", tagName, field.Key)
+		}
+	}
+	return nil
+}
+
+func (r *globalCustomPayloadConfigurationRestResource) readTagCatalog(ctx context.Context) (*TagCatalog, error) {
+	data, err := r.client.Get(ctx, CustomPayloadTagCatalogResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	catalog := &TagCatalog{}
+	if err := json.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse json of tag catalog; %s", err)
+	}
+	return catalog, nil
+}
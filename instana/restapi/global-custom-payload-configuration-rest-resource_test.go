@@ -0,0 +1,154 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	globalCustomPayloadConfigurationResponse = `{"fields":[{"type":"staticString","key":"team","value":"platform"},{"type":"dynamic","key":"zone","value":{"tagName":"agent.zone"}}]}`
+	customPayloadTagCatalogResponse          = `{"tagTree":[],"tags":[{"name":"agent.zone","type":"STRING"},{"name":"agent.tag","type":"KEY_VALUE_PAIR"}]}`
+)
+
+func createGlobalCustomPayloadConfigurationForRestResourceTest() *GlobalCustomPayloadConfiguration {
+	return &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{
+		createStaticCustomPayloadField("team", "platform"),
+		createDynamicCustomPayloadField("zone", "agent.zone", nil),
+	}}
+}
+
+func TestShouldReadGlobalCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), GlobalCustomPayloadConfigurationPathElement, EventSettingsBasePath).Times(1).Return([]byte(globalCustomPayloadConfigurationResponse), nil)
+
+	result, err := NewGlobalCustomPayloadConfigurationRestResource(client).GetOne(context.Background(), GlobalCustomPayloadConfigurationPathElement)
+
+	require.NoError(t, err)
+	require.Equal(t, createGlobalCustomPayloadConfigurationForRestResourceTest(), result)
+}
+
+func TestShouldUpdateGlobalCustomPayloadConfigurationWhenTagsOfDynamicFieldsExistInCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createGlobalCustomPayloadConfigurationForRestResourceTest()
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), CustomPayloadTagCatalogResourcePath).Times(1).Return([]byte(customPayloadTagCatalogResponse), nil),
+		client.EXPECT().Put(gomock.Any(), config, EventSettingsBasePath).Times(1).Return([]byte("[]"), nil),
+		client.EXPECT().GetOne(gomock.Any(), GlobalCustomPayloadConfigurationPathElement, EventSettingsBasePath).Times(1).Return([]byte(globalCustomPayloadConfigurationResponse), nil),
+	)
+
+	result, err := NewGlobalCustomPayloadConfigurationRestResource(client).Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldNotReadTagCatalogWhenGlobalCustomPayloadConfigurationContainsOnlyStaticFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{createStaticCustomPayloadField("team", "platform")}}
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Put(gomock.Any(), config, EventSettingsBasePath).Times(1).Return([]byte("[]"), nil)
+	client.EXPECT().GetOne(gomock.Any(), GlobalCustomPayloadConfigurationPathElement, EventSettingsBasePath).Times(1).Return([]byte(`{"fields":[{"type":"staticString","key":"team","value":"platform"}]}`), nil)
+
+	result, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToUpdateGlobalCustomPayloadConfigurationWhenTagOfDynamicFieldDoesNotExistInCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{createDynamicCustomPayloadField("zone", "agent.unknown", nil)}}
+
+	client.EXPECT().Get(gomock.Any(), CustomPayloadTagCatalogResourcePath).Times(1).Return([]byte(customPayloadTagCatalogResponse), nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), config)
+
+	require.Error(t, err)
+	require.Equal(t, "tag agent.unknown of custom payload field zone does not exist in the tag catalog of Instana", err.Error())
+}
+
+func TestShouldFailToUpdateGlobalCustomPayloadConfigurationWhenTagCatalogCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), CustomPayloadTagCatalogResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), createGlobalCustomPayloadConfigurationForRestResourceTest())
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToUpdateGlobalCustomPayloadConfigurationWhenTagCatalogIsNotValidJSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), CustomPayloadTagCatalogResourcePath).Times(1).Return([]byte("invalid"), nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), createGlobalCustomPayloadConfigurationForRestResourceTest())
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "tag catalog")
+}
+
+func TestShouldFailToUpdateGlobalCustomPayloadConfigurationWhenConfigurationIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{createStaticCustomPayloadField("", "value")}})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUpdateGlobalCustomPayloadConfigurationWhenPutRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+	config := &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{createStaticCustomPayloadField("team", "platform")}}
+
+	client.EXPECT().Put(gomock.Any(), config, EventSettingsBasePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGlobalCustomPayloadConfigurationRestResource(client).Update(context.Background(), config)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldResetGlobalCustomPayloadConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), GlobalCustomPayloadConfigurationPathElement, EventSettingsBasePath).Times(1).Return(nil)
+
+	err := NewGlobalCustomPayloadConfigurationRestResource(client).DeleteByID(context.Background(), GlobalCustomPayloadConfigurationPathElement)
+
+	require.NoError(t, err)
+}
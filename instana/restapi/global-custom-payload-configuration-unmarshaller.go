@@ -0,0 +1,28 @@
+package restapi
+
+import (
+	"encoding/json"
+)
+
+//NewGlobalCustomPayloadConfigurationUnmarshaller creates a new Unmarshaller instance for the global custom payload configuration
+func NewGlobalCustomPayloadConfigurationUnmarshaller() JSONUnmarshaller {
+	return &globalCustomPayloadConfigurationUnmarshaller{}
+}
+
+type globalCustomPayloadConfigurationUnmarshaller struct{}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *globalCustomPayloadConfigurationUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
+	temp := &GlobalCustomPayloadConfiguration{}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return &GlobalCustomPayloadConfiguration{}, err
+	}
+	for i, v := range temp.Fields {
+		field, err := unmarshalCustomPayloadFieldValue(v)
+		if err != nil {
+			return &GlobalCustomPayloadConfiguration{}, err
+		}
+		temp.Fields[i] = field
+	}
+	return temp, nil
+}
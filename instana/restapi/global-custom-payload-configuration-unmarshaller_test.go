@@ -0,0 +1,58 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldSuccessfullyUnmarshalGlobalCustomPayloadConfiguration(t *testing.T) {
+	data := `{
+		"fields": [
+			{ "type": "staticString", "key": "team", "value": "platform" },
+			{ "type": "dynamic", "key": "zone", "value": { "tagName": "agent.zone" } },
+			{ "type": "dynamic", "key": "stage", "value": { "tagName": "agent.tag", "key": "stage" } }
+		],
+		"lastUpdated": 1700000000000
+	}`
+
+	result, err := NewGlobalCustomPayloadConfigurationUnmarshaller().Unmarshal([]byte(data))
+
+	require.NoError(t, err)
+	require.Equal(t, &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{
+		createStaticCustomPayloadField("team", "platform"),
+		createDynamicCustomPayloadField("zone", "agent.zone", nil),
+		createDynamicCustomPayloadField("stage", "agent.tag", utils.StringPtr("stage")),
+	}}, result)
+}
+
+func TestShouldUnmarshalGlobalCustomPayloadConfigurationWhenKeyOfDynamicValueIsNull(t *testing.T) {
+	data := `{ "fields": [ { "type": "dynamic", "key": "zone", "value": { "tagName": "agent.zone", "key": null } } ] }`
+
+	result, err := NewGlobalCustomPayloadConfigurationUnmarshaller().Unmarshal([]byte(data))
+
+	require.NoError(t, err)
+	require.Equal(t, &GlobalCustomPayloadConfiguration{Fields: []CustomPayloadField[any]{
+		createDynamicCustomPayloadField("zone", "agent.zone", nil),
+	}}, result)
+}
+
+func TestShouldFailToUnmarshalGlobalCustomPayloadConfigurationWhenFieldValueIsNotValid(t *testing.T) {
+	for _, field := range []string{
+		`{ "type": "dynamic", "key": "zone", "value": { "key": "zone" } }`,
+		`{ "type": "dynamic", "key": "zone", "value": null }`,
+		`{ "type": "staticString", "key": "team", "value": null }`,
+	} {
+		_, err := NewGlobalCustomPayloadConfigurationUnmarshaller().Unmarshal([]byte(`{ "fields": [ ` + field + ` ] }`))
+
+		require.Error(t, err, field)
+	}
+}
+
+func TestShouldFailToUnmarshalGlobalCustomPayloadConfigurationWhenResponseIsNotValidJSON(t *testing.T) {
+	_, err := NewGlobalCustomPayloadConfigurationUnmarshaller().Unmarshal([]byte("invalid"))
+
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package restapi
+
+import (
+	"context"
+)
+
+//NewGroupMappingRestResource creates a new REST resource for group mappings. The Instana API does not provide an
+//endpoint to read a single group mapping. Therefore, single group mappings are read from the list of all group mappings.
+//Create, update and delete are delegated to a REST resource using POST for create and PUT for update.
+func NewGroupMappingRestResource(client RestClient) RestResource {
+	return &groupMappingRestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(GroupMappingsResourcePath, NewDefaultJSONUnmarshaller(&GroupMapping{}), client),
+	}
+}
+
+type groupMappingRestResource struct {
+	RestResource
+}
+
+func (r *groupMappingRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	mappings, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range *mappings {
+		if mapping.GetIDForResourcePath() == id {
+			if err := mapping.Validate(); err != nil {
+				return mapping, err
+			}
+			return mapping, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
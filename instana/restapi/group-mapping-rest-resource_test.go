@@ -0,0 +1,106 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnGroupMappingFromListWhenExecutingGetOneOperationOfGroupMappingRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mapping := createValidGroupMapping()
+	other := createValidGroupMapping()
+	other.ID = "other-id"
+	response, _ := json.Marshal([]*GroupMapping{other, mapping})
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupMappingRestResource(client).GetOne(context.Background(), groupMappingID)
+
+	require.NoError(t, err)
+	require.Equal(t, mapping, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenGroupMappingIsNotContainedInListOfGroupMappings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	other := createValidGroupMapping()
+	other.ID = "other-id"
+	response, _ := json.Marshal([]*GroupMapping{other})
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewGroupMappingRestResource(client).GetOne(context.Background(), groupMappingID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOneOperationOfGroupMappingRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewGroupMappingRestResource(client).GetOne(context.Background(), groupMappingID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenGroupMappingReturnedByGetOneOperationIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mapping := createValidGroupMapping()
+	mapping.Key = ""
+	response, _ := json.Marshal([]*GroupMapping{mapping})
+
+	client.EXPECT().Get(gomock.Any(), GroupMappingsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewGroupMappingRestResource(client).GetOne(context.Background(), groupMappingID)
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateGroupMappingViaPostAndUpdateGroupMappingViaPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mapping := createValidGroupMapping()
+	response, _ := json.Marshal(mapping)
+	sut := NewGroupMappingRestResource(client)
+
+	client.EXPECT().Post(gomock.Any(), mapping, GroupMappingsResourcePath).Times(1).Return(response, nil)
+	client.EXPECT().Put(gomock.Any(), mapping, GroupMappingsResourcePath).Times(1).Return(response, nil)
+
+	result, err := sut.Create(context.Background(), mapping)
+	require.NoError(t, err)
+	require.Equal(t, mapping, result)
+
+	result, err = sut.Update(context.Background(), mapping)
+	require.NoError(t, err)
+	require.Equal(t, mapping, result)
+}
+
+func TestShouldDeleteGroupMappingByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	mapping := createValidGroupMapping()
+
+	client.EXPECT().Delete(gomock.Any(), groupMappingID, GroupMappingsResourcePath).Times(1).Return(nil)
+
+	err := NewGroupMappingRestResource(client).Delete(context.Background(), mapping)
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,40 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//GroupMappingsResourcePath path to Group Mapping resource of Instana RESTful API
+const GroupMappingsResourcePath = RBACSettingsBasePath + "/mappings"
+
+//GroupMapping data structure for the Instana API model for mappings of groups of an identity provider (LDAP, SAML or
+//OIDC) to Instana groups. The key is the attribute of the identity provider which contains the group memberships of the
+//user and the value is the group of the identity provider which is mapped to the Instana group
+type GroupMapping struct {
+	ID      string `json:"id,omitempty"`
+	GroupID string `json:"groupId"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (m *GroupMapping) GetIDForResourcePath() string {
+	return m.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not
+//validated as it is generated by Instana when the group mapping is created
+func (m *GroupMapping) Validate() error {
+	if utils.IsBlank(m.GroupID) {
+		return errors.New("groupId is missing")
+	}
+	if utils.IsBlank(m.Key) {
+		return errors.New("key is missing")
+	}
+	if utils.IsBlank(m.Value) {
+		return errors.New("value is missing")
+	}
+	return nil
+}
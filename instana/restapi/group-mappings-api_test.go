@@ -0,0 +1,58 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	groupMappingID      = "group-mapping-id"
+	groupMappingGroupID = "group-id"
+	groupMappingKey     = "memberOf"
+	groupMappingValue   = "cn=admins,ou=groups,dc=example,dc=com"
+)
+
+func TestShouldSuccessfullyValidateGroupMapping(t *testing.T) {
+	mapping := createValidGroupMapping()
+
+	require.Equal(t, groupMappingID, mapping.GetIDForResourcePath())
+	require.NoError(t, mapping.Validate())
+}
+
+func TestShouldSuccessfullyValidateGroupMappingWithoutID(t *testing.T) {
+	mapping := createValidGroupMapping()
+	mapping.ID = ""
+
+	require.NoError(t, mapping.Validate())
+}
+
+func TestShouldFailToValidateGroupMapping(t *testing.T) {
+	testCases := map[string]func(m *GroupMapping){
+		"groupId is missing": func(m *GroupMapping) { m.GroupID = " " },
+		"key is missing":     func(m *GroupMapping) { m.Key = "" },
+		"value is missing":   func(m *GroupMapping) { m.Value = " " },
+	}
+
+	for expectedError, modifier := range testCases {
+		t.Run("Should fail with error "+expectedError, func(t *testing.T) {
+			mapping := createValidGroupMapping()
+			modifier(mapping)
+
+			err := mapping.Validate()
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err.Error())
+		})
+	}
+}
+
+func createValidGroupMapping() *GroupMapping {
+	return &GroupMapping{
+		ID:      groupMappingID,
+		GroupID: groupMappingGroupID,
+		Key:     groupMappingKey,
+		Value:   groupMappingValue,
+	}
+}
@@ -0,0 +1,184 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//NewGroupMembershipRestResource creates a new REST resource for the membership of a single user in a group. Users are
+//added through the users endpoint of the group which keeps all other members of the group. As the Instana API does not
+//provide an endpoint to remove a single user from a group, the user is removed by updating the group without the user.
+//All changes of the members of a group are serialized per group and verified afterwards.
+func NewGroupMembershipRestResource(client RestClient) RestResource {
+	return &groupMembershipRestResource{
+		client:            client,
+		groupUnmarshaller: NewDefaultJSONUnmarshaller(&Group{}),
+	}
+}
+
+type groupMembershipRestResource struct {
+	client            RestClient
+	groupUnmarshaller JSONUnmarshaller
+}
+
+//groupUsersRequest the payload of the users endpoint of a group which is a plain JSON array of the user IDs
+type groupUsersRequest []string
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the path element of the users endpoint
+func (r groupUsersRequest) GetIDForResourcePath() string {
+	return GroupMembershipUsersPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject
+func (r groupUsersRequest) Validate() error {
+	return nil
+}
+
+//GetAll returns all memberships of all groups
+func (r *groupMembershipRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, GroupsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := unmarshalArray(data, r.groupUnmarshaller)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]InstanaDataObject, 0)
+	for _, g := range *groups {
+		group := g.(*Group)
+		for _, member := range group.Members {
+			result = append(result, r.toGroupMembership(group.ID, member))
+		}
+	}
+	return &result, nil
+}
+
+func (r *groupMembershipRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return nil, err
+	}
+	group, err := r.getGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range group.Members {
+		if member.UserID == userID {
+			return r.toGroupMembership(groupID, member), nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *groupMembershipRestResource) toGroupMembership(groupID string, member APIMember) *GroupMembership {
+	return &GroupMembership{GroupID: groupID, UserID: member.UserID, Email: member.Email}
+}
+
+func (r *groupMembershipRestResource) getGroup(ctx context.Context, groupID string) (*Group, error) {
+	data, err := r.client.GetOne(ctx, groupID, GroupsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	group, err := r.groupUnmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return group.(*Group), nil
+}
+
+func (r *groupMembershipRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	membership := data.(*GroupMembership)
+	userID := membership.UserID
+	if len(userID) == 0 {
+		var err error
+		userID, err = r.lookupUserIDByEmail(ctx, *membership.Email)
+		if err != nil {
+			return data, err
+		}
+	}
+	unlock := groupUpdateLocks.Lock(membership.GroupID)
+	defer unlock()
+	if _, err := r.client.Put(ctx, groupUsersRequest{userID}, GroupsResourcePath+"/"+membership.GroupID); err != nil {
+		return data, err
+	}
+	result, err := r.GetOne(ctx, NewGroupMembershipID(membership.GroupID, userID))
+	if errors.Is(err, ErrEntityNotFound) {
+		return data, fmt.Errorf("user %s is not a member of group %s after it was added; the group was modified concurrently", userID, membership.GroupID)
+	}
+	return result, err
+}
+
+func (r *groupMembershipRestResource) lookupUserIDByEmail(ctx context.Context, email string) (string, error) {
+	data, err := r.client.Get(ctx, UsersResourcePath)
+	if err != nil {
+		return "", err
+	}
+	users := make([]User, 0)
+	if err := json.Unmarshal(data, &users); err != nil {
+		return "", fmt.Errorf("failed to parse json; %s", err)
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user.ID, nil
+		}
+	}
+	return "", fmt.Errorf("user with email %s does not exist", email)
+}
+
+//Update adds the user to the group again. The users endpoint of the group is idempotent
+func (r *groupMembershipRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Create(ctx, data)
+}
+
+func (r *groupMembershipRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID removes the user from the group by updating the group without the user. The request is skipped when the
+//user is not a member of the group anymore. After the update the group is read again to verify that the user was removed
+func (r *groupMembershipRestResource) DeleteByID(ctx context.Context, id string) error {
+	groupID, userID, err := ParseGroupMembershipID(id)
+	if err != nil {
+		return err
+	}
+	unlock := groupUpdateLocks.Lock(groupID)
+	defer unlock()
+	group, err := r.getGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	members := make([]APIMember, 0, len(group.Members))
+	for _, member := range group.Members {
+		if member.UserID != userID {
+			members = append(members, member)
+		}
+	}
+	if len(members) == len(group.Members) {
+		return nil
+	}
+	group.Members = members
+	if _, err = r.client.Put(ctx, group, GroupsResourcePath); err != nil {
+		return err
+	}
+	return r.verifyUserIsNotMemberOfGroup(ctx, groupID, userID)
+}
+
+func (r *groupMembershipRestResource) verifyUserIsNotMemberOfGroup(ctx context.Context, groupID string, userID string) error {
+	group, err := r.getGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	for _, member := range group.Members {
+		if member.UserID == userID {
+			return fmt.Errorf("user %s is still a member of group %s after it was removed; the group was modified concurrently", userID, groupID)
+		}
+	}
+	return nil
+}
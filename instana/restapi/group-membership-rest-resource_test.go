@@ -0,0 +1,303 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const groupMembershipOtherUserID = "other-user-id"
+
+func TestShouldReturnGroupMembershipWhenUserIsMemberOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID, groupMembershipUserID))
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupMembershipRestResource(client).GetOne(context.Background(), groupMembershipID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID, Email: utils.StringPtr(groupMembershipUserID + "@example.com")}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenUserIsNotMemberOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID))
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewGroupMembershipRestResource(client).GetOne(context.Background(), groupMembershipID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnErrorWhenGroupOfGroupMembershipCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	_, err := NewGroupMembershipRestResource(client).GetOne(context.Background(), groupMembershipID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToReadGroupMembershipWhenIDIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGroupMembershipRestResource(client).GetOne(context.Background(), "invalid")
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnMembershipsOfAllGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	otherGroup := createGroupForGroupMembershipTest(groupMembershipOtherUserID)
+	otherGroup.ID = "other-group-id"
+	response, _ := json.Marshal([]*Group{createGroupForGroupMembershipTest(groupMembershipUserID), otherGroup})
+
+	client.EXPECT().Get(gomock.Any(), GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupMembershipRestResource(client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, *result, 2)
+	require.Equal(t, groupMembershipID, (*result)[0].GetIDForResourcePath())
+	require.Equal(t, "other-group-id:"+groupMembershipOtherUserID, (*result)[1].GetIDForResourcePath())
+}
+
+func TestShouldAddUserByIDToGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID, groupMembershipUserID))
+
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).DoAndReturn(func(_ context.Context, data InstanaDataObject, _ string) ([]byte, error) {
+			require.Equal(t, "users", data.GetIDForResourcePath())
+			body, _ := json.Marshal(data)
+			require.JSONEq(t, `["user-id"]`, string(body))
+			return response, nil
+		}),
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil),
+	)
+
+	result, err := NewGroupMembershipRestResource(client).Create(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipID, result.GetIDForResourcePath())
+}
+
+func TestShouldAddUserByEmailToGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	users, _ := json.Marshal([]User{
+		{ID: groupMembershipOtherUserID, Email: "other@example.com"},
+		{ID: groupMembershipUserID, Email: "User@Example.com"},
+	})
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipUserID))
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(users, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).Return(response, nil)
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupMembershipRestResource(client).Create(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, Email: utils.StringPtr(groupMembershipEmail)})
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipID, result.GetIDForResourcePath())
+}
+
+func TestShouldFailToAddUserByEmailToGroupWhenUserDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	users, _ := json.Marshal([]User{{ID: groupMembershipOtherUserID, Email: "other@example.com"}})
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(users, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGroupMembershipRestResource(client).Create(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, Email: utils.StringPtr(groupMembershipEmail)})
+
+	require.Error(t, err)
+	require.Equal(t, "user with email user@example.com does not exist", err.Error())
+}
+
+func TestShouldFailToAddUserToGroupWhenRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).Return(nil, expectedError)
+
+	_, err := NewGroupMembershipRestResource(client).Update(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotCallInstanaAPIWhenAddingInvalidGroupMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	_, err := NewGroupMembershipRestResource(client).Create(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID})
+
+	require.Error(t, err)
+}
+
+func TestShouldRemoveUserFromGroupAndKeepOtherMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID, groupMembershipUserID))
+	expectedGroup := createGroupForGroupMembershipTest(groupMembershipOtherUserID)
+	responseAfterUpdate, _ := json.Marshal(expectedGroup)
+
+	gomock.InOrder(
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil),
+		client.EXPECT().Put(gomock.Any(), expectedGroup, GroupsResourcePath).Times(1).Return(nil, nil),
+		client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(responseAfterUpdate, nil),
+	)
+
+	err := NewGroupMembershipRestResource(client).Delete(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailToRemoveUserFromGroupWhenUserIsStillMemberAfterUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID, groupMembershipUserID))
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(2).Return(response, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath).Times(1).Return(nil, nil)
+
+	err := NewGroupMembershipRestResource(client).DeleteByID(context.Background(), groupMembershipID)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is still a member of group")
+}
+
+func TestShouldFailToAddUserToGroupWhenUserIsNotMemberAfterUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID))
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath+"/"+groupMembershipGroupID).Times(1).Return(response, nil)
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewGroupMembershipRestResource(client).Create(context.Background(), &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not a member of group")
+}
+
+func TestShouldNotRestoreRemovedUsersWhenUsersAreRemovedFromGroupInParallel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	userIDs := make([]string, 10)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprintf("user-%d", i)
+	}
+	var lock sync.Mutex
+	current := createGroupForGroupMembershipTest(userIDs...)
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).AnyTimes().DoAndReturn(func(_ context.Context, _ string, _ string) ([]byte, error) {
+		lock.Lock()
+		response, err := json.Marshal(current)
+		lock.Unlock()
+		//simulate the latency between reading and updating the group so that unserialized updates overlap
+		time.Sleep(5 * time.Millisecond)
+		return response, err
+	})
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), GroupsResourcePath).AnyTimes().DoAndReturn(func(_ context.Context, data InstanaDataObject, _ string) ([]byte, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		current = data.(*Group)
+		return nil, nil
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(userIDs))
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			errs <- NewGroupMembershipRestResource(client).DeleteByID(context.Background(), NewGroupMembershipID(groupMembershipGroupID, userID))
+		}(userID)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Empty(t, current.Members)
+}
+
+func TestShouldNotUpdateGroupWhenRemovedUserIsNotMemberOfGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal(createGroupForGroupMembershipTest(groupMembershipOtherUserID))
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(response, nil)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := NewGroupMembershipRestResource(client).DeleteByID(context.Background(), groupMembershipID)
+
+	require.NoError(t, err)
+}
+
+func TestShouldReturnErrorWhenGroupCannotBeReadForRemovalOfUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().GetOne(gomock.Any(), groupMembershipGroupID, GroupsResourcePath).Times(1).Return(nil, expectedError)
+
+	err := NewGroupMembershipRestResource(client).DeleteByID(context.Background(), groupMembershipID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func createGroupForGroupMembershipTest(userIDs ...string) *Group {
+	members := make([]APIMember, len(userIDs))
+	for i, userID := range userIDs {
+		members[i] = APIMember{UserID: userID, Email: utils.StringPtr(userID + "@example.com")}
+	}
+	return &Group{
+		ID:      groupMembershipGroupID,
+		Name:    "group",
+		Members: members,
+		PermissionSet: APIPermissionSetWithRoles{
+			Permissions: []InstanaPermission{PermissionCanConfigureApplications},
+		},
+	}
+}
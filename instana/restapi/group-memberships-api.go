@@ -0,0 +1,56 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//GroupMembershipUsersPathElement path element of the endpoint to add users to a group
+const GroupMembershipUsersPathElement = "users"
+
+//groupMembershipIDSeparator separator of the group ID and the user ID in the ID of a group membership
+const groupMembershipIDSeparator = ":"
+
+//GroupMembership data structure for the membership of a single user in a group. The membership is not a resource on its
+//own at Instana but a member of the group. The user is either identified by its ID or by its email address
+type GroupMembership struct {
+	GroupID string
+	UserID  string
+	Email   *string
+}
+
+//NewGroupMembershipID creates the ID of a group membership from the given group ID and user ID
+func NewGroupMembershipID(groupID string, userID string) string {
+	return groupID + groupMembershipIDSeparator + userID
+}
+
+//ParseGroupMembershipID splits the ID of a group membership into the group ID and the user ID
+func ParseGroupMembershipID(id string) (string, string, error) {
+	parts := strings.SplitN(id, groupMembershipIDSeparator, 2)
+	if len(parts) != 2 || utils.IsBlank(parts[0]) || utils.IsBlank(parts[1]) {
+		return "", "", fmt.Errorf("%s is not a valid group membership id; expected <group_id>%s<user_id>", id, groupMembershipIDSeparator)
+	}
+	return parts[0], parts[1], nil
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. The ID consists of the group ID and the user ID
+func (m *GroupMembership) GetIDForResourcePath() string {
+	if len(m.UserID) == 0 {
+		return ""
+	}
+	return NewGroupMembershipID(m.GroupID, m.UserID)
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (m *GroupMembership) Validate() error {
+	if utils.IsBlank(m.GroupID) {
+		return errors.New("groupId is missing")
+	}
+	if utils.IsBlank(m.UserID) && (m.Email == nil || utils.IsBlank(*m.Email)) {
+		return errors.New("either userId or email is required")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	groupMembershipGroupID = "group-id"
+	groupMembershipUserID  = "user-id"
+	groupMembershipEmail   = "user@example.com"
+	groupMembershipID      = groupMembershipGroupID + ":" + groupMembershipUserID
+)
+
+func TestShouldCreateAndParseIDOfGroupMembership(t *testing.T) {
+	id := NewGroupMembershipID(groupMembershipGroupID, groupMembershipUserID)
+
+	require.Equal(t, groupMembershipID, id)
+
+	groupID, userID, err := ParseGroupMembershipID(id)
+
+	require.NoError(t, err)
+	require.Equal(t, groupMembershipGroupID, groupID)
+	require.Equal(t, groupMembershipUserID, userID)
+}
+
+func TestShouldFailToParseInvalidIDOfGroupMembership(t *testing.T) {
+	for _, id := range []string{"", "group-id", "group-id:", ":user-id", " : "} {
+		t.Run("Should fail to parse '"+id+"'", func(t *testing.T) {
+			_, _, err := ParseGroupMembershipID(id)
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldReturnIDOfGroupMembershipWhenUserIDIsKnown(t *testing.T) {
+	membership := &GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}
+
+	require.Equal(t, groupMembershipID, membership.GetIDForResourcePath())
+}
+
+func TestShouldReturnEmptyIDOfGroupMembershipWhenUserIDIsNotKnown(t *testing.T) {
+	membership := &GroupMembership{GroupID: groupMembershipGroupID, Email: utils.StringPtr(groupMembershipEmail)}
+
+	require.Empty(t, membership.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateGroupMembershipWithUserIDOrEmail(t *testing.T) {
+	require.NoError(t, (&GroupMembership{GroupID: groupMembershipGroupID, UserID: groupMembershipUserID}).Validate())
+	require.NoError(t, (&GroupMembership{GroupID: groupMembershipGroupID, Email: utils.StringPtr(groupMembershipEmail)}).Validate())
+}
+
+func TestShouldFailToValidateGroupMembership(t *testing.T) {
+	testCases := map[string]*GroupMembership{
+		"groupId is missing":                 {GroupID: " ", UserID: groupMembershipUserID},
+		"either userId or email is required": {GroupID: groupMembershipGroupID, Email: utils.StringPtr(" ")},
+	}
+
+	for expectedError, membership := range testCases {
+		t.Run("Should fail with error "+expectedError, func(t *testing.T) {
+			err := membership.Validate()
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err.Error())
+		})
+	}
+}
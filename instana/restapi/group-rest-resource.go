@@ -0,0 +1,48 @@
+package restapi
+
+import (
+	"context"
+)
+
+//NewGroupRestResource creates a new REST resource for groups using POST for create and PUT for update. When the members
+//of a group are not managed (nil), the group is created without members and the current members are kept on update so
+//that memberships managed separately are not removed. Updates are serialized per group with the changes of group
+//memberships so that members added or removed in parallel are not overwritten.
+func NewGroupRestResource(client RestClient) RestResource {
+	return &groupRestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(GroupsResourcePath, NewDefaultJSONUnmarshaller(&Group{}), client),
+	}
+}
+
+type groupRestResource struct {
+	RestResource
+}
+
+func (r *groupRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	group := data.(*Group)
+	if group.Members == nil {
+		groupWithoutMembers := *group
+		groupWithoutMembers.Members = make([]APIMember, 0)
+		return r.RestResource.Create(ctx, &groupWithoutMembers)
+	}
+	return r.RestResource.Create(ctx, data)
+}
+
+func (r *groupRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	group := data.(*Group)
+	unlock := groupUpdateLocks.Lock(group.ID)
+	defer unlock()
+	if group.Members == nil {
+		current, err := r.RestResource.GetOne(ctx, group.ID)
+		if err != nil {
+			return data, err
+		}
+		groupWithCurrentMembers := *group
+		groupWithCurrentMembers.Members = current.(*Group).Members
+		if groupWithCurrentMembers.Members == nil {
+			groupWithCurrentMembers.Members = make([]APIMember, 0)
+		}
+		return r.RestResource.Update(ctx, &groupWithCurrentMembers)
+	}
+	return r.RestResource.Update(ctx, data)
+}
@@ -0,0 +1,111 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	groupRestResourceGroupID = "group-id"
+	groupRestResourceUserID  = "user-id"
+)
+
+func TestShouldCreateGroupWithProvidedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	group := createGroupForGroupRestResourceTest([]APIMember{{UserID: groupRestResourceUserID}})
+	response, _ := json.Marshal(group)
+
+	client.EXPECT().Post(gomock.Any(), group, GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupRestResource(client).Create(context.Background(), group)
+
+	require.NoError(t, err)
+	require.Equal(t, group, result)
+}
+
+func TestShouldCreateGroupWithoutMembersWhenMembersAreNotManaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	group := createGroupForGroupRestResourceTest(nil)
+	expectedRequest := createGroupForGroupRestResourceTest([]APIMember{})
+	response, _ := json.Marshal(expectedRequest)
+
+	client.EXPECT().Post(gomock.Any(), expectedRequest, GroupsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewGroupRestResource(client).Create(context.Background(), group)
+
+	require.NoError(t, err)
+	require.Nil(t, group.Members)
+}
+
+func TestShouldUpdateGroupWithProvidedMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	group := createGroupForGroupRestResourceTest([]APIMember{})
+	response, _ := json.Marshal(group)
+
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Put(gomock.Any(), group, GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupRestResource(client).Update(context.Background(), group)
+
+	require.NoError(t, err)
+	require.Equal(t, group, result)
+}
+
+func TestShouldKeepCurrentMembersWhenUpdatingGroupAndMembersAreNotManaged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	group := createGroupForGroupRestResourceTest(nil)
+	currentGroup := createGroupForGroupRestResourceTest([]APIMember{{UserID: groupRestResourceUserID}})
+	currentGroup.Name = "current name"
+	currentGroupResponse, _ := json.Marshal(currentGroup)
+	expectedRequest := createGroupForGroupRestResourceTest([]APIMember{{UserID: groupRestResourceUserID}})
+	response, _ := json.Marshal(expectedRequest)
+
+	client.EXPECT().GetOne(gomock.Any(), groupRestResourceGroupID, GroupsResourcePath).Times(1).Return(currentGroupResponse, nil)
+	client.EXPECT().Put(gomock.Any(), expectedRequest, GroupsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewGroupRestResource(client).Update(context.Background(), group)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedRequest, result)
+}
+
+func TestShouldFailToUpdateGroupWhenMembersAreNotManagedAndCurrentGroupCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	group := createGroupForGroupRestResourceTest(nil)
+	expectedError := errors.New("test")
+
+	client.EXPECT().GetOne(gomock.Any(), groupRestResourceGroupID, GroupsResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewGroupRestResource(client).Update(context.Background(), group)
+
+	require.Equal(t, expectedError, err)
+}
+
+func createGroupForGroupRestResourceTest(members []APIMember) *Group {
+	return &Group{
+		ID:      groupRestResourceGroupID,
+		Name:    "name",
+		Members: members,
+		PermissionSet: APIPermissionSetWithRoles{
+			Permissions: []InstanaPermission{PermissionCanConfigureApplications},
+		},
+	}
+}
@@ -187,7 +187,8 @@ func (m *APIMember) validate() error {
 	return nil
 }
 
-//Group data structure for the Instana API model for groups
+//Group data structure for the Instana API model for groups. Members are nil when the members of the group are not
+//managed by terraform. In this case the current members of the group are kept when the group is updated
 type Group struct {
 	ID            string                    `json:"id"`
 	Name          string                    `json:"name"`
@@ -1,5 +1,7 @@
 package restapi
 
+import "context"
+
 //InstanaDataObject is a marker interface for any data object provided by any resource of the Instana REST API
 type InstanaDataObject interface {
 	GetIDForResourcePath() string
@@ -8,11 +10,12 @@ type InstanaDataObject interface {
 
 //RestResource interface definition of a instana REST resource.
 type RestResource interface {
-	GetOne(id string) (InstanaDataObject, error)
-	Create(data InstanaDataObject) (InstanaDataObject, error)
-	Update(data InstanaDataObject) (InstanaDataObject, error)
-	Delete(data InstanaDataObject) error
-	DeleteByID(id string) error
+	GetAll(ctx context.Context) (*[]InstanaDataObject, error)
+	GetOne(ctx context.Context, id string) (InstanaDataObject, error)
+	Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error)
+	Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error)
+	Delete(ctx context.Context, data InstanaDataObject) error
+	DeleteByID(ctx context.Context, id string) error
 }
 
 //DataFilterFunc function definition for filtering data received from Instana API
@@ -21,8 +24,8 @@ type DataFilterFunc func(o InstanaDataObject) bool
 //ReadOnlyRestResource interface definition for a read only REST resource. The resource at instana might
 //implement more methods but the implementation of the provider is limited to read only.
 type ReadOnlyRestResource interface {
-	GetAll() (*[]InstanaDataObject, error)
-	GetOne(id string) (InstanaDataObject, error)
+	GetAll(ctx context.Context) (*[]InstanaDataObject, error)
+	GetOne(ctx context.Context, id string) (InstanaDataObject, error)
 }
 
 //JSONUnmarshaller interface definition for unmarshalling that unmarshalls JSON to go data structures
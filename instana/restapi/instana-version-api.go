@@ -0,0 +1,33 @@
+package restapi
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-version"
+)
+
+//InstanaVersionResourcePath path to the version information of the Instana backend
+const InstanaVersionResourcePath = InstanaAPIBasePath + "/instana/version"
+
+//InstanaVersionInfo the version information of the Instana backend as provided by the Instana API
+type InstanaVersionInfo struct {
+	Branch   string `json:"branch"`
+	Commit   string `json:"commit"`
+	ImageTag string `json:"imageTag"`
+}
+
+var instanaVersionPattern = regexp.MustCompile(`\d+\.\d+\.\d+`)
+
+//Version returns the version of the Instana backend. The version is parsed from the image tag (e.g. 3.229.318-0 results in
+//version 3.229.318). When the image tag does not contain a version, the version is parsed from the branch. Only full
+//versions (major.minor.patch) are accepted as partial versions like release-229 cannot be compared with the minimum
+//versions of the resources
+func (i *InstanaVersionInfo) Version() (*version.Version, error) {
+	for _, value := range []string{i.ImageTag, i.Branch} {
+		if match := instanaVersionPattern.FindString(value); len(match) > 0 {
+			return version.NewVersion(match)
+		}
+	}
+	return nil, fmt.Errorf("no version found in version information of Instana backend; image tag = %s; branch = %s", i.ImageTag, i.Branch)
+}
@@ -0,0 +1,85 @@
+package restapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldParseVersionOfInstanaBackendFromImageTag(t *testing.T) {
+	versionInfo := InstanaVersionInfo{Branch: "release-229", Commit: "abc", ImageTag: "3.229.318-0"}
+
+	result, err := versionInfo.Version()
+
+	require.NoError(t, err)
+	require.Equal(t, "3.229.318", result.String())
+}
+
+func TestShouldParseVersionOfInstanaBackendFromBranchWhenImageTagDoesNotContainAVersion(t *testing.T) {
+	versionInfo := InstanaVersionInfo{Branch: "release-3.229.318", ImageTag: "latest"}
+
+	result, err := versionInfo.Version()
+
+	require.NoError(t, err)
+	require.Equal(t, "3.229.318", result.String())
+}
+
+func TestShouldFailToParseVersionOfInstanaBackendWhenBranchOnlyContainsAPartialVersion(t *testing.T) {
+	for _, branch := range []string{"release-229", "release-3.229"} {
+		t.Run(branch, func(t *testing.T) {
+			versionInfo := InstanaVersionInfo{Branch: branch, ImageTag: "latest"}
+
+			_, err := versionInfo.Version()
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "no version found")
+		})
+	}
+}
+
+func TestShouldFailToParseVersionOfInstanaBackendWhenNoVersionIsProvided(t *testing.T) {
+	versionInfo := InstanaVersionInfo{Branch: "main", ImageTag: "latest"}
+
+	_, err := versionInfo.Version()
+
+	require.Error(t, err)
+}
+
+func TestShouldRequestVersionOfInstanaBackend(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"branch":"release-229","commit":"abc","imageTag":"3.229.318-0"}`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()), createTestClientConfig())
+	require.NoError(t, err)
+	result, err := api.InstanaVersion(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &InstanaVersionInfo{Branch: "release-229", Commit: "abc", ImageTag: "3.229.318-0"}, result)
+}
+
+func TestShouldFailToRequestVersionOfInstanaBackendWhenResponseIsInvalid(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, InstanaVersionResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`invalid`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()), createTestClientConfig())
+	require.NoError(t, err)
+	_, err = api.InstanaVersion(context.Background())
+
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+//NewInvitationRestResource creates a new REST resource for invitations of users. Invitations are created in bulk and
+//revoked by the email address of the invited user. The Instana API only returns pending invitations. Therefore,
+//invitations which are accepted (or revoked) are reported as not found.
+func NewInvitationRestResource(client RestClient) RestResource {
+	return &invitationRestResource{
+		unmarshaller: NewDefaultJSONUnmarshaller(&Invitation{}),
+		client:       client,
+	}
+}
+
+type invitationRestResource struct {
+	unmarshaller JSONUnmarshaller
+	client       RestClient
+}
+
+//invitationsRequest the payload of the invitations endpoint which is a JSON array of invitations
+type invitationsRequest []*Invitation
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Invitations are created in bulk without ID
+func (r invitationsRequest) GetIDForResourcePath() string {
+	return ""
+}
+
+//Validate implementation of the interface InstanaDataObject
+func (r invitationsRequest) Validate() error {
+	return nil
+}
+
+func (r *invitationRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, InvitationsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalArray(data, r.unmarshaller)
+}
+
+//GetOne returns the pending invitation of the given email address
+func (r *invitationRestResource) GetOne(ctx context.Context, email string) (InstanaDataObject, error) {
+	invitations, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range *invitations {
+		if strings.EqualFold(invitation.GetIDForResourcePath(), email) {
+			if err := invitation.Validate(); err != nil {
+				return invitation, err
+			}
+			return invitation, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+//Create invites the user unless a user with the same email address already exists
+func (r *invitationRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	invitation := data.(*Invitation)
+	if err := r.verifyUserDoesNotExist(ctx, invitation.Email); err != nil {
+		return data, err
+	}
+	if _, err := r.client.Post(ctx, invitationsRequest{invitation}, InvitationsResourcePath); err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, invitation.Email)
+}
+
+func (r *invitationRestResource) verifyUserDoesNotExist(ctx context.Context, email string) error {
+	data, err := r.client.Get(ctx, UsersResourcePath)
+	if err != nil {
+		return err
+	}
+	users := make([]User, 0)
+	if err := json.Unmarshal(data, &users); err != nil {
+		return fmt.Errorf("failed to parse json; %s", err)
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return fmt.Errorf("user with email %s already exists; the invitation has been accepted and can be removed from the configuration", email)
+		}
+	}
+	return nil
+}
+
+//Update invites the user again. Changes of the email address or the group require a new invitation
+func (r *invitationRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Create(ctx, data)
+}
+
+func (r *invitationRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID revokes the pending invitation of the given email address
+func (r *invitationRestResource) DeleteByID(ctx context.Context, email string) error {
+	return r.client.DeleteByQuery(ctx, InvitationsResourcePath, map[string]string{"email": email})
+}
@@ -0,0 +1,132 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnPendingInvitationOfEmailIgnoringCase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal([]*Invitation{{ID: "other-id", Email: "other@example.com"}, {ID: "invitation-id", Email: "User@Example.com", GroupID: invitationGroupID}})
+
+	client.EXPECT().Get(gomock.Any(), InvitationsResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewInvitationRestResource(client).GetOne(context.Background(), invitationEmail)
+
+	require.NoError(t, err)
+	require.Equal(t, &Invitation{ID: "invitation-id", Email: "User@Example.com", GroupID: invitationGroupID}, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenInvitationIsNotPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	response, _ := json.Marshal([]*Invitation{{ID: "other-id", Email: "other@example.com"}})
+
+	client.EXPECT().Get(gomock.Any(), InvitationsResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewInvitationRestResource(client).GetOne(context.Background(), invitationEmail)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnErrorWhenInvitationsCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), InvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewInvitationRestResource(client).GetOne(context.Background(), invitationEmail)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldCreateInvitationAndReturnPendingInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	invitation := &Invitation{Email: invitationEmail, GroupID: invitationGroupID}
+	users, _ := json.Marshal([]User{{ID: "other-user-id", Email: "other@example.com"}})
+	invitations, _ := json.Marshal([]*Invitation{{ID: "invitation-id", Email: invitationEmail, GroupID: invitationGroupID}})
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(users, nil),
+		client.EXPECT().Post(gomock.Any(), gomock.Any(), InvitationsResourcePath).Times(1).DoAndReturn(func(_ context.Context, data InstanaDataObject, _ string) ([]byte, error) {
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			require.JSONEq(t, `[{"email":"user@example.com","groupId":"group-id"}]`, string(payload))
+			return []byte{}, nil
+		}),
+		client.EXPECT().Get(gomock.Any(), InvitationsResourcePath).Times(1).Return(invitations, nil),
+	)
+
+	result, err := NewInvitationRestResource(client).Create(context.Background(), invitation)
+
+	require.NoError(t, err)
+	require.Equal(t, &Invitation{ID: "invitation-id", Email: invitationEmail, GroupID: invitationGroupID}, result)
+}
+
+func TestShouldFailToCreateInvitationWhenUserAlreadyExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	users, _ := json.Marshal([]User{{ID: "user-id", Email: "User@Example.com"}})
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return(users, nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewInvitationRestResource(client).Create(context.Background(), &Invitation{Email: invitationEmail, GroupID: invitationGroupID})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already exists")
+}
+
+func TestShouldFailToCreateInvitationWhenInvitationIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewInvitationRestResource(client).Create(context.Background(), &Invitation{GroupID: invitationGroupID})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToCreateInvitationWhenPostRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), UsersResourcePath).Times(1).Return([]byte("[]"), nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), InvitationsResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewInvitationRestResource(client).Create(context.Background(), &Invitation{Email: invitationEmail, GroupID: invitationGroupID})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldRevokeInvitationByEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().DeleteByQuery(gomock.Any(), InvitationsResourcePath, map[string]string{"email": invitationEmail}).Times(1).Return(nil)
+
+	err := NewInvitationRestResource(client).Delete(context.Background(), &Invitation{Email: invitationEmail, GroupID: invitationGroupID})
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,34 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//InvitationsResourcePath path to Invitation resource of Instana RESTful API
+const InvitationsResourcePath = SettingsBasePath + "/invitations"
+
+//Invitation data structure for the Instana API model for pending invitations of users. Invitations are identified by
+//the email address of the invited user. The invited user is assigned to the given group when the invitation is accepted
+type Invitation struct {
+	ID      string `json:"id,omitempty"`
+	Email   string `json:"email"`
+	GroupID string `json:"groupId"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Invitations are identified by the email address
+func (i *Invitation) GetIDForResourcePath() string {
+	return i.Email
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (i *Invitation) Validate() error {
+	if utils.IsBlank(i.Email) {
+		return errors.New("email is missing")
+	}
+	if utils.IsBlank(i.GroupID) {
+		return errors.New("group id is missing; Instana requires the group to which the invited user is assigned")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	invitationEmail   = "user@example.com"
+	invitationGroupID = "group-id"
+)
+
+func TestShouldReturnEmailAsIDOfInvitation(t *testing.T) {
+	invitation := Invitation{ID: "invitation-id", Email: invitationEmail}
+
+	require.Equal(t, invitationEmail, invitation.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateInvitation(t *testing.T) {
+	invitation := Invitation{Email: invitationEmail, GroupID: invitationGroupID}
+
+	require.NoError(t, invitation.Validate())
+}
+
+func TestShouldFailToValidateInvitationWhenEmailIsBlank(t *testing.T) {
+	for _, email := range []string{"", " "} {
+		invitation := Invitation{Email: email, GroupID: invitationGroupID}
+
+		err := invitation.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "email")
+	}
+}
+
+func TestShouldFailToValidateInvitationWhenGroupIDIsBlank(t *testing.T) {
+	for _, groupID := range []string{"", " "} {
+		invitation := Invitation{Email: invitationEmail, GroupID: groupID}
+
+		err := invitation.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "group id is missing")
+	}
+}
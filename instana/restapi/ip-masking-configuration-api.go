@@ -0,0 +1,78 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+//IPMaskingConfigurationPathElement path element of the IP masking configuration of website and mobile app monitoring configs
+const IPMaskingConfigurationPathElement = "ip-masking"
+
+//IPMasking custom type for the masking of IP addresses of beacons
+type IPMasking string
+
+//IPMaskings custom type for a slice of IPMasking
+type IPMaskings []IPMasking
+
+//IsSupported check if the provided IPMasking is supported
+func (maskings IPMaskings) IsSupported(masking IPMasking) bool {
+	for _, m := range maskings {
+		if m == masking {
+			return true
+		}
+	}
+	return false
+}
+
+//ToStringSlice Returns the corresponding string representations
+func (maskings IPMaskings) ToStringSlice() []string {
+	result := make([]string, len(maskings))
+	for i, v := range maskings {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//IPMaskingDefault constant value for IPMasking DEFAULT
+	IPMaskingDefault = IPMasking("DEFAULT")
+	//IPMaskingStrict constant value for IPMasking STRICT
+	IPMaskingStrict = IPMasking("STRICT")
+	//IPMaskingRemoveAllDetails constant value for IPMasking REMOVE_ALL_DETAILS
+	IPMaskingRemoveAllDetails = IPMasking("REMOVE_ALL_DETAILS")
+)
+
+//SupportedIPMaskings list of all supported IPMasking
+var SupportedIPMaskings = IPMaskings{IPMaskingDefault, IPMaskingStrict, IPMaskingRemoveAllDetails}
+
+//IPMaskingConfiguration data structure for the Instana API model of the IP masking configuration of a website or mobile app monitoring config
+type IPMaskingConfiguration struct {
+	MonitoringConfigID string    `json:"-"`
+	IPMasking          IPMasking `json:"ipMasking"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the ID of the monitoring config
+func (c *IPMaskingConfiguration) GetIDForResourcePath() string {
+	return c.MonitoringConfigID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *IPMaskingConfiguration) Validate() error {
+	if len(c.MonitoringConfigID) == 0 {
+		return errors.New("ID of monitoring config is missing")
+	}
+	if !SupportedIPMaskings.IsSupported(c.IPMasking) {
+		return fmt.Errorf("IP masking %s is not supported", c.IPMasking)
+	}
+	return nil
+}
+
+//Defaults implementation of the interface MonitoringConfigSettings
+func (c *IPMaskingConfiguration) Defaults() MonitoringConfigSettings {
+	return &IPMaskingConfiguration{MonitoringConfigID: c.MonitoringConfigID, IPMasking: IPMaskingDefault}
+}
+
+//KeepUnmanagedSettings implementation of the interface MonitoringConfigSettings. All settings are managed by the provider
+func (c *IPMaskingConfiguration) KeepUnmanagedSettings(_ MonitoringConfigSettings) {
+	//nothing to keep
+}
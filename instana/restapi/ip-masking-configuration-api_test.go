@@ -0,0 +1,82 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnTrueForAllSupportedIPMaskings(t *testing.T) {
+	for _, v := range SupportedIPMaskings {
+		require.True(t, SupportedIPMaskings.IsSupported(v))
+	}
+}
+
+func TestShouldReturnFalseForAllNonSupportedIPMaskings(t *testing.T) {
+	for _, v := range []string{"FOO", "BAR", "INVALID"} {
+		require.False(t, SupportedIPMaskings.IsSupported(IPMasking(v)))
+	}
+}
+
+func TestShouldReturnSupportedIPMaskingsAsStringSlice(t *testing.T) {
+	expected := []string{"DEFAULT", "STRICT", "REMOVE_ALL_DETAILS"}
+	require.Equal(t, expected, SupportedIPMaskings.ToStringSlice())
+}
+
+func TestShouldReturnMonitoringConfigIDAsIDOfIPMaskingConfiguration(t *testing.T) {
+	config := IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID}
+
+	require.Equal(t, testMonitoringConfigID, config.GetIDForResourcePath())
+}
+
+func TestShouldSuccessfullyValidateIPMaskingConfiguration(t *testing.T) {
+	for _, masking := range SupportedIPMaskings {
+		config := IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: masking}
+
+		require.NoError(t, config.Validate())
+	}
+}
+
+func TestShouldFailToValidateIPMaskingConfigurationWhenMonitoringConfigIDIsMissing(t *testing.T) {
+	config := IPMaskingConfiguration{IPMasking: IPMaskingStrict}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ID")
+}
+
+func TestShouldFailToValidateIPMaskingConfigurationWhenIPMaskingIsNotSupported(t *testing.T) {
+	config := IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMasking("INVALID")}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "INVALID")
+}
+
+func TestShouldReturnDefaultsOfIPMaskingConfigurationForTheSameMonitoringConfig(t *testing.T) {
+	config := IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMaskingStrict}
+
+	require.Equal(t, &IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMaskingDefault}, config.Defaults())
+}
+
+func TestShouldNotTakeOverAnySettingsFromCurrentIPMaskingConfiguration(t *testing.T) {
+	current := &IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMaskingDefault}
+	config := &IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMaskingStrict}
+
+	config.KeepUnmanagedSettings(current)
+
+	require.Equal(t, IPMaskingStrict, config.IPMasking)
+}
+
+func TestShouldNotSerializeMonitoringConfigIDOfIPMaskingConfiguration(t *testing.T) {
+	config := IPMaskingConfiguration{MonitoringConfigID: testMonitoringConfigID, IPMasking: IPMaskingStrict}
+
+	data, err := json.Marshal(&config)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ipMasking":"STRICT"}`, string(data))
+}
@@ -0,0 +1,35 @@
+package restapi
+
+import "sync"
+
+//newKeyedMutex creates a new keyedMutex
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*sync.Mutex)}
+}
+
+//keyedMutex provides a mutex per key (e.g. per ID of an object) so that read-modify-write operations on the same
+//object are serialized while operations on different objects can be executed in parallel
+type keyedMutex struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+//Lock locks the mutex of the given key and returns the function to unlock it
+func (m *keyedMutex) Lock(key string) func() {
+	m.mutex.Lock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		m.locks[key] = lock
+	}
+	m.mutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+//groupUpdateLocks serializes all updates of the members of a group within the provider. Terraform applies resources in
+//parallel and the Instana API only supports replacing all members of a group. Without serialization, parallel removals
+//of users from the same group would restore users removed by another request. The locks are shared by all REST
+//resources as the InstanaAPI creates a new REST resource instance for every call
+var groupUpdateLocks = newKeyedMutex()
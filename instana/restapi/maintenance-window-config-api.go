@@ -0,0 +1,72 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//MaintenanceWindowConfigResourcePath path to maintenance window config resource of Instana RESTful API
+const MaintenanceWindowConfigResourcePath = SettingsBasePath + "/maintenance"
+
+//MaxMaintenanceWindowsPerConfig the maximum number of windows of a maintenance window config supported by Instana
+const MaxMaintenanceWindowsPerConfig = 1
+
+//MaintenanceWindow represents a single time window of a maintenance window config. Start and end are provided as unix
+//timestamps in milliseconds
+type MaintenanceWindow struct {
+	ID    string `json:"id"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+}
+
+//Validate validates the maintenance window
+func (w MaintenanceWindow) Validate() error {
+	if utils.IsBlank(w.ID) {
+		return errors.New("id of window is missing")
+	}
+	if w.Start < 0 {
+		return errors.New("start of window must not be negative")
+	}
+	if w.End <= w.Start {
+		return errors.New("end of window must be after start of window")
+	}
+	return nil
+}
+
+//MaintenanceWindowConfig represents the REST resource of a maintenance window config at Instana. The entities affected
+//by the maintenance window are selected by a dynamic focus query. A config without window is not scheduled
+type MaintenanceWindowConfig struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Query   string              `json:"query"`
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *MaintenanceWindowConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *MaintenanceWindowConfig) Validate() error {
+	if utils.IsBlank(c.ID) {
+		return errors.New("id is missing")
+	}
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	if utils.IsBlank(c.Query) {
+		return errors.New("query is missing")
+	}
+	if len(c.Windows) > MaxMaintenanceWindowsPerConfig {
+		return fmt.Errorf("a maintenance window config supports at most %d window", MaxMaintenanceWindowsPerConfig)
+	}
+	for _, w := range c.Windows {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	maintenanceWindowConfigID    = "maintenance-window-config-id"
+	maintenanceWindowConfigName  = "maintenance-window-config-name"
+	maintenanceWindowConfigQuery = "entity.zone:production"
+)
+
+func TestShouldSuccessfullyValidateMaintenanceWindowConfigWithWindow(t *testing.T) {
+	config := createValidMaintenanceWindowConfig()
+
+	require.Equal(t, maintenanceWindowConfigID, config.GetIDForResourcePath())
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldSuccessfullyValidateMaintenanceWindowConfigWithoutWindow(t *testing.T) {
+	config := createValidMaintenanceWindowConfig()
+	config.Windows = nil
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldFailToValidateMaintenanceWindowConfig(t *testing.T) {
+	testCases := map[string]func(c *MaintenanceWindowConfig){
+		"id is missing":                               func(c *MaintenanceWindowConfig) { c.ID = "" },
+		"name is missing":                             func(c *MaintenanceWindowConfig) { c.Name = " " },
+		"query is missing":                            func(c *MaintenanceWindowConfig) { c.Query = "" },
+		"id of window is missing":                     func(c *MaintenanceWindowConfig) { c.Windows[0].ID = "" },
+		"start of window must not be negative":        func(c *MaintenanceWindowConfig) { c.Windows[0].Start = -1 },
+		"end of window must be after start of window": func(c *MaintenanceWindowConfig) { c.Windows[0].End = c.Windows[0].Start },
+		"a maintenance window config supports at most 1 window": func(c *MaintenanceWindowConfig) {
+			c.Windows = append(c.Windows, MaintenanceWindow{ID: "other-window-id", Start: 1700100000000, End: 1700200000000})
+		},
+	}
+
+	for expectedError, modifier := range testCases {
+		t.Run("Should fail with error "+expectedError, func(t *testing.T) {
+			config := createValidMaintenanceWindowConfig()
+			modifier(config)
+
+			err := config.Validate()
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err.Error())
+		})
+	}
+}
+
+func TestShouldUnmarshalMaintenanceWindowConfigAndIgnoreLastUpdated(t *testing.T) {
+	response := []byte(`{"id":"maintenance-window-config-id","name":"maintenance-window-config-name","query":"entity.zone:production","lastUpdated":1700000000001,"windows":[{"id":"window-id","start":1700000000000,"end":1700007200000}]}`)
+
+	result, err := NewDefaultJSONUnmarshaller(&MaintenanceWindowConfig{}).Unmarshal(response)
+
+	require.NoError(t, err)
+	require.Equal(t, createValidMaintenanceWindowConfig(), result)
+}
+
+func createValidMaintenanceWindowConfig() *MaintenanceWindowConfig {
+	return &MaintenanceWindowConfig{
+		ID:      maintenanceWindowConfigID,
+		Name:    maintenanceWindowConfigName,
+		Query:   maintenanceWindowConfigQuery,
+		Windows: []MaintenanceWindow{{ID: "window-id", Start: 1700000000000, End: 1700007200000}},
+	}
+}
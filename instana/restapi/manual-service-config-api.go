@@ -0,0 +1,43 @@
+package restapi
+
+import (
+	"errors"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//ManualServiceConfigResourcePath path to manual service config resource of Instana RESTful API
+const ManualServiceConfigResourcePath = ApplicationMonitoringSettingsBasePath + "/manual-service"
+
+//ManualServiceConfig represents the REST resource of a manual service config at Instana. Calls matching the tag filter
+//expression are mapped either to an existing service or to a new service of an unmonitored (uninstrumented) component
+type ManualServiceConfig struct {
+	ID                     string      `json:"id,omitempty"`
+	Description            *string     `json:"description,omitempty"`
+	Enabled                bool        `json:"enabled"`
+	ExistingServiceID      *string     `json:"existingServiceId,omitempty"`
+	UnmonitoredServiceName *string     `json:"unmonitoredServiceName,omitempty"`
+	TagFilterExpression    interface{} `json:"tagFilterExpression"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ManualServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not
+//validated as it is generated by Instana when the manual service config is created
+func (c *ManualServiceConfig) Validate() error {
+	if c.TagFilterExpression == nil {
+		return errors.New("tag filter expression is missing")
+	}
+	if err := c.TagFilterExpression.(TagFilterExpressionElement).Validate(); err != nil {
+		return err
+	}
+	hasExistingServiceID := c.ExistingServiceID != nil && !utils.IsBlank(*c.ExistingServiceID)
+	hasUnmonitoredServiceName := c.UnmonitoredServiceName != nil && !utils.IsBlank(*c.UnmonitoredServiceName)
+	if hasExistingServiceID == hasUnmonitoredServiceName {
+		return errors.New("exactly one of existing service id or unmonitored service name must be provided")
+	}
+	return nil
+}
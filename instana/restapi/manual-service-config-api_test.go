@@ -0,0 +1,90 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	manualServiceConfigID                     = "manual-service-config-id"
+	manualServiceConfigUnmonitoredServiceName = "my-database"
+	manualServiceConfigExistingServiceID      = "existing-service-id"
+)
+
+func TestShouldSuccessfullyValidateManualServiceConfigWithUnmonitoredServiceName(t *testing.T) {
+	config := createValidManualServiceConfig()
+
+	require.Equal(t, manualServiceConfigID, config.GetIDForResourcePath())
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldSuccessfullyValidateManualServiceConfigWithExistingServiceID(t *testing.T) {
+	config := createValidManualServiceConfig()
+	config.UnmonitoredServiceName = nil
+	config.ExistingServiceID = utils.StringPtr(manualServiceConfigExistingServiceID)
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldSuccessfullyValidateManualServiceConfigWithoutID(t *testing.T) {
+	config := createValidManualServiceConfig()
+	config.ID = ""
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldFailToValidateManualServiceConfigWhenTagFilterExpressionIsMissing(t *testing.T) {
+	config := createValidManualServiceConfig()
+	config.TagFilterExpression = nil
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Equal(t, "tag filter expression is missing", err.Error())
+}
+
+func TestShouldFailToValidateManualServiceConfigWhenTagFilterExpressionIsNotValid(t *testing.T) {
+	config := createValidManualServiceConfig()
+	config.TagFilterExpression = NewLogicalAndTagFilter([]TagFilterExpressionElement{})
+
+	require.Error(t, config.Validate())
+}
+
+func TestShouldFailToValidateManualServiceConfigWhenServiceIsNotDefinedExactlyOnce(t *testing.T) {
+	testCases := map[string]func(c *ManualServiceConfig){
+		"neither existing service id nor unmonitored service name": func(c *ManualServiceConfig) {
+			c.UnmonitoredServiceName = nil
+		},
+		"blank unmonitored service name": func(c *ManualServiceConfig) {
+			c.UnmonitoredServiceName = utils.StringPtr(" ")
+		},
+		"existing service id and unmonitored service name": func(c *ManualServiceConfig) {
+			c.ExistingServiceID = utils.StringPtr(manualServiceConfigExistingServiceID)
+		},
+	}
+
+	for name, modifier := range testCases {
+		t.Run("Should fail when "+name+" is provided", func(t *testing.T) {
+			config := createValidManualServiceConfig()
+			modifier(config)
+
+			err := config.Validate()
+
+			require.Error(t, err)
+			require.Equal(t, "exactly one of existing service id or unmonitored service name must be provided", err.Error())
+		})
+	}
+}
+
+func createValidManualServiceConfig() *ManualServiceConfig {
+	return &ManualServiceConfig{
+		ID:                     manualServiceConfigID,
+		Description:            utils.StringPtr("description"),
+		Enabled:                true,
+		UnmonitoredServiceName: utils.StringPtr(manualServiceConfigUnmonitoredServiceName),
+		TagFilterExpression:    NewStringTagFilter(TagFilterEntityDestination, "call.database.connection", EqualsOperator, "jdbc:mysql://db:3306"),
+	}
+}
@@ -0,0 +1,97 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+)
+
+//NewManualServiceConfigRestResource creates a new REST resource for manual service configs. The Instana API does not
+//provide an endpoint to read a single manual service config. Therefore, single configs are read from the list of all
+//manual service configs. The bulk PUT endpoint of the Instana API is not used because it replaces all manual service
+//configs including the ones which are not managed by terraform.
+func NewManualServiceConfigRestResource(unmarshaller JSONUnmarshaller, client RestClient) RestResource {
+	return &manualServiceConfigRestResource{
+		resourcePath: ManualServiceConfigResourcePath,
+		unmarshaller: unmarshaller,
+		client:       client,
+	}
+}
+
+type manualServiceConfigRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller
+	client       RestClient
+}
+
+func (r *manualServiceConfigRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalArray(data, r.unmarshaller)
+}
+
+func (r *manualServiceConfigRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	configs, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.GetIDForResourcePath() == id {
+			if err := config.Validate(); err != nil {
+				return config, err
+			}
+			return config, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
+
+func (r *manualServiceConfigRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	response, err := r.client.Post(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+func (r *manualServiceConfigRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	response, err := r.client.Put(ctx, data, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+func (r *manualServiceConfigRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
+	object, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dataObject, ok := object.(InstanaDataObject)
+	if !ok {
+		return dataObject, errors.New("unmarshalled object does not implement InstanaDataObject")
+	}
+
+	if err := dataObject.Validate(); err != nil {
+		return dataObject, err
+	}
+	if len(dataObject.GetIDForResourcePath()) == 0 {
+		return dataObject, errors.New("id of manual service config is missing in response")
+	}
+	return dataObject, nil
+}
+
+func (r *manualServiceConfigRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *manualServiceConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
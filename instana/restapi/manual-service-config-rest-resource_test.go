@@ -0,0 +1,189 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnManualServiceConfigFromListWhenExecutingGetOneOperationOfManualServiceConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	other := createValidManualServiceConfig()
+	other.ID = "other-id"
+	response, _ := json.Marshal([]*ManualServiceConfig{other, config})
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).GetOne(context.Background(), manualServiceConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnEntityNotFoundWhenManualServiceConfigIsNotContainedInListOfManualServiceConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	other := createValidManualServiceConfig()
+	other.ID = "other-id"
+	response, _ := json.Marshal([]*ManualServiceConfig{other})
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).GetOne(context.Background(), manualServiceConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOneOperationOfManualServiceConfigRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).GetOne(context.Background(), manualServiceConfigID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenManualServiceConfigReturnedByGetOneOperationIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	config.UnmonitoredServiceName = nil
+	response, _ := json.Marshal([]*ManualServiceConfig{config})
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).GetOne(context.Background(), manualServiceConfigID)
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnAllManualServiceConfigsWhenExecutingGetAllOperationOfManualServiceConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	response, _ := json.Marshal([]*ManualServiceConfig{config})
+
+	client.EXPECT().Get(gomock.Any(), ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{config}, result)
+}
+
+func TestShouldCreateManualServiceConfigViaPostAndReturnConfigWithIDGeneratedByInstana(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	config.ID = ""
+	createdConfig := createValidManualServiceConfig()
+	response, _ := json.Marshal(createdConfig)
+
+	client.EXPECT().Post(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, createdConfig, result)
+}
+
+func TestShouldFailToCreateManualServiceConfigWhenResponseDoesNotContainAnID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	config.ID = ""
+	response, _ := json.Marshal(config)
+
+	client.EXPECT().Post(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).Create(context.Background(), config)
+
+	require.Error(t, err)
+	require.Equal(t, "id of manual service config is missing in response", err.Error())
+}
+
+func TestShouldUpdateManualServiceConfigViaPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	response, _ := json.Marshal(config)
+
+	client.EXPECT().Put(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldNotCallInstanaAPIWhenCreatingOrUpdatingInvalidManualServiceConfig(t *testing.T) {
+	for name, operation := range map[string]func(RestResource, context.Context, InstanaDataObject) (InstanaDataObject, error){
+		"create": RestResource.Create,
+		"update": RestResource.Update,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockRestClient(ctrl)
+			config := createValidManualServiceConfig()
+			config.TagFilterExpression = nil
+
+			client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := operation(NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client), context.Background(), config)
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldReturnErrorWhenCreateOrUpdateOfManualServiceConfigFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+	expectedError := errors.New("test")
+	sut := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client)
+
+	client.EXPECT().Post(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Put(gomock.Any(), config, ManualServiceConfigResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.Create(context.Background(), config)
+	require.Equal(t, expectedError, err)
+
+	_, err = sut.Update(context.Background(), config)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteManualServiceConfigByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidManualServiceConfig()
+
+	client.EXPECT().Delete(gomock.Any(), manualServiceConfigID, ManualServiceConfigResourcePath).Times(1).Return(nil)
+
+	err := NewManualServiceConfigRestResource(NewManualServiceConfigUnmarshaller(), client).Delete(context.Background(), config)
+
+	require.NoError(t, err)
+}
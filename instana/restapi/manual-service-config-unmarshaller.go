@@ -0,0 +1,34 @@
+package restapi
+
+import (
+	"encoding/json"
+)
+
+//NewManualServiceConfigUnmarshaller creates a new Unmarshaller instance for manual service configs
+func NewManualServiceConfigUnmarshaller() JSONUnmarshaller {
+	return &manualServiceConfigUnmarshaller{
+		tagFilterUnmarshaller: NewTagFilterUnmarshaller(),
+	}
+}
+
+type manualServiceConfigUnmarshaller struct {
+	tagFilterUnmarshaller TagFilterUnmarshaller
+}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *manualServiceConfigUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
+	var rawTagFilterExpression json.RawMessage
+	temp := &ManualServiceConfig{
+		TagFilterExpression: &rawTagFilterExpression,
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return &ManualServiceConfig{}, err
+	}
+
+	tagFilter, err := u.tagFilterUnmarshaller.Unmarshal(rawTagFilterExpression)
+	if err != nil {
+		return &ManualServiceConfig{}, err
+	}
+	temp.TagFilterExpression = tagFilter
+	return temp, nil
+}
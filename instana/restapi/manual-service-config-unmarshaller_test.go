@@ -0,0 +1,52 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldSuccessfullyUnmarshalManualServiceConfig(t *testing.T) {
+	config := createValidManualServiceConfig()
+
+	serializedJSON, _ := json.Marshal(config)
+
+	result, err := NewManualServiceConfigUnmarshaller().Unmarshal(serializedJSON)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToUnmarshalManualServiceConfigWhenTagFilterExpressionIsNotValid(t *testing.T) {
+	response := `{"id":"id","tagFilterExpression":{"type":"INVALID"}}`
+
+	_, err := NewManualServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUnmarshalManualServiceConfigWhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewManualServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnEmptyManualServiceConfigWhenNoFieldOfResponseMatchesToModel(t *testing.T) {
+	response := `{"foo" : "bar"}`
+	config, err := NewManualServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	require.NoError(t, err)
+	require.Equal(t, &ManualServiceConfig{}, config)
+}
+
+func TestShouldFailToUnmarshalManualServiceConfigWhenResponseIsNotAValidJson(t *testing.T) {
+	response := `Invalid Data`
+
+	_, err := NewManualServiceConfigUnmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+}
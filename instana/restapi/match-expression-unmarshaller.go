@@ -0,0 +1,75 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+//MatchExpressionUnmarshaller interface for the unmarshaller for MatchExpressions
+type MatchExpressionUnmarshaller interface {
+	Unmarshal(raw json.RawMessage) (MatchExpression, error)
+}
+
+//NewMatchExpressionUnmarshaller creates a new instance of MatchExpressionUnmarshaller
+func NewMatchExpressionUnmarshaller() MatchExpressionUnmarshaller {
+	return &matchExpressionUnmarshaller{}
+}
+
+type matchExpressionUnmarshaller struct{}
+
+func (u *matchExpressionUnmarshaller) Unmarshal(raw json.RawMessage) (MatchExpression, error) {
+	return u.unmarshalMatchSpecification(raw)
+}
+
+func (u *matchExpressionUnmarshaller) unmarshalMatchSpecification(raw json.RawMessage) (MatchExpression, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	temp := struct {
+		Dtype MatchExpressionType `json:"type"`
+	}{}
+
+	if err := json.Unmarshal(raw, &temp); err != nil {
+		return nil, err
+	}
+
+	if temp.Dtype == BinaryOperatorExpressionType {
+		return u.unmarshalBinaryOperator(raw)
+	} else if temp.Dtype == LeafExpressionType {
+		return u.unmarshalTagMatcherExpression(raw)
+	} else {
+		return nil, errors.New("invalid expression type")
+	}
+}
+
+func (u *matchExpressionUnmarshaller) unmarshalBinaryOperator(raw json.RawMessage) (*BinaryOperator, error) {
+	var leftRaw json.RawMessage
+	var rightRaw json.RawMessage
+	temp := BinaryOperator{
+		Left:  &leftRaw,
+		Right: &rightRaw,
+	}
+
+	json.Unmarshal(raw, &temp) //cannot fail as already successfully unmarshalled in unmarshalMatchSpecification
+	left, err := u.unmarshalMatchSpecification(leftRaw)
+	if err != nil {
+		return &BinaryOperator{}, err
+	}
+
+	right, err := u.unmarshalMatchSpecification(rightRaw)
+	if err != nil {
+		return &BinaryOperator{}, err
+	}
+	return &BinaryOperator{
+		Dtype:       temp.Dtype,
+		Left:        left,
+		Right:       right,
+		Conjunction: temp.Conjunction,
+	}, nil
+}
+
+func (u *matchExpressionUnmarshaller) unmarshalTagMatcherExpression(raw json.RawMessage) (*TagMatcherExpression, error) {
+	data := TagMatcherExpression{}
+	json.Unmarshal(raw, &data) //cannot fail as already successfully unmarshalled in unmarshalMatchSpecification
+	return &data, nil
+}
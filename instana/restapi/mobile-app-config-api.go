@@ -0,0 +1,25 @@
+package restapi
+
+import "errors"
+
+//MobileAppConfigResourcePath path to mobile app monitoring config resource of Instana RESTful API
+const MobileAppConfigResourcePath = MobileAppMonitoringResourcePath + "/config"
+
+//MobileAppConfig data structure of a Mobile App Monitoring Configuration of the Instana API
+type MobileAppConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+//GetIDForResourcePath implemention of the interface InstanaDataObject
+func (r *MobileAppConfig) GetIDForResourcePath() string {
+	return r.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (r *MobileAppConfig) Validate() error {
+	if len(r.Name) == 0 {
+		return errors.New("Name is missing")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mobileAppConfigID   = "mobile-app-id"
+	mobileAppConfigName = "mobile-app-name"
+)
+
+func TestShouldSuccessfullyValidateMobileAppConfigWithNameOnlyForCreateUseCase(t *testing.T) {
+	sut := MobileAppConfig{Name: mobileAppConfigName}
+
+	err := sut.Validate()
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfigName, sut.Name)
+}
+
+func TestShouldSuccessfullyValidateFullMobileAppConfigAsServerResponseForCreateOrUpdateOperation(t *testing.T) {
+	sut := MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: mobileAppConfigName,
+	}
+
+	err := sut.Validate()
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfigID, sut.ID)
+	require.Equal(t, mobileAppConfigID, sut.GetIDForResourcePath())
+	require.Equal(t, mobileAppConfigName, sut.Name)
+}
+
+func TestShouldFailToValidateMobileAppConfigWhenNameIsNotProvided(t *testing.T) {
+	sut := MobileAppConfig{}
+
+	err := sut.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Name is missing")
+}
@@ -0,0 +1,39 @@
+package restapi
+
+import "context"
+
+//NewMobileAppConfigRestResource creates a new REST for the mobile app monitoring config
+func NewMobileAppConfigRestResource(unmarshaller JSONUnmarshaller, client RestClient) RestResource {
+	return &mobileAppConfigRestResource{
+		monitoringConfigRestResource: &monitoringConfigRestResource{
+			resourcePath: MobileAppConfigResourcePath,
+			unmarshaller: unmarshaller,
+			client:       client,
+			nameOf: func(data InstanaDataObject) string {
+				return data.(*MobileAppConfig).Name
+			},
+		},
+	}
+}
+
+//mobileAppConfigRestResource REST resource for mobile app monitoring configurations. The Instana API does not provide an
+//endpoint to get a single mobile app config. Therefore, the mobile app config is looked up in the list of all configs
+type mobileAppConfigRestResource struct {
+	*monitoringConfigRestResource
+}
+
+func (r *mobileAppConfigRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	configs, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range *configs {
+		if config.GetIDForResourcePath() == id {
+			if err := config.Validate(); err != nil {
+				return config, err
+			}
+			return config, nil
+		}
+	}
+	return nil, ErrEntityNotFound
+}
@@ -0,0 +1,185 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var mobileAppConfigSerialized = []byte("serialized-mobile-app")
+var mobileAppNameQueryParameter = map[string]string{"name": mobileAppConfigName}
+
+func makeTestMobileAppConfig() *MobileAppConfig {
+	return &MobileAppConfig{
+		ID:   mobileAppConfigID,
+		Name: mobileAppConfigName,
+	}
+}
+
+func TestShouldSuccessfullyExecuteGetAllOperationOfMobileAppConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	mobileAppConfig := makeTestMobileAppConfig()
+	serializedElement := []byte("{\"id\":\"" + mobileAppConfigID + "\"}")
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return([]byte("["+string(serializedElement)+"]"), nil)
+	unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedElement)).Times(1).Return(mobileAppConfig, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{mobileAppConfig}, result)
+}
+
+func TestShouldSuccessfullyExecuteGetOperationOfMobileAppConfigRestResourceFromListOfAllConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	mobileAppConfig := makeTestMobileAppConfig()
+	otherMobileAppConfig := &MobileAppConfig{ID: "other-id", Name: "other-name"}
+	serializedElement := []byte("{\"id\":\"" + mobileAppConfigID + "\"}")
+	serializedOtherElement := []byte("{\"id\":\"other-id\"}")
+
+	client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return([]byte("["+string(serializedOtherElement)+","+string(serializedElement)+"]"), nil)
+	unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedOtherElement)).Times(1).Return(otherMobileAppConfig, nil)
+	unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedElement)).Times(1).Return(mobileAppConfig, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfig, result)
+}
+
+func TestShouldReturnNotFoundErrorWhenMobileAppConfigIsNotContainedInListOfAllConfigs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	serializedOtherElement := []byte("{\"id\":\"other-id\"}")
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return([]byte("["+string(serializedOtherElement)+"]"), nil)
+	unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedOtherElement)).Times(1).Return(&MobileAppConfig{ID: "other-id", Name: "other-name"}, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnErrorWhenListOfMobileAppConfigsCannotBeRetrievedForGetOperation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), MobileAppConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetOne(context.Background(), mobileAppConfigID)
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldSuccessfullyExecuteCreateOperationOfMobileAppConfigRestResourceUsingNameQueryParameter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	mobileAppConfig := makeTestMobileAppConfig()
+
+	client.EXPECT().PostByQuery(gomock.Any(), MobileAppConfigResourcePath, mobileAppNameQueryParameter).Times(1).Return(mobileAppConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(mobileAppConfig, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Create(context.Background(), mobileAppConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfig, result)
+}
+
+func TestShouldReturnErrorWhenExecutingCreateOperationOfMobileAppConfigRestResourceAndProvidedObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+
+	client.EXPECT().PostByQuery(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Create(context.Background(), &MobileAppConfig{})
+
+	require.Error(t, err)
+	require.Contains(t, nameIsMissingError, err.Error())
+}
+
+func TestShouldSuccessfullyExecuteUpdateOperationOfMobileAppConfigRestResourceUsingNameQueryParameter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	mobileAppConfig := makeTestMobileAppConfig()
+
+	client.EXPECT().PutByQuery(gomock.Any(), MobileAppConfigResourcePath, mobileAppConfigID, mobileAppNameQueryParameter).Times(1).Return(mobileAppConfigSerialized, nil)
+	unmarshaller.EXPECT().Unmarshal(mobileAppConfigSerialized).Times(1).Return(mobileAppConfig, nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	result, err := sut.Update(context.Background(), mobileAppConfig)
+
+	require.NoError(t, err)
+	require.Equal(t, mobileAppConfig, result)
+}
+
+func TestShouldReturnErrorWhenExecutingUpdateOperationOfMobileAppConfigRestResourceAndPutFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	mobileAppConfig := makeTestMobileAppConfig()
+	expectedError := errors.New("Error")
+
+	client.EXPECT().PutByQuery(gomock.Any(), MobileAppConfigResourcePath, mobileAppConfigID, mobileAppNameQueryParameter).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	_, err := sut.Update(context.Background(), mobileAppConfig)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldSuccessfullyExecuteDeleteOperationOfMobileAppConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), mobileAppConfigID, MobileAppConfigResourcePath).Times(1).Return(nil)
+
+	sut := NewMobileAppConfigRestResource(unmarshaller, client)
+
+	err := sut.Delete(context.Background(), makeTestMobileAppConfig())
+
+	require.NoError(t, err)
+}
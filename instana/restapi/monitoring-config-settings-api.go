@@ -0,0 +1,11 @@
+package restapi
+
+//MonitoringConfigSettings interface of settings of website and mobile app monitoring configs. The settings are
+//available below the monitoring config and the ID of the settings is the ID of the monitoring config
+type MonitoringConfigSettings interface {
+	InstanaDataObject
+	//Defaults returns the default settings of Instana for the same monitoring config. The defaults are applied when the settings are deleted
+	Defaults() MonitoringConfigSettings
+	//KeepUnmanagedSettings takes over the settings which are not managed by the provider from the given current settings
+	KeepUnmanagedSettings(current MonitoringConfigSettings)
+}
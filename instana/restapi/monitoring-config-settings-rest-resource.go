@@ -0,0 +1,95 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+//NewMonitoringConfigSettingsRestResource creates a new REST resource for JSON based settings of website or mobile app
+//monitoring configs. The settings are available at the given path element below the monitoring config, e.g.
+///api/website-monitoring/config/{websiteId}/ip-masking. The ID of the settings is the ID of the monitoring config.
+//Create and update are implemented as HTTP PUT. As the settings cannot be deleted, delete applies the defaults of Instana.
+func NewMonitoringConfigSettingsRestResource(configResourcePath string, pathElement string, newSettings func(monitoringConfigID string) MonitoringConfigSettings, client RestClient) RestResource {
+	return &monitoringConfigSettingsRestResource{
+		configResourcePath: configResourcePath,
+		pathElement:        pathElement,
+		newSettings:        newSettings,
+		client:             client,
+	}
+}
+
+type monitoringConfigSettingsRestResource struct {
+	configResourcePath string
+	pathElement        string
+	newSettings        func(monitoringConfigID string) MonitoringConfigSettings
+	client             RestClient
+}
+
+func (r *monitoringConfigSettingsRestResource) GetAll(_ context.Context) (*[]InstanaDataObject, error) {
+	return nil, errors.New("settings of monitoring configs can only be requested for a single monitoring config")
+}
+
+func (r *monitoringConfigSettingsRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	return r.getSettings(ctx, id)
+}
+
+func (r *monitoringConfigSettingsRestResource) getSettings(ctx context.Context, id string) (MonitoringConfigSettings, error) {
+	data, err := r.client.Get(ctx, r.buildResourcePath(id))
+	if err != nil {
+		return nil, err
+	}
+	return r.validateResponseAndConvertToStruct(id, data)
+}
+
+func (r *monitoringConfigSettingsRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Update(ctx, data)
+}
+
+//Update applies the settings. Settings which are not managed by the provider are taken over from the current settings
+func (r *monitoringConfigSettingsRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	settings := data.(MonitoringConfigSettings)
+	current, err := r.getSettings(ctx, settings.GetIDForResourcePath())
+	if err != nil {
+		return data, err
+	}
+	settings.KeepUnmanagedSettings(current)
+
+	response, err := r.client.PutWithoutID(ctx, settings, r.buildResourcePath(settings.GetIDForResourcePath()))
+	if err != nil {
+		return data, err
+	}
+	if len(response) == 0 {
+		return r.GetOne(ctx, settings.GetIDForResourcePath())
+	}
+	return r.validateResponseAndConvertToStruct(settings.GetIDForResourcePath(), response)
+}
+
+func (r *monitoringConfigSettingsRestResource) validateResponseAndConvertToStruct(id string, data []byte) (MonitoringConfigSettings, error) {
+	settings := r.newSettings(id)
+	if err := json.Unmarshal(data, settings); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	if err := settings.Validate(); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+func (r *monitoringConfigSettingsRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID resets the settings of the monitoring config with the given ID to the defaults of Instana
+func (r *monitoringConfigSettingsRestResource) DeleteByID(ctx context.Context, id string) error {
+	_, err := r.Update(ctx, r.newSettings(id).Defaults())
+	return err
+}
+
+func (r *monitoringConfigSettingsRestResource) buildResourcePath(id string) string {
+	return fmt.Sprintf("%s/%s/%s", r.configResourcePath, id, r.pathElement)
+}
@@ -0,0 +1,167 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGeoLocationConfigurationPath = WebsiteMonitoringConfigResourcePath + "/" + testMonitoringConfigID + "/" + GeoLocationConfigurationPathElement
+	testGeoMappingRulesJSON          = `[{"cidr":"10.0.0.0/8","subdivisions":[]}]`
+)
+
+func createGeoLocationConfigurationRestResourceForTest(client RestClient) RestResource {
+	return NewMonitoringConfigSettingsRestResource(WebsiteMonitoringConfigResourcePath, GeoLocationConfigurationPathElement, func(id string) MonitoringConfigSettings {
+		return &GeoLocationConfiguration{MonitoringConfigID: id}
+	}, client)
+}
+
+func TestShouldReadMonitoringConfigSettingsOfTheGivenMonitoringConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_CITY","geoMappingRules":[]}`), nil)
+
+	result, err := createGeoLocationConfigurationRestResourceForTest(client).GetOne(context.Background(), testMonitoringConfigID)
+
+	require.NoError(t, err)
+	require.Equal(t, &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveCity, GeoMappingRules: json.RawMessage(`[]`)}, result)
+}
+
+func TestShouldFailToReadMonitoringConfigSettingsWhenRestClientReturnsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return(nil, ErrEntityNotFound)
+
+	_, err := createGeoLocationConfigurationRestResourceForTest(client).GetOne(context.Background(), testMonitoringConfigID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldFailToReadMonitoringConfigSettingsWhenResponseIsNotValid(t *testing.T) {
+	for _, response := range []string{"invalid", `{"geoDetailRemoval":"INVALID"}`} {
+		ctrl := gomock.NewController(t)
+		client := mocks.NewMockRestClient(ctrl)
+
+		client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(response), nil)
+
+		_, err := createGeoLocationConfigurationRestResourceForTest(client).GetOne(context.Background(), testMonitoringConfigID)
+
+		require.Error(t, err)
+		ctrl.Finish()
+	}
+}
+
+func TestShouldNotSupportToReadAllMonitoringConfigSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	_, err := createGeoLocationConfigurationRestResourceForTest(client).GetAll(context.Background())
+
+	require.Error(t, err)
+}
+
+func TestShouldUpdateMonitoringConfigSettingsAndKeepUnmanagedSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll}
+	expectedRequest := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll, GeoMappingRules: json.RawMessage(testGeoMappingRulesJSON)}
+	response := `{"geoDetailRemoval":"REMOVE_ALL","geoMappingRules":` + testGeoMappingRulesJSON + `}`
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"NO_REMOVAL","geoMappingRules":`+testGeoMappingRulesJSON+`}`), nil),
+		client.EXPECT().PutWithoutID(gomock.Any(), expectedRequest, testGeoLocationConfigurationPath).Times(1).Return([]byte(response), nil),
+	)
+
+	result, err := createGeoLocationConfigurationRestResourceForTest(client).Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedRequest, result)
+}
+
+func TestShouldReadMonitoringConfigSettingsAfterUpdateWhenResponseIsEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll}
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"NO_REMOVAL"}`), nil),
+		client.EXPECT().PutWithoutID(gomock.Any(), config, testGeoLocationConfigurationPath).Times(1).Return([]byte{}, nil),
+		client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_ALL"}`), nil),
+	)
+
+	result, err := createGeoLocationConfigurationRestResourceForTest(client).Update(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldFailToUpdateMonitoringConfigSettingsWhenSettingsAreNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := createGeoLocationConfigurationRestResourceForTest(client).Update(context.Background(), &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUpdateMonitoringConfigSettingsWhenCurrentSettingsCannotBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return(nil, expectedError)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := createGeoLocationConfigurationRestResourceForTest(client).Update(context.Background(), &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToUpdateMonitoringConfigSettingsWhenPutRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"NO_REMOVAL"}`), nil)
+	client.EXPECT().PutWithoutID(gomock.Any(), gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return(nil, expectedError)
+
+	_, err := createGeoLocationConfigurationRestResourceForTest(client).Update(context.Background(), &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll})
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldResetMonitoringConfigSettingsToDefaultsWhenDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedRequest := &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalNoRemoval, GeoMappingRules: json.RawMessage(testGeoMappingRulesJSON)}
+
+	gomock.InOrder(
+		client.EXPECT().Get(gomock.Any(), testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"REMOVE_ALL","geoMappingRules":`+testGeoMappingRulesJSON+`}`), nil),
+		client.EXPECT().PutWithoutID(gomock.Any(), expectedRequest, testGeoLocationConfigurationPath).Times(1).Return([]byte(`{"geoDetailRemoval":"NO_REMOVAL"}`), nil),
+	)
+
+	err := createGeoLocationConfigurationRestResourceForTest(client).Delete(context.Background(), &GeoLocationConfiguration{MonitoringConfigID: testMonitoringConfigID, GeoDetailRemoval: GeoDetailRemovalRemoveAll})
+
+	require.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package restapi
 
 import (
+	"context"
 	"reflect"
 )
 
@@ -21,8 +22,8 @@ type readOnlyRestResource struct {
 	client             RestClient
 }
 
-func (i *readOnlyRestResource) GetAll() (*[]InstanaDataObject, error) {
-	data, err := i.client.Get(i.resourcePath)
+func (i *readOnlyRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := i.client.Get(ctx, i.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -41,8 +42,8 @@ func (i *readOnlyRestResource) GetAll() (*[]InstanaDataObject, error) {
 	return &result, nil
 }
 
-func (i *readOnlyRestResource) GetOne(id string) (InstanaDataObject, error) {
-	data, err := i.client.GetOne(id, i.resourcePath)
+func (i *readOnlyRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := i.client.GetOne(ctx, id, i.resourcePath)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package restapi_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -43,14 +44,14 @@ func TestShouldSuccessfullyGetAllObjects(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(testResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), testResourcePath).Times(1).Return(restResponseData, nil)
 
 	arrayJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	arrayJSONUnmarshaller.EXPECT().Unmarshal(restResponseData).Times(1).Return(&serverResponse, nil)
 
 	sut := NewReadOnlyRestResource(testResourcePath, nil, arrayJSONUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &expectedResult, result)
@@ -63,14 +64,14 @@ func TestShouldReturnEmptySliceWhenNoDataIsReturned(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(testResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), testResourcePath).Times(1).Return(restResponseData, nil)
 
 	arrayJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	arrayJSONUnmarshaller.EXPECT().Unmarshal(restResponseData).Times(1).Return(&[]TestInstanaDataObject{}, nil)
 
 	sut := NewReadOnlyRestResource(testResourcePath, nil, arrayJSONUnmarshaller, restClient)
 
-	result, err := sut.GetAll()
+	result, err := sut.GetAll(context.Background())
 
 	require.NoError(t, err)
 	require.Equal(t, &[]InstanaDataObject{}, result)
@@ -83,14 +84,14 @@ func TestShouldFailToGetAllWhenClientReturnsError(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(testResourcePath).Times(1).Return(nil, expectedError)
+	restClient.EXPECT().Get(gomock.Any(), testResourcePath).Times(1).Return(nil, expectedError)
 
 	arrayJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	arrayJSONUnmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewReadOnlyRestResource(testResourcePath, nil, arrayJSONUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -104,14 +105,14 @@ func TestShouldFailToGetAllWhenRestResultCannotBeUnmarshalled(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().Get(testResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().Get(gomock.Any(), testResourcePath).Times(1).Return(restResponseData, nil)
 
 	arrayJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	arrayJSONUnmarshaller.EXPECT().Unmarshal(restResponseData).Times(1).Return(nil, expectedError)
 
 	sut := NewReadOnlyRestResource(testResourcePath, nil, arrayJSONUnmarshaller, restClient)
 
-	_, err := sut.GetAll()
+	_, err := sut.GetAll(context.Background())
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -131,14 +132,14 @@ func TestShouldSuccessfullyGetObjectById(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().GetOne(id, testResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().GetOne(gomock.Any(), id, testResourcePath).Times(1).Return(restResponseData, nil)
 
 	objectJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	objectJSONUnmarshaller.EXPECT().Unmarshal(restResponseData).Times(1).Return(&expectedResult, nil)
 
 	sut := NewReadOnlyRestResource(testResourcePath, objectJSONUnmarshaller, nil, restClient)
 
-	result, err := sut.GetOne(id)
+	result, err := sut.GetOne(context.Background(), id)
 
 	require.NoError(t, err)
 	require.Equal(t, expectedResult, result)
@@ -152,14 +153,14 @@ func TestShouldFailToGetObjectByIdWhenRestClientResturnsError(t *testing.T) {
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().GetOne(id, testResourcePath).Times(1).Return(nil, expectedError)
+	restClient.EXPECT().GetOne(gomock.Any(), id, testResourcePath).Times(1).Return(nil, expectedError)
 
 	objectJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	objectJSONUnmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewReadOnlyRestResource(testResourcePath, objectJSONUnmarshaller, nil, restClient)
 
-	_, err := sut.GetOne(id)
+	_, err := sut.GetOne(context.Background(), id)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -174,14 +175,14 @@ func TestShouldFailToGetObjectByIdWhenRestResultCannotBeUnmarshalled(t *testing.
 	defer ctrl.Finish()
 
 	restClient := mocks.NewMockRestClient(ctrl)
-	restClient.EXPECT().GetOne(id, testResourcePath).Times(1).Return(restResponseData, nil)
+	restClient.EXPECT().GetOne(gomock.Any(), id, testResourcePath).Times(1).Return(restResponseData, nil)
 
 	objectJSONUnmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	objectJSONUnmarshaller.EXPECT().Unmarshal(restResponseData).Times(1).Return(nil, expectedError)
 
 	sut := NewReadOnlyRestResource(testResourcePath, objectJSONUnmarshaller, nil, restClient)
 
-	_, err := sut.GetOne(id)
+	_, err := sut.GetOne(context.Background(), id)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -0,0 +1,152 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+//NewReleaseRestResource creates a new REST resource for releases. Applications are referenced by ID in terraform but by
+//name in the create and update requests of the Instana API. Therefore, the referenced applications are looked up before
+//a release is created or updated. This also ensures that all referenced applications exist.
+func NewReleaseRestResource(client RestClient) RestResource {
+	return &releaseRestResource{
+		resourcePath: ReleasesResourcePath,
+		unmarshaller: NewDefaultJSONUnmarshaller(&Release{}),
+		client:       client,
+	}
+}
+
+type releaseRestResource struct {
+	resourcePath string
+	unmarshaller JSONUnmarshaller
+	client       RestClient
+}
+
+func (r *releaseRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalArray(data, r.unmarshaller)
+}
+
+func (r *releaseRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.validateResponseAndConvertToStruct(data)
+}
+
+func (r *releaseRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.upsert(ctx, data, r.client.Post)
+}
+
+func (r *releaseRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.upsert(ctx, data, r.client.Put)
+}
+
+func (r *releaseRestResource) upsert(ctx context.Context, data InstanaDataObject, operation restClientOperation) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	release, err := r.resolveApplicationNames(ctx, data.(*Release))
+	if err != nil {
+		return data, err
+	}
+	response, err := operation(ctx, release, r.resourcePath)
+	if err != nil {
+		return data, err
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+//resolveApplicationNames returns a copy of the given release where the names of all referenced applications are set
+func (r *releaseRestResource) resolveApplicationNames(ctx context.Context, release *Release) (*Release, error) {
+	names := make(map[string]string)
+	resolve := func(applications []ReleaseApplication) ([]ReleaseApplication, error) {
+		result := make([]ReleaseApplication, len(applications))
+		for i, application := range applications {
+			name, ok := names[application.ID]
+			if !ok {
+				var err error
+				name, err = r.lookupApplicationName(ctx, application.ID)
+				if err != nil {
+					return nil, err
+				}
+				names[application.ID] = name
+			}
+			result[i] = ReleaseApplication{ID: application.ID, Name: name}
+		}
+		return result, nil
+	}
+
+	applications, err := resolve(release.Applications)
+	if err != nil {
+		return nil, err
+	}
+	services := make([]ReleaseService, len(release.Services))
+	for i, service := range release.Services {
+		services[i] = ReleaseService{Name: service.Name}
+		if service.ScopedTo != nil {
+			scopedApplications, err := resolve(service.ScopedTo.Applications)
+			if err != nil {
+				return nil, err
+			}
+			services[i].ScopedTo = &ReleaseServiceScope{Applications: scopedApplications}
+		}
+	}
+	return &Release{
+		ID:           release.ID,
+		Name:         release.Name,
+		Start:        release.Start,
+		Applications: applications,
+		Services:     services,
+	}, nil
+}
+
+func (r *releaseRestResource) lookupApplicationName(ctx context.Context, applicationID string) (string, error) {
+	data, err := r.client.GetOne(ctx, applicationID, ApplicationConfigsResourcePath)
+	if err != nil {
+		if errors.Is(err, ErrEntityNotFound) {
+			return "", fmt.Errorf("application %s referenced by the release does not exist", applicationID)
+		}
+		return "", err
+	}
+	application := struct {
+		Label string `json:"label"`
+	}{}
+	if err := json.Unmarshal(data, &application); err != nil {
+		return "", fmt.Errorf("failed to parse json; %s", err)
+	}
+	return application.Label, nil
+}
+
+func (r *releaseRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
+	object, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dataObject, ok := object.(InstanaDataObject)
+	if !ok {
+		return dataObject, errors.New("unmarshalled object does not implement InstanaDataObject")
+	}
+
+	if err := dataObject.Validate(); err != nil {
+		return dataObject, err
+	}
+	if len(dataObject.GetIDForResourcePath()) == 0 {
+		return dataObject, errors.New("id of release is missing in response")
+	}
+	return dataObject, nil
+}
+
+func (r *releaseRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+func (r *releaseRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
+}
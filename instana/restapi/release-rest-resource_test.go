@@ -0,0 +1,222 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	releaseApplicationLabel    = "release-application-label"
+	releaseApplicationResponse = `{ "id" : "release-application-id", "label" : "release-application-label" }`
+)
+
+func TestShouldReturnReleaseWhenExecutingGetOneOperationOfReleaseRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createReleaseWithResolvedApplicationNames()
+	response, _ := json.Marshal(release)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseID, ReleasesResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewReleaseRestResource(client).GetOne(context.Background(), releaseID)
+
+	require.NoError(t, err)
+	require.Equal(t, release, result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOneOperationOfReleaseRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseID, ReleasesResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+
+	_, err := NewReleaseRestResource(client).GetOne(context.Background(), releaseID)
+
+	require.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestShouldReturnAllReleasesWhenExecutingGetAllOperationOfReleaseRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createReleaseWithResolvedApplicationNames()
+	response, _ := json.Marshal([]*Release{release})
+
+	client.EXPECT().Get(gomock.Any(), ReleasesResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewReleaseRestResource(client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{release}, result)
+}
+
+func TestShouldCreateReleaseViaPostWithResolvedApplicationNamesAndLookupEachApplicationOnlyOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+	release.ID = ""
+	expectedRequest := createReleaseWithResolvedApplicationNames()
+	expectedRequest.ID = ""
+	createdRelease := createReleaseWithResolvedApplicationNames()
+	response, _ := json.Marshal(createdRelease)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return([]byte(releaseApplicationResponse), nil)
+	client.EXPECT().Post(gomock.Any(), expectedRequest, ReleasesResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewReleaseRestResource(client).Create(context.Background(), release)
+
+	require.NoError(t, err)
+	require.Equal(t, createdRelease, result)
+}
+
+func TestShouldUpdateReleaseViaPutWithResolvedApplicationNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+	expectedRequest := createReleaseWithResolvedApplicationNames()
+	response, _ := json.Marshal(expectedRequest)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return([]byte(releaseApplicationResponse), nil)
+	client.EXPECT().Put(gomock.Any(), expectedRequest, ReleasesResourcePath).Times(1).Return(response, nil)
+
+	result, err := NewReleaseRestResource(client).Update(context.Background(), release)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedRequest, result)
+}
+
+func TestShouldFailToCreateReleaseWhenReferencedApplicationDoesNotExist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return(nil, ErrEntityNotFound)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewReleaseRestResource(client).Create(context.Background(), release)
+
+	require.Error(t, err)
+	require.Equal(t, "application release-application-id referenced by the release does not exist", err.Error())
+}
+
+func TestShouldFailToCreateReleaseWhenLookupOfApplicationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+	expectedError := errors.New("test")
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewReleaseRestResource(client).Create(context.Background(), release)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldFailToCreateReleaseWhenApplicationResponseIsNotValidJson(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return([]byte("invalid"), nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewReleaseRestResource(client).Create(context.Background(), release)
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToCreateReleaseWhenResponseDoesNotContainAnID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+	release.ID = ""
+	createdRelease := createReleaseWithResolvedApplicationNames()
+	createdRelease.ID = ""
+	response, _ := json.Marshal(createdRelease)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(1).Return([]byte(releaseApplicationResponse), nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), ReleasesResourcePath).Times(1).Return(response, nil)
+
+	_, err := NewReleaseRestResource(client).Create(context.Background(), release)
+
+	require.Error(t, err)
+	require.Equal(t, "id of release is missing in response", err.Error())
+}
+
+func TestShouldNotCallInstanaAPIWhenCreatingOrUpdatingInvalidRelease(t *testing.T) {
+	for name, operation := range map[string]func(RestResource, context.Context, InstanaDataObject) (InstanaDataObject, error){
+		"create": RestResource.Create,
+		"update": RestResource.Update,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockRestClient(ctrl)
+			release := createValidRelease()
+			release.Name = ""
+
+			client.EXPECT().GetOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := operation(NewReleaseRestResource(client), context.Background(), release)
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldReturnErrorWhenCreateOrUpdateOfReleaseFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+	expectedError := errors.New("test")
+	sut := NewReleaseRestResource(client)
+
+	client.EXPECT().GetOne(gomock.Any(), releaseApplicationID, ApplicationConfigsResourcePath).Times(2).Return([]byte(releaseApplicationResponse), nil)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), ReleasesResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), ReleasesResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := sut.Create(context.Background(), release)
+	require.Equal(t, expectedError, err)
+
+	_, err = sut.Update(context.Background(), release)
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldDeleteReleaseByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	release := createValidRelease()
+
+	client.EXPECT().Delete(gomock.Any(), releaseID, ReleasesResourcePath).Times(1).Return(nil)
+
+	err := NewReleaseRestResource(client).Delete(context.Background(), release)
+
+	require.NoError(t, err)
+}
+
+func createReleaseWithResolvedApplicationNames() *Release {
+	release := createValidRelease()
+	release.Applications[0].Name = releaseApplicationLabel
+	release.Services[0].ScopedTo.Applications[0].Name = releaseApplicationLabel
+	return release
+}
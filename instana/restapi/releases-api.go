@@ -0,0 +1,89 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//ReleasesResourcePath path to releases resource of Instana RESTful API
+const ReleasesResourcePath = InstanaAPIBasePath + "/releases"
+
+//MaxReleaseScopes the maximum number of applications and services respectively which can be assigned to a release
+const MaxReleaseScopes = 10
+
+//ReleaseApplication represents an application to which a release or a service of a release is scoped. Instana
+//references applications by name when creating or updating a release and returns the ID and the name of the application
+type ReleaseApplication struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+//ReleaseServiceScope represents the applications to which a service of a release is scoped
+type ReleaseServiceScope struct {
+	Applications []ReleaseApplication `json:"applications"`
+}
+
+//ReleaseService represents a service to which a release is scoped
+type ReleaseService struct {
+	Name     string               `json:"name"`
+	ScopedTo *ReleaseServiceScope `json:"scopedTo,omitempty"`
+}
+
+//Release represents the REST resource of a release (deployment marker) at Instana
+type Release struct {
+	ID           string               `json:"id,omitempty"`
+	Name         string               `json:"name"`
+	Start        int64                `json:"start"`
+	Applications []ReleaseApplication `json:"applications"`
+	Services     []ReleaseService     `json:"services"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (r *Release) GetIDForResourcePath() string {
+	return r.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. The ID is not
+//validated as it is generated by Instana when the release is created
+func (r *Release) Validate() error {
+	if utils.IsBlank(r.Name) {
+		return errors.New("name is missing")
+	}
+	if r.Start < 1 {
+		return errors.New("start is missing")
+	}
+	if err := validateReleaseApplications(r.Applications); err != nil {
+		return err
+	}
+	if len(r.Services) > MaxReleaseScopes {
+		return fmt.Errorf("a maximum of %d services is supported", MaxReleaseScopes)
+	}
+	for _, service := range r.Services {
+		if utils.IsBlank(service.Name) {
+			return errors.New("name of service is missing")
+		}
+		if service.ScopedTo != nil {
+			if len(service.ScopedTo.Applications) == 0 {
+				return fmt.Errorf("applications of the scope of service %s are missing", service.Name)
+			}
+			if err := validateReleaseApplications(service.ScopedTo.Applications); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateReleaseApplications(applications []ReleaseApplication) error {
+	if len(applications) > MaxReleaseScopes {
+		return fmt.Errorf("a maximum of %d applications is supported", MaxReleaseScopes)
+	}
+	for _, application := range applications {
+		if utils.IsBlank(application.ID) {
+			return errors.New("id of application is missing")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package restapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	releaseID            = "release-id"
+	releaseName          = "release-name"
+	releaseStart         = int64(1700000000000)
+	releaseApplicationID = "release-application-id"
+	releaseServiceName   = "release-service-name"
+)
+
+func TestShouldSuccessfullyValidateRelease(t *testing.T) {
+	release := createValidRelease()
+
+	require.Equal(t, releaseID, release.GetIDForResourcePath())
+	require.NoError(t, release.Validate())
+}
+
+func TestShouldSuccessfullyValidateReleaseWithoutIDAndScopes(t *testing.T) {
+	release := &Release{Name: releaseName, Start: releaseStart}
+
+	require.NoError(t, release.Validate())
+}
+
+func TestShouldFailToValidateRelease(t *testing.T) {
+	testCases := map[string]func(r *Release){
+		"name is missing":              func(r *Release) { r.Name = " " },
+		"start is missing":             func(r *Release) { r.Start = 0 },
+		"id of application is missing": func(r *Release) { r.Applications[0].ID = "" },
+		"a maximum of 10 applications is supported": func(r *Release) {
+			r.Applications = createReleaseApplications(MaxReleaseScopes + 1)
+		},
+		"a maximum of 10 services is supported": func(r *Release) {
+			r.Services = make([]ReleaseService, MaxReleaseScopes+1)
+			for i := range r.Services {
+				r.Services[i] = ReleaseService{Name: fmt.Sprintf("service-%d", i)}
+			}
+		},
+		"name of service is missing": func(r *Release) { r.Services[0].Name = "" },
+		"applications of the scope of service release-service-name are missing": func(r *Release) {
+			r.Services[0].ScopedTo.Applications = []ReleaseApplication{}
+		},
+		"a maximum of 10 applications is supported by the scope of a service": func(r *Release) {
+			r.Services[0].ScopedTo.Applications = createReleaseApplications(MaxReleaseScopes + 1)
+		},
+	}
+
+	for name, modifier := range testCases {
+		t.Run("Should fail with error "+name, func(t *testing.T) {
+			release := createValidRelease()
+			modifier(release)
+
+			err := release.Validate()
+
+			require.Error(t, err)
+			require.Contains(t, name, err.Error())
+		})
+	}
+}
+
+func createReleaseApplications(count int) []ReleaseApplication {
+	result := make([]ReleaseApplication, count)
+	for i := range result {
+		result[i] = ReleaseApplication{ID: fmt.Sprintf("application-%d", i)}
+	}
+	return result
+}
+
+func createValidRelease() *Release {
+	return &Release{
+		ID:           releaseID,
+		Name:         releaseName,
+		Start:        releaseStart,
+		Applications: []ReleaseApplication{{ID: releaseApplicationID}},
+		Services: []ReleaseService{
+			{
+				Name:     releaseServiceName,
+				ScopedTo: &ReleaseServiceScope{Applications: []ReleaseApplication{{ID: releaseApplicationID}}},
+			},
+		},
+	}
+}
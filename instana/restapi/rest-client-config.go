@@ -0,0 +1,50 @@
+package restapi
+
+import "time"
+
+//ClientConfig the configuration of the RestClient
+type ClientConfig struct {
+	//SkipTlsVerification deactivates the verification of the TLS certificate of the Instana API
+	SkipTlsVerification bool
+	//CACertificates PEM encoded certificates of additional certificate authorities which are trusted when verifying the TLS certificate of the Instana API
+	CACertificates []byte
+	//ClientCertificate PEM encoded client certificate presented to the Instana API for mutual TLS authentication
+	ClientCertificate []byte
+	//ClientKey PEM encoded private key of the ClientCertificate
+	ClientKey []byte
+	//HTTPProxy the URL of the proxy used for all requests to the Instana API. When empty the proxy settings of the environment are used
+	HTTPProxy string
+	//NoProxy comma separated list of hosts, domains, IPs or CIDRs which are accessed without the HTTPProxy
+	NoProxy string
+	//RetryPolicy the RetryPolicy applied to throttled and failed requests
+	RetryPolicy RetryPolicy
+	//Throttling the ThrottlingPolicy applied to all requests
+	Throttling ThrottlingPolicy
+	//RequestTimeout the maximum duration of a single request including the time waiting in the throttling queue and all retries
+	RequestTimeout time.Duration
+}
+
+//ThrottlingPolicy defines the rate at which requests are sent to the Instana API
+type ThrottlingPolicy struct {
+	//WriteRequestsPerSecond the maximum number of write requests (POST, PUT, DELETE) per second
+	WriteRequestsPerSecond int
+	//ReadRequestsPerSecond the maximum number of read requests (GET) per second. 0 deactivates the throttling of read requests
+	ReadRequestsPerSecond int
+	//QueueSize the maximum number of requests waiting for execution per throttled request type. Further requests are blocked until a slot is available or the request times out
+	QueueSize int
+}
+
+//DefaultClientConfig the ClientConfig which is used when no explicit configuration is provided
+var DefaultClientConfig = ClientConfig{
+	SkipTlsVerification: false,
+	RetryPolicy:         DefaultRetryPolicy,
+	Throttling:          DefaultThrottlingPolicy,
+	RequestTimeout:      30 * time.Second,
+}
+
+//DefaultThrottlingPolicy the ThrottlingPolicy which is used when no explicit ThrottlingPolicy is configured
+var DefaultThrottlingPolicy = ThrottlingPolicy{
+	WriteRequestsPerSecond: 5,
+	ReadRequestsPerSecond:  0,
+	QueueSize:              1000,
+}
@@ -0,0 +1,121 @@
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const envLog = "TF_LOG"
+const envLogProvider = "TF_LOG_PROVIDER"
+const logLevelTrace = "TRACE"
+const redactedValue = "REDACTED"
+const maxLoggedBodyLength = 4096
+
+//genericSecretFields JSON fields which contain secrets independent of the resource type
+var genericSecretFields = []string{"apiToken", "password", "secret", "clientSecret"}
+
+//secretFields the lower case names of all JSON fields whose values are masked in trace logs
+var secretFields = newSecretFieldSet(genericSecretFields, alertingChannelSecretFields, apiTokenSecretFields)
+
+func newSecretFieldSet(fieldLists ...[]string) map[string]bool {
+	result := make(map[string]bool)
+	for _, fields := range fieldLists {
+		for _, field := range fields {
+			result[strings.ToLower(field)] = true
+		}
+	}
+	return result
+}
+
+//isTraceLoggingEnabled checks if terraform runs with log level TRACE for the provider. In line with terraform, an
+//unknown log level is handled as TRACE
+func isTraceLoggingEnabled() bool {
+	level := os.Getenv(envLogProvider)
+	if len(level) == 0 {
+		level = os.Getenv(envLog)
+	}
+	if len(level) == 0 {
+		return false
+	}
+	switch strings.ToUpper(level) {
+	case "DEBUG", "INFO", "WARN", "ERROR", "OFF":
+		return false
+	default:
+		return true
+	}
+}
+
+func traceLogRequest(method string, url string, headers http.Header, body interface{}) {
+	log.Printf("[%s] HTTP %s %s; headers = %s; body = %s\n", logLevelTrace, method, url, redactHeaders(headers), maskRequestBody(body))
+}
+
+func traceLogResponse(method string, url string, statusCode int, headers http.Header, body []byte, latency time.Duration) {
+	log.Printf("[%s] HTTP %s %s responded with status code %d after %s; headers = %s; body = %s\n", logLevelTrace, method, url, statusCode, latency, redactHeaders(headers), maskBody(body))
+}
+
+func maskRequestBody(body interface{}) string {
+	switch b := body.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return maskBody(b)
+	case string:
+		return maskBody([]byte(b))
+	default:
+		data, err := json.Marshal(body)
+		if err != nil {
+			return "<unserializable body>"
+		}
+		return maskBody(data)
+	}
+}
+
+//maskBody replaces the values of all secret fields of the given JSON body. Bodies which are not valid JSON are not logged as
+//secrets cannot be detected reliably
+func maskBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "<non-JSON body omitted>"
+	}
+	masked, err := json.Marshal(maskSecretFields(value))
+	if err != nil {
+		return "<unserializable body>"
+	}
+	if len(masked) > maxLoggedBodyLength {
+		return string(masked[:maxLoggedBodyLength]) + "..."
+	}
+	return string(masked)
+}
+
+func maskSecretFields(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if secretFields[strings.ToLower(key)] {
+				if fieldValue != nil {
+					v[key] = redactedValue
+				}
+			} else {
+				v[key] = maskSecretFields(fieldValue)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, element := range v {
+			v[i] = maskSecretFields(element)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,102 @@
+package restapi_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const secretAPIToken = "secret-provider-api-token"
+
+func TestShouldLogRequestAndResponseBodiesWithMaskedSecretsWhenTraceLoggingIsEnabled(t *testing.T) {
+	t.Setenv("TF_LOG", "TRACE")
+	webhookURL := "https://hooks.example.com/secret-webhook"
+	serviceKey := "secret-service-key"
+	apiKey := "secret-api-key"
+	channel := &AlertingChannel{
+		ID:                    testID,
+		Name:                  "name",
+		Kind:                  PagerDutyChannelType,
+		WebhookURL:            &webhookURL,
+		ServiceIntegrationKey: &serviceKey,
+		APIKey:                &apiKey,
+		WebhookURLs:           []string{"https://hooks.example.com/secret-webhook-1"},
+		Headers:               []string{"Authorization: Bearer secret-header"},
+	}
+
+	output := executeEchoRequestAndCaptureLog(t, channel)
+
+	require.Contains(t, output, "[TRACE] HTTP PUT")
+	require.Contains(t, output, "responded with status code 200 after")
+	require.Contains(t, output, `"name":"name"`)
+	require.Contains(t, output, `"serviceIntegrationKey":"REDACTED"`)
+	require.NotContains(t, output, "secret")
+}
+
+func TestShouldMaskAccessGrantingTokenOfAPITokensInTraceLogs(t *testing.T) {
+	t.Setenv("TF_LOG", "TRACE")
+	apiToken := &APIToken{ID: testID, AccessGrantingToken: "secret-access-granting-token", InternalID: testID, Name: "name"}
+
+	output := executeEchoRequestAndCaptureLog(t, apiToken)
+
+	require.Contains(t, output, `"accessGrantingToken":"REDACTED"`)
+	require.NotContains(t, output, "secret")
+}
+
+func TestShouldMaskSecretsInNestedJsonBodiesOfTraceLogs(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER", "trace")
+	data := testSecretDataObject{testDataObject: testDataObject{id: testID}, Nested: []map[string]string{{"password": "secret-password", "value": "visible"}}}
+
+	output := executeEchoRequestAndCaptureLog(t, data)
+
+	require.Contains(t, output, `"password":"REDACTED"`)
+	require.Contains(t, output, `"value":"visible"`)
+	require.NotContains(t, output, "secret")
+}
+
+func TestShouldNotLogBodiesWhenTraceLoggingIsNotEnabled(t *testing.T) {
+	for _, level := range []string{"", "DEBUG", "info"} {
+		t.Run(fmt.Sprintf("Should not log bodies for log level '%s'", level), func(t *testing.T) {
+			t.Setenv("TF_LOG", level)
+			t.Setenv("TF_LOG_PROVIDER", "")
+
+			output := executeEchoRequestAndCaptureLog(t, &APIToken{ID: testID, AccessGrantingToken: "token", InternalID: testID, Name: "name"})
+
+			require.NotContains(t, output, "[TRACE]")
+			require.NotContains(t, output, "accessGrantingToken")
+		})
+	}
+}
+
+type testSecretDataObject struct {
+	testDataObject
+	Nested []map[string]string `json:"nested"`
+}
+
+func executeEchoRequestAndCaptureLog(t *testing.T, data InstanaDataObject) string {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodPut, testPathWithID, testutils.EchoHandlerFunc)
+	httpServer.Start()
+	defer httpServer.Close()
+
+	config := createTestClientConfig()
+	client, err := NewClient(secretAPIToken, fmt.Sprintf("localhost:%d", httpServer.GetPort()), config)
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	originalOutput := log.Writer()
+	log.SetOutput(&buffer)
+	defer log.SetOutput(originalOutput)
+
+	_, err = client.Put(context.Background(), data, testPath)
+
+	require.NoError(t, err)
+	return buffer.String()
+}
@@ -0,0 +1,65 @@
+package restapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+//newHTTPTransport creates the http.Transport of the RestClient for the given ClientConfig. Without an explicit HTTP proxy
+//the proxy settings of the environment (HTTPS_PROXY, NO_PROXY) are applied.
+func newHTTPTransport(config ClientConfig) (*http.Transport, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if len(config.HTTPProxy) > 0 {
+		if _, err := url.Parse(config.HTTPProxy); err != nil {
+			return nil, fmt.Errorf("invalid HTTP proxy; %s", err)
+		}
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  config.HTTPProxy,
+			HTTPSProxy: config.HTTPProxy,
+			NoProxy:    config.NoProxy,
+		}).ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+	return transport, nil
+}
+
+func newTLSConfig(config ClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SkipTlsVerification, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if len(config.CACertificates) > 0 {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(config.CACertificates) {
+			return nil, errors.New("failed to load CA certificates; no valid PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if len(config.ClientCertificate) > 0 || len(config.ClientKey) > 0 {
+		clientCertificate, err := tls.X509KeyPair(config.ClientCertificate, config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate; %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,224 @@
+package restapi_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldTrustServerCertificateSignedByConfiguredCACertificate(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	server := startTLSServer(t, ca, tls.NoClientCert)
+	defer server.Close()
+
+	config := DefaultClientConfig
+	config.CACertificates = ca.certificatePEM
+	_, err := createClientForServer(t, server, config).Get(context.Background(), testPath)
+
+	require.NoError(t, err)
+}
+
+func TestShouldRejectServerCertificateSignedByUnknownCACertificate(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	server := startTLSServer(t, ca, tls.NoClientCert)
+	defer server.Close()
+
+	config := DefaultClientConfig
+	config.CACertificates = newTestCertificateAuthority(t).certificatePEM
+	_, err := createClientForServer(t, server, config).Get(context.Background(), testPath)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "certificate")
+}
+
+func TestShouldPresentClientCertificateToServer(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	server := startTLSServer(t, ca, tls.RequireAndVerifyClientCert)
+	defer server.Close()
+	clientCertificate, clientKey := ca.issueCertificate(t, "client")
+
+	config := DefaultClientConfig
+	config.CACertificates = ca.certificatePEM
+	config.ClientCertificate = clientCertificate
+	config.ClientKey = clientKey
+	_, err := createClientForServer(t, server, config).Get(context.Background(), testPath)
+
+	require.NoError(t, err)
+}
+
+func TestShouldFailRequestWhenServerRequiresClientCertificateAndNoClientCertificateIsConfigured(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	server := startTLSServer(t, ca, tls.RequireAndVerifyClientCert)
+	defer server.Close()
+
+	config := DefaultClientConfig
+	config.CACertificates = ca.certificatePEM
+	_, err := createClientForServer(t, server, config).Get(context.Background(), testPath)
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToCreateClientWhenCACertificatesAreInvalid(t *testing.T) {
+	config := DefaultClientConfig
+	config.CACertificates = []byte("invalid")
+
+	_, err := NewClient("api-token", "localhost", config)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to load CA certificates")
+}
+
+func TestShouldFailToCreateClientWhenClientCertificateIsInvalid(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+	_, clientKey := ca.issueCertificate(t, "client")
+	config := DefaultClientConfig
+	config.ClientCertificate = []byte("invalid")
+	config.ClientKey = clientKey
+
+	_, err := NewClient("api-token", "localhost", config)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to load client certificate")
+}
+
+func TestShouldSendRequestsThroughConfiguredHTTPProxy(t *testing.T) {
+	proxy, proxiedHosts := startTestProxy()
+	defer proxy.Close()
+
+	config := DefaultClientConfig
+	config.HTTPProxy = proxy.URL
+	client, err := NewClient("api-token", "instana.test", config)
+	require.NoError(t, err)
+	_, err = client.Get(context.Background(), testPath)
+
+	require.Error(t, err)
+	require.Equal(t, []string{"instana.test:443"}, proxiedHosts())
+}
+
+func TestShouldNotSendRequestsThroughConfiguredHTTPProxyWhenHostIsExcluded(t *testing.T) {
+	proxy, proxiedHosts := startTestProxy()
+	defer proxy.Close()
+
+	config := DefaultClientConfig
+	config.HTTPProxy = proxy.URL
+	config.NoProxy = "example.com,.test"
+	config.RequestTimeout = 5 * time.Second
+	client, err := NewClient("api-token", "instana.test", config)
+	require.NoError(t, err)
+	_, err = client.Get(context.Background(), testPath)
+
+	require.Error(t, err)
+	require.Empty(t, proxiedHosts())
+}
+
+//startTestProxy starts a HTTP proxy which records the hosts of all CONNECT requests and rejects them
+func startTestProxy() (*httptest.Server, func() []string) {
+	var lock sync.Mutex
+	hosts := make([]string, 0)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		hosts = append(hosts, r.Host)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	return proxy, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, hosts...)
+	}
+}
+
+func startTLSServer(t *testing.T, ca *testCertificateAuthority, clientAuth tls.ClientAuthType) *httptest.Server {
+	serverCertificate, serverKey := ca.issueCertificate(t, "127.0.0.1")
+	keyPair, err := tls.X509KeyPair(serverCertificate, serverKey)
+	require.NoError(t, err)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(ca.certificatePEM)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(testData))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		ClientAuth:   clientAuth,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	server.StartTLS()
+	return server
+}
+
+func createClientForServer(t *testing.T, server *httptest.Server, config ClientConfig) RestClient {
+	config.RetryPolicy = RetryPolicy{}
+	client, err := NewClient("api-token", strings.TrimPrefix(server.URL, "https://"), config)
+	require.NoError(t, err)
+	return client
+}
+
+type testCertificateAuthority struct {
+	certificate    *x509.Certificate
+	key            *ecdsa.PrivateKey
+	certificatePEM []byte
+}
+
+func newTestCertificateAuthority(t *testing.T) *testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificateAuthority{
+		certificate:    certificate,
+		key:            key,
+		certificatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca *testCertificateAuthority) issueCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-1 * time.Hour),
+		NotAfter:     time.Now().Add(1 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if ip := net.ParseIP(commonName); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
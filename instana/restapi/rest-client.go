@@ -2,32 +2,38 @@ package restapi
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"time"
 
 	resty "gopkg.in/resty.v1"
 )
 
-//ErrEntityNotFound error message which is returned when the entity cannot be found at the server
+//ErrEntityNotFound error message which is returned when the entity cannot be found at the server. The APIError returned for HTTP 404 responses matches this error when using errors.Is
 var ErrEntityNotFound = errors.New("failed to get resource from Instana API. 404 - Resource not found")
 
 const contentTypeHeader = "Content-Type"
 const encodingApplicationJSON = "application/json; charset=utf-8"
+const encodingTextCSV = "text/csv; charset=utf-8"
 
 //RestClient interface to access REST resources of the Instana API
 type RestClient interface {
-	Get(resourcePath string) ([]byte, error)
-	GetOne(id string, resourcePath string) ([]byte, error)
-	Post(data InstanaDataObject, resourcePath string) ([]byte, error)
-	PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error)
-	Put(data InstanaDataObject, resourcePath string) ([]byte, error)
-	Delete(resourceID string, resourceBasePath string) error
-	PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error)
-	PutByQuery(resourcePath string, is string, queryParams map[string]string) ([]byte, error)
+	Get(ctx context.Context, resourcePath string) ([]byte, error)
+	GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error)
+	GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error)
+	Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	PostWithID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	PutWithoutID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error)
+	Delete(ctx context.Context, resourceID string, resourceBasePath string) error
+	PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error)
+	PutByQuery(ctx context.Context, resourcePath string, is string, queryParams map[string]string) ([]byte, error)
+	DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error
+	GetCSV(ctx context.Context, resourcePath string) ([]byte, error)
+	PutCSV(ctx context.Context, data []byte, resourcePath string) ([]byte, error)
 }
 
 type apiRequest struct {
@@ -39,165 +45,311 @@ type apiRequest struct {
 }
 
 type apiResponse struct {
-	data []byte
-	err  error
+	response *resty.Response
+	err      error
 }
 
-//NewClient creates a new instance of the Instana REST API client
-func NewClient(apiToken string, host string, skipTlsVerification bool) RestClient {
-	restyClient := resty.New()
-	if skipTlsVerification {
-		restyClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+//NewClient creates a new instance of the Instana REST API client. The endpoint is either the host name (and port) of the
+//Instana API, which is accessed via HTTPS, or a full URL including scheme, port and path prefix (e.g. http://localhost:8080/instana)
+func NewClient(apiToken string, endpoint string, config ClientConfig) (RestClient, error) {
+	baseURL, err := parseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := newHTTPTransport(config)
+	if err != nil {
+		return nil, err
 	}
+	restyClient := resty.New().SetTransport(transport)
 
-	throttleRate := time.Second / 5 //5 write requests per second
-	throttledRequests := make(chan *apiRequest, 1000)
 	client := &restClientImpl{
-		apiToken:          apiToken,
-		host:              host,
-		restyClient:       restyClient,
-		throttledRequests: throttledRequests,
-		throttleRate:      throttleRate,
+		apiToken:       apiToken,
+		baseURL:        baseURL,
+		restyClient:    restyClient,
+		writeThrottle:  newRequestThrottle(config.Throttling.WriteRequestsPerSecond, config.Throttling.QueueSize),
+		readThrottle:   newRequestThrottle(config.Throttling.ReadRequestsPerSecond, config.Throttling.QueueSize),
+		retryPolicy:    config.RetryPolicy,
+		requestTimeout: config.RequestTimeout,
+		traceLogging:   isTraceLoggingEnabled(),
 	}
 
-	go client.processThrottledRequests()
-	return client
+	client.startProcessingOfThrottledRequests(client.writeThrottle)
+	client.startProcessingOfThrottledRequests(client.readThrottle)
+	return client, nil
+}
+
+func parseEndpoint(endpoint string) (string, error) {
+	if !strings.Contains(endpoint, "://") {
+		return "https://" + strings.TrimSuffix(endpoint, "/"), nil
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		//the url.Error contains the full endpoint which might include credentials
+		return "", fmt.Errorf("invalid endpoint; %s", errors.Unwrap(err))
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return "", fmt.Errorf("invalid endpoint %s; scheme must be http or https", endpointURL.Redacted())
+	}
+	if len(endpointURL.Host) == 0 {
+		return "", fmt.Errorf("invalid endpoint %s; host is missing", endpointURL.Redacted())
+	}
+	if len(endpointURL.RawQuery) > 0 || len(endpointURL.Fragment) > 0 || endpointURL.User != nil {
+		return "", fmt.Errorf("invalid endpoint %s; user info, query parameters and fragments are not supported", endpointURL.Redacted())
+	}
+	return fmt.Sprintf("%s://%s%s", endpointURL.Scheme, endpointURL.Host, strings.TrimSuffix(endpointURL.EscapedPath(), "/")), nil
 }
 
 type restClientImpl struct {
-	apiToken          string
-	host              string
-	restyClient       *resty.Client
-	throttledRequests chan *apiRequest
-	throttleRate      time.Duration
+	apiToken       string
+	baseURL        string
+	restyClient    *resty.Client
+	writeThrottle  *requestThrottle
+	readThrottle   *requestThrottle
+	retryPolicy    RetryPolicy
+	requestTimeout time.Duration
+	traceLogging   bool
+}
+
+//requestThrottle queue of requests which are executed with the given rate. A nil requestThrottle represents unthrottled requests
+type requestThrottle struct {
+	requests chan *apiRequest
+	rate     time.Duration
+}
+
+func newRequestThrottle(requestsPerSecond int, queueSize int) *requestThrottle {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &requestThrottle{
+		requests: make(chan *apiRequest, queueSize),
+		rate:     time.Second / time.Duration(requestsPerSecond),
+	}
 }
 
 var emptyResponse = make([]byte, 0)
 
 //Get request data via HTTP GET for the given resourcePath
-func (client *restClientImpl) Get(resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Get(ctx context.Context, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
 	req := client.createRequest()
-	return client.executeRequest(resty.MethodGet, url, req)
+	return client.executeRequestWithThrottling(ctx, client.readThrottle, resty.MethodGet, url, req)
 }
 
 //GetOne request the resource with the given ID
-func (client *restClientImpl) GetOne(id string, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) GetOne(ctx context.Context, id string, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
 	req := client.createRequest()
-	return client.executeRequest(resty.MethodGet, url, req)
+	return client.executeRequestWithThrottling(ctx, client.readThrottle, resty.MethodGet, url, req)
+}
+
+//GetByQuery request the resource of the given resourcePath by providing the query parameters
+func (client *restClientImpl) GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	return client.executeRequestWithThrottling(ctx, client.readThrottle, resty.MethodGet, url, req)
 }
 
 //Post executes a HTTP PUT request to create or update the given resource
-func (client *restClientImpl) Post(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Post(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
 	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPost, url, req)
 }
 
 //PostWithID executes a HTTP PUT request to create or update the given resource using the ID from the InstanaDataObject in the resource path
-func (client *restClientImpl) PostWithID(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) PostWithID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetIDForResourcePath())
 	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPost, url, req)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPost, url, req)
 }
 
 //Put executes a HTTP PUT request to create or update the given resource
-func (client *restClientImpl) Put(data InstanaDataObject, resourcePath string) ([]byte, error) {
+func (client *restClientImpl) Put(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, data.GetIDForResourcePath())
 	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
-	return client.executeRequestWithThrottling(resty.MethodPut, url, req)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPut, url, req)
+}
+
+//PutWithoutID executes a HTTP PUT request to update the given resource at the given resourcePath. In contrast to Put the
+//ID of the InstanaDataObject is not appended to the resource path
+func (client *restClientImpl) PutWithoutID(ctx context.Context, data InstanaDataObject, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader(contentTypeHeader, encodingApplicationJSON).SetBody(data)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPut, url, req)
 }
 
 //Delete executes a HTTP DELETE request to delete the resource with the given ID
-func (client *restClientImpl) Delete(resourceID string, resourceBasePath string) error {
+func (client *restClientImpl) Delete(ctx context.Context, resourceID string, resourceBasePath string) error {
 	url := client.buildResourceURL(resourceBasePath, resourceID)
 	req := client.createRequest()
-	_, err := client.executeRequestWithThrottling(resty.MethodDelete, url, req)
+	_, err := client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodDelete, url, req)
 	return err
 }
 
 //PostByQuery executes a HTTP POST request to create the resource by providing the data a query parameters
-func (client *restClientImpl) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (client *restClientImpl) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildURL(resourcePath)
 	req := client.createRequest()
 	client.appendQueryParameters(req, queryParams)
-	return client.executeRequest(resty.MethodPost, url, req)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPost, url, req)
 }
 
 //PutByQuery executes a HTTP PUT request to update the resource with the given ID by providing the data a query parameters
-func (client *restClientImpl) PutByQuery(resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
+func (client *restClientImpl) PutByQuery(ctx context.Context, resourcePath string, id string, queryParams map[string]string) ([]byte, error) {
 	url := client.buildResourceURL(resourcePath, id)
 	req := client.createRequest()
 	client.appendQueryParameters(req, queryParams)
-	return client.executeRequest(resty.MethodPut, url, req)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPut, url, req)
+}
+
+//DeleteByQuery executes a HTTP DELETE request to delete the resource identified by the given query parameters
+func (client *restClientImpl) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest()
+	client.appendQueryParameters(req, queryParams)
+	_, err := client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodDelete, url, req)
+	return err
+}
+
+//GetCSV request the CSV document of the given resourcePath via HTTP GET
+func (client *restClientImpl) GetCSV(ctx context.Context, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader("Accept", "text/csv")
+	return client.executeRequestWithThrottling(ctx, client.readThrottle, resty.MethodGet, url, req)
+}
+
+//PutCSV executes a HTTP PUT request to replace the CSV document of the given resourcePath
+func (client *restClientImpl) PutCSV(ctx context.Context, data []byte, resourcePath string) ([]byte, error) {
+	url := client.buildURL(resourcePath)
+	req := client.createRequest().SetHeader("Accept", "text/csv").SetHeader(contentTypeHeader, encodingTextCSV).SetBody(data)
+	return client.executeRequestWithThrottling(ctx, client.writeThrottle, resty.MethodPut, url, req)
 }
 
 func (client *restClientImpl) createRequest() *resty.Request {
 	return client.restyClient.R().SetHeader("Accept", "application/json").SetHeader("Authorization", fmt.Sprintf("apiToken %s", client.apiToken))
 }
 
-func (client *restClientImpl) executeRequestWithThrottling(method string, url string, req *resty.Request) ([]byte, error) {
-	responseChannel := make(chan *apiResponse)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer close(responseChannel)
+//executeRequestWithThrottling executes the request and retries it according to the retry policy. Every attempt, including
+//retries, is passed through the given throttle so that retries also consume a slot of the configured request rate
+func (client *restClientImpl) executeRequestWithThrottling(parentCtx context.Context, throttle *requestThrottle, method string, url string, req *resty.Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(parentCtx, client.requestTimeout)
 	defer cancel()
+	req.SetContext(ctx)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := client.executeAttempt(ctx, throttle, method, url, req)
+		if err != nil {
+			return emptyResponse, err
+		}
+		statusCode := resp.StatusCode()
+		if attempt < client.retryPolicy.MaxRetries && client.retryPolicy.IsRetryable(method, statusCode) {
+			backoff := client.retryPolicy.Backoff(attempt, resp.Header().Get(retryAfterHeader))
+			log.Printf("[DEBUG] HTTP %s %s returned status code %d; retry %d of %d in %s\n", method, url, statusCode, attempt+1, client.retryPolicy.MaxRetries, backoff)
+			if err := waitForRetry(ctx, backoff); err != nil {
+				return emptyResponse, fmt.Errorf("failed to retry HTTP %s request to Instana API; %s", method, err)
+			}
+			continue
+		}
+		if statusCode < 200 || statusCode >= 300 {
+			return emptyResponse, newAPIError(method, url, statusCode, resp.Header(), resp.Body())
+		}
+		return resp.Body(), nil
+	}
+}
+
+//executeAttempt executes a single attempt of the request. Throttled attempts are queued and executed by the
+//processor of the throttle
+func (client *restClientImpl) executeAttempt(ctx context.Context, throttle *requestThrottle, method string, url string, req *resty.Request) (*resty.Response, error) {
+	if throttle == nil {
+		return client.executeRequest(method, url, req)
+	}
 
-	client.throttledRequests <- &apiRequest{
+	responseChannel := make(chan *apiResponse, 1)
+	select {
+	case throttle.requests <- &apiRequest{
 		method:          method,
 		url:             url,
 		request:         *req,
 		ctx:             ctx,
 		responseChannel: responseChannel,
+	}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s while waiting for a free slot in the request queue", client.contextError(ctx))
 	}
 
 	select {
 	case r := <-responseChannel:
-		return r.data, r.err
-	case <-time.After(30 * time.Second):
-		return nil, errors.New("API request timed out")
+		return r.response, r.err
+	case <-ctx.Done():
+		return nil, client.contextError(ctx)
 	}
 }
 
-func (client *restClientImpl) processThrottledRequests() {
-	throttle := time.Tick(client.throttleRate)
-	for req := range client.throttledRequests {
-		<-throttle
+func (client *restClientImpl) contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("API request timed out after %s", client.requestTimeout)
+	}
+	return fmt.Errorf("API request canceled; %s", ctx.Err())
+}
+
+func (client *restClientImpl) startProcessingOfThrottledRequests(throttle *requestThrottle) {
+	if throttle != nil {
+		go client.processThrottledRequests(throttle)
+	}
+}
+
+func (client *restClientImpl) processThrottledRequests(throttle *requestThrottle) {
+	ticker := time.NewTicker(throttle.rate)
+	defer ticker.Stop()
+	for req := range throttle.requests {
+		if req.ctx.Err() != nil {
+			//skip requests which timed out while waiting in the queue
+			continue
+		}
+		<-ticker.C
 		go client.handleThrottledAPIRequest(req)
 	}
 }
 
 func (client *restClientImpl) handleThrottledAPIRequest(req *apiRequest) {
-	data, err := client.executeRequest(req.method, req.url, &req.request)
-	responseMessage := &apiResponse{
-		data: data,
-		err:  err,
-	}
-	select {
-	case <-req.ctx.Done():
-		return
-	default:
-		req.responseChannel <- responseMessage
+	resp, err := client.executeRequest(req.method, req.url, &req.request)
+	req.responseChannel <- &apiResponse{
+		response: resp,
+		err:      err,
 	}
 }
 
-func (client *restClientImpl) executeRequest(method string, url string, req *resty.Request) ([]byte, error) {
+func (client *restClientImpl) executeRequest(method string, url string, req *resty.Request) (*resty.Response, error) {
 	log.Printf("[DEBUG] Call %s %s\n", method, url)
+	if client.traceLogging {
+		traceLogRequest(method, url, req.Header, req.Body)
+	}
+	start := time.Now()
 	resp, err := req.Execute(method, url)
+	if client.traceLogging && resp != nil && resp.RawResponse != nil {
+		traceLogResponse(method, url, resp.StatusCode(), resp.Header(), resp.Body(), time.Since(start))
+	}
 	if err != nil {
 		if resp == nil {
-			return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; %s", method, err)
+			return nil, fmt.Errorf("failed to send HTTP %s request to Instana API; %s", method, err)
 		}
-		return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; Headers %s, %s", method, resp.StatusCode(), resp.Status(), resp.Header(), err)
-	}
-	statusCode := resp.StatusCode()
-	if statusCode == 404 {
-		return emptyResponse, ErrEntityNotFound
+		apiError := newAPIError(method, url, resp.StatusCode(), resp.Header(), resp.Body())
+		apiError.Message = err.Error()
+		return nil, apiError
 	}
-	if statusCode < 200 || statusCode >= 300 {
-		return emptyResponse, fmt.Errorf("failed to send HTTP %s request to Instana API; status code = %d; status message = %s; Headers %s\nBody: %s", method, statusCode, resp.Status(), resp.Header(), resp.Body())
+	return resp, nil
+}
+
+func waitForRetry(ctx context.Context, backoff time.Duration) error {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	return resp.Body(), nil
 }
 
 func (client *restClientImpl) appendQueryParameters(req *resty.Request, queryParams map[string]string) {
@@ -216,5 +368,5 @@ func (client *restClientImpl) buildResourceURL(resourceBasePath string, id strin
 }
 
 func (client *restClientImpl) buildURL(resourcePath string) string {
-	return fmt.Sprintf("https://%s%s", client.host, resourcePath)
+	return client.baseURL + resourcePath
 }
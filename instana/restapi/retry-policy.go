@@ -0,0 +1,101 @@
+package restapi
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	resty "gopkg.in/resty.v1"
+)
+
+//RetryPolicy defines how the RestClient retries requests which are rejected by the Instana API because of throttling (HTTP 429) or server side errors (HTTP 5xx)
+type RetryPolicy struct {
+	//MaxRetries the maximum number of retries of a single request. 0 deactivates retries
+	MaxRetries int
+	//MinBackoff the backoff of the first retry. The backoff is doubled for every further retry
+	MinBackoff time.Duration
+	//MaxBackoff the upper limit of the backoff between two attempts. The limit also applies to the delay requested by a Retry-After header
+	MaxBackoff time.Duration
+}
+
+//DefaultRetryPolicy the RetryPolicy which is used when no explicit RetryPolicy is configured
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 10 * time.Second,
+}
+
+const retryAfterHeader = "Retry-After"
+
+var idempotentHTTPMethods = []string{resty.MethodGet, resty.MethodHead, resty.MethodOptions, resty.MethodPut, resty.MethodDelete}
+
+var jitterRandom = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+var jitterRandomLock sync.Mutex
+
+//IsRetryable checks if a request with the given HTTP method which received a response with the given status code should be retried.
+//Throttled requests (HTTP 429) are rejected before they are processed by the Instana API and are therefore retried for all methods.
+//Server side errors (HTTP 5xx) are only retried for idempotent methods as the request might be processed partially.
+func (p RetryPolicy) IsRetryable(method string, statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode >= 500 && statusCode != http.StatusNotImplemented && isIdempotentHTTPMethod(method)
+}
+
+func isIdempotentHTTPMethod(method string) bool {
+	for _, m := range idempotentHTTPMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+//Backoff calculates the duration to wait before the given zero based retry attempt. When a valid Retry-After header value is provided
+//the delay requested by the Instana API is used. Otherwise, a capped exponential backoff with jitter is calculated.
+func (p RetryPolicy) Backoff(attempt int, retryAfter string) time.Duration {
+	if delay, ok := parseRetryAfter(retryAfter); ok {
+		return p.capBackoff(delay)
+	}
+	backoff := p.MaxBackoff
+	if exponentialBackoff := float64(p.MinBackoff) * math.Exp2(float64(attempt)); exponentialBackoff < float64(p.MaxBackoff) {
+		backoff = time.Duration(exponentialBackoff)
+	}
+	halfBackoff := int64(backoff / 2)
+	if halfBackoff <= 0 {
+		return backoff
+	}
+	jitterRandomLock.Lock()
+	defer jitterRandomLock.Unlock()
+	return time.Duration(halfBackoff + jitterRandom.Int63n(halfBackoff+1))
+}
+
+func (p RetryPolicy) capBackoff(backoff time.Duration) time.Duration {
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,87 @@
+package restapi_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{MaxRetries: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: 1 * time.Second}
+
+func TestShouldRetryThrottledRequestsForAllMethods(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+		t.Run(fmt.Sprintf("Should retry throttled %s request", method), func(t *testing.T) {
+			require.True(t, testRetryPolicy.IsRetryable(method, http.StatusTooManyRequests))
+		})
+	}
+}
+
+func TestShouldRetryServerErrorsForIdempotentMethodsOnly(t *testing.T) {
+	for _, statusCode := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(fmt.Sprintf("Should retry status code %d for idempotent methods only", statusCode), func(t *testing.T) {
+			require.True(t, testRetryPolicy.IsRetryable(http.MethodGet, statusCode))
+			require.True(t, testRetryPolicy.IsRetryable(http.MethodPut, statusCode))
+			require.True(t, testRetryPolicy.IsRetryable(http.MethodDelete, statusCode))
+			require.False(t, testRetryPolicy.IsRetryable(http.MethodPost, statusCode))
+		})
+	}
+}
+
+func TestShouldNotRetryClientErrorsSuccessfulResponsesAndNotImplementedStatus(t *testing.T) {
+	for _, statusCode := range []int{http.StatusOK, http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusNotImplemented} {
+		t.Run(fmt.Sprintf("Should not retry status code %d", statusCode), func(t *testing.T) {
+			require.False(t, testRetryPolicy.IsRetryable(http.MethodGet, statusCode))
+		})
+	}
+}
+
+func TestShouldCalculateExponentialBackoffWithJitter(t *testing.T) {
+	for attempt := 0; attempt < 3; attempt++ {
+		upperLimit := testRetryPolicy.MinBackoff * time.Duration(1<<attempt)
+		for i := 0; i < 50; i++ {
+			backoff := testRetryPolicy.Backoff(attempt, "")
+
+			require.GreaterOrEqual(t, backoff, upperLimit/2)
+			require.LessOrEqual(t, backoff, upperLimit)
+		}
+	}
+}
+
+func TestShouldCapExponentialBackoffAtMaxBackoff(t *testing.T) {
+	for _, attempt := range []int{4, 10, 100, 5000} {
+		backoff := testRetryPolicy.Backoff(attempt, "")
+
+		require.GreaterOrEqual(t, backoff, testRetryPolicy.MaxBackoff/2)
+		require.LessOrEqual(t, backoff, testRetryPolicy.MaxBackoff)
+	}
+}
+
+func TestShouldUseRetryAfterHeaderInSecondsAsBackoff(t *testing.T) {
+	require.Equal(t, 0*time.Second, testRetryPolicy.Backoff(2, "0"))
+	require.Equal(t, 1*time.Second, testRetryPolicy.Backoff(0, "1"))
+}
+
+func TestShouldCapRetryAfterHeaderAtMaxBackoff(t *testing.T) {
+	require.Equal(t, testRetryPolicy.MaxBackoff, testRetryPolicy.Backoff(0, "3600"))
+}
+
+func TestShouldUseRetryAfterHeaderAsHttpDateAsBackoff(t *testing.T) {
+	backoff := testRetryPolicy.Backoff(0, time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat))
+	require.Equal(t, testRetryPolicy.MaxBackoff, backoff)
+
+	backoff = testRetryPolicy.Backoff(0, time.Now().Add(-10*time.Second).UTC().Format(http.TimeFormat))
+	require.Equal(t, time.Duration(0), backoff)
+}
+
+func TestShouldFallbackToExponentialBackoffWhenRetryAfterHeaderIsInvalid(t *testing.T) {
+	for _, value := range []string{"invalid", "-1", "1.5"} {
+		backoff := testRetryPolicy.Backoff(0, value)
+
+		require.GreaterOrEqual(t, backoff, testRetryPolicy.MinBackoff/2)
+		require.LessOrEqual(t, backoff, testRetryPolicy.MinBackoff)
+	}
+}
@@ -0,0 +1,95 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const (
+	//ServiceConfigsResourcePath path to service config resource of Instana RESTful API
+	ServiceConfigsResourcePath = ApplicationMonitoringSettingsBasePath + "/service"
+	//ServiceConfigOrderPathElement path element of the order endpoint of the service config resource of the Instana RESTful API
+	ServiceConfigOrderPathElement = "order"
+	//ServiceConfigOrderResourcePath path to the order endpoint of the service config resource of the Instana RESTful API
+	ServiceConfigOrderResourcePath = ServiceConfigsResourcePath + "/" + ServiceConfigOrderPathElement
+)
+
+//MaxServiceMatchingRules the maximum number of match specifications of a service config supported by the Instana API
+const MaxServiceMatchingRules = 20
+
+//ServiceMatchingRule represents a single match specification of a service config. The key is matched against the tags of the monitored entities
+type ServiceMatchingRule struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+//ServiceConfig represents the REST resource of service configuration (custom service naming rule) at Instana
+type ServiceConfig struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Label              string                `json:"label"`
+	Comment            *string               `json:"comment,omitempty"`
+	Enabled            bool                  `json:"enabled"`
+	MatchSpecification []ServiceMatchingRule `json:"matchSpecification"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (c *ServiceConfig) GetIDForResourcePath() string {
+	return c.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (c *ServiceConfig) Validate() error {
+	if utils.IsBlank(c.ID) {
+		return errors.New("id is missing")
+	}
+	if utils.IsBlank(c.Name) {
+		return errors.New("name is missing")
+	}
+	if utils.IsBlank(c.Label) {
+		return errors.New("label is missing")
+	}
+	if len(c.MatchSpecification) > MaxServiceMatchingRules {
+		return fmt.Errorf("a maximum of %d match specifications is supported", MaxServiceMatchingRules)
+	}
+	for _, rule := range c.MatchSpecification {
+		if utils.IsBlank(rule.Key) {
+			return errors.New("key of match specification is missing")
+		}
+		if utils.IsBlank(rule.Value) {
+			return errors.New("value of match specification is missing")
+		}
+	}
+	return nil
+}
+
+//ServiceConfigOrder represents the order of all service configs at Instana. The order defines the precedence of the service configs
+type ServiceConfigOrder struct {
+	ID               string
+	ServiceConfigIDs []string
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject
+func (o *ServiceConfigOrder) GetIDForResourcePath() string {
+	return o.ID
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (o *ServiceConfigOrder) Validate() error {
+	if len(o.ServiceConfigIDs) == 0 {
+		return errors.New("service config ids are missing")
+	}
+	ids := make(map[string]bool)
+	for _, id := range o.ServiceConfigIDs {
+		if utils.IsBlank(id) {
+			return errors.New("service config id must not be blank")
+		}
+		if ids[id] {
+			return fmt.Errorf("service config id %s is provided multiple times", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
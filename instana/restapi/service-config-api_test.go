@@ -0,0 +1,115 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serviceConfigID    = "service-config-id"
+	serviceConfigName  = "service-config-name"
+	serviceConfigLabel = "{docker.label.app}-{kubernetes.namespace}"
+)
+
+func TestShouldSuccessfullyValidateMinimalServiceConfig(t *testing.T) {
+	config := ServiceConfig{
+		ID:    serviceConfigID,
+		Name:  serviceConfigName,
+		Label: serviceConfigLabel,
+	}
+
+	require.Equal(t, serviceConfigID, config.GetIDForResourcePath())
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldSuccessfullyValidateFullServiceConfig(t *testing.T) {
+	config := createValidServiceConfig()
+
+	require.NoError(t, config.Validate())
+}
+
+func TestShouldFailToValidateServiceConfigWhenMandatoryFieldIsMissing(t *testing.T) {
+	testCases := map[string]func(c *ServiceConfig){
+		"id is missing":                           func(c *ServiceConfig) { c.ID = "" },
+		"name is missing":                         func(c *ServiceConfig) { c.Name = " " },
+		"label is missing":                        func(c *ServiceConfig) { c.Label = "" },
+		"key of match specification is missing":   func(c *ServiceConfig) { c.MatchSpecification[0].Key = "" },
+		"value of match specification is missing": func(c *ServiceConfig) { c.MatchSpecification[0].Value = "" },
+	}
+
+	for expectedError, modifier := range testCases {
+		t.Run("Should fail with error "+expectedError, func(t *testing.T) {
+			config := createValidServiceConfig()
+			modifier(config)
+
+			err := config.Validate()
+
+			require.Error(t, err)
+			require.Equal(t, expectedError, err.Error())
+		})
+	}
+}
+
+func TestShouldFailToValidateServiceConfigWhenTooManyMatchSpecificationsAreProvided(t *testing.T) {
+	config := createValidServiceConfig()
+	config.MatchSpecification = make([]ServiceMatchingRule, MaxServiceMatchingRules+1)
+	for i := range config.MatchSpecification {
+		config.MatchSpecification[i] = ServiceMatchingRule{Key: "key", Value: "value"}
+	}
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maximum of 20 match specifications")
+}
+
+func TestShouldSuccessfullyValidateServiceConfigOrder(t *testing.T) {
+	order := ServiceConfigOrder{ID: "order-id", ServiceConfigIDs: []string{"id1", "id2"}}
+
+	require.Equal(t, "order-id", order.GetIDForResourcePath())
+	require.NoError(t, order.Validate())
+}
+
+func TestShouldFailToValidateServiceConfigOrderWhenNoServiceConfigIDIsProvided(t *testing.T) {
+	order := ServiceConfigOrder{ID: "order-id"}
+
+	err := order.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "service config ids are missing")
+}
+
+func TestShouldFailToValidateServiceConfigOrderWhenServiceConfigIDIsBlank(t *testing.T) {
+	order := ServiceConfigOrder{ID: "order-id", ServiceConfigIDs: []string{"id1", " "}}
+
+	err := order.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must not be blank")
+}
+
+func TestShouldFailToValidateServiceConfigOrderWhenServiceConfigIDIsProvidedMultipleTimes(t *testing.T) {
+	order := ServiceConfigOrder{ID: "order-id", ServiceConfigIDs: []string{"id1", "id2", "id1"}}
+
+	err := order.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "id1 is provided multiple times")
+}
+
+func createValidServiceConfig() *ServiceConfig {
+	return &ServiceConfig{
+		ID:      serviceConfigID,
+		Name:    serviceConfigName,
+		Label:   serviceConfigLabel,
+		Comment: utils.StringPtr("comment"),
+		Enabled: true,
+		MatchSpecification: []ServiceMatchingRule{
+			{Key: "docker.label.app", Value: "*"},
+			{Key: "kubernetes.namespace", Value: "*"},
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+//NewServiceConfigOrderRestResource creates a new REST resource for the order of the service configs. The order is not a
+//resource on its own at Instana. It is read from the list of service configs and updated through the order endpoint.
+func NewServiceConfigOrderRestResource(client RestClient) RestResource {
+	return &serviceConfigOrderRestResource{
+		client: client,
+	}
+}
+
+type serviceConfigOrderRestResource struct {
+	client RestClient
+}
+
+//serviceConfigOrderRequest the payload of the order endpoint which is a plain JSON array of the service config IDs
+type serviceConfigOrderRequest []string
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the path element of the order endpoint
+func (r serviceConfigOrderRequest) GetIDForResourcePath() string {
+	return ServiceConfigOrderPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject
+func (r serviceConfigOrderRequest) Validate() error {
+	return nil
+}
+
+func (r *serviceConfigOrderRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	order, err := r.GetOne(ctx, ServiceConfigOrderPathElement)
+	if err != nil {
+		return nil, err
+	}
+	return &[]InstanaDataObject{order}, nil
+}
+
+func (r *serviceConfigOrderRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, ServiceConfigsResourcePath)
+	if err != nil {
+		return nil, err
+	}
+	serviceConfigs := make([]ServiceConfig, 0)
+	if err := json.Unmarshal(data, &serviceConfigs); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	ids := make([]string, len(serviceConfigs))
+	for i, serviceConfig := range serviceConfigs {
+		ids[i] = serviceConfig.ID
+	}
+	return &ServiceConfigOrder{ID: id, ServiceConfigIDs: ids}, nil
+}
+
+func (r *serviceConfigOrderRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Update(ctx, data)
+}
+
+func (r *serviceConfigOrderRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	order := data.(*ServiceConfigOrder)
+	if _, err := r.client.Put(ctx, serviceConfigOrderRequest(order.ServiceConfigIDs), ServiceConfigsResourcePath); err != nil {
+		return data, err
+	}
+	return r.GetOne(ctx, order.ID)
+}
+
+func (r *serviceConfigOrderRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID the order of the service configs cannot be deleted at Instana. The service configs keep their current order.
+func (r *serviceConfigOrderRestResource) DeleteByID(ctx context.Context, id string) error {
+	return nil
+}
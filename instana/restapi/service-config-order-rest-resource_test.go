@@ -0,0 +1,137 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const serviceConfigOrderID = "service-config-order-id"
+
+var serviceConfigsResponse = []byte(`[{"id":"id1","name":"name1"},{"id":"id2","name":"name2"}]`)
+
+func TestShouldReturnServiceConfigOrderFromListOfServiceConfigsWhenExecutingGetOneOperationOfServiceConfigOrderRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigsResourcePath).Times(1).Return(serviceConfigsResponse, nil)
+
+	result, err := NewServiceConfigOrderRestResource(client).GetOne(context.Background(), serviceConfigOrderID)
+
+	require.NoError(t, err)
+	require.Equal(t, &ServiceConfigOrder{ID: serviceConfigOrderID, ServiceConfigIDs: []string{"id1", "id2"}}, result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOneOperationOfServiceConfigOrderRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigsResourcePath).Times(1).Return(nil, expectedError)
+
+	_, err := NewServiceConfigOrderRestResource(client).GetOne(context.Background(), serviceConfigOrderID)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldReturnErrorWhenExecutingGetOneOperationOfServiceConfigOrderRestResourceAndResponseIsNotAJsonArray(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigsResourcePath).Times(1).Return([]byte(`{"id":"id1"}`), nil)
+
+	_, err := NewServiceConfigOrderRestResource(client).GetOne(context.Background(), serviceConfigOrderID)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to parse json")
+}
+
+func TestShouldReturnSingleServiceConfigOrderWhenExecutingGetAllOperationOfServiceConfigOrderRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Get(gomock.Any(), ServiceConfigsResourcePath).Times(1).Return(serviceConfigsResponse, nil)
+
+	result, err := NewServiceConfigOrderRestResource(client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{&ServiceConfigOrder{ID: ServiceConfigOrderPathElement, ServiceConfigIDs: []string{"id1", "id2"}}}, result)
+}
+
+func TestShouldPutServiceConfigIDsToOrderEndpointWhenExecutingCreateAndUpdateOperationOfServiceConfigOrderRestResource(t *testing.T) {
+	for name, operation := range map[string]func(RestResource, context.Context, InstanaDataObject) (InstanaDataObject, error){
+		"create": RestResource.Create,
+		"update": RestResource.Update,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockRestClient(ctrl)
+			order := &ServiceConfigOrder{ID: serviceConfigOrderID, ServiceConfigIDs: []string{"id2", "id1"}}
+
+			gomock.InOrder(
+				client.EXPECT().Put(gomock.Any(), gomock.Any(), ServiceConfigsResourcePath).Times(1).DoAndReturn(func(_ context.Context, data InstanaDataObject, _ string) ([]byte, error) {
+					require.Equal(t, ServiceConfigOrderPathElement, data.GetIDForResourcePath())
+					serialized, err := json.Marshal(data)
+					require.NoError(t, err)
+					require.JSONEq(t, `["id2","id1"]`, string(serialized))
+					return []byte{}, nil
+				}),
+				client.EXPECT().Get(gomock.Any(), ServiceConfigsResourcePath).Times(1).Return([]byte(`[{"id":"id2"},{"id":"id1"}]`), nil),
+			)
+
+			result, err := operation(NewServiceConfigOrderRestResource(client), context.Background(), order)
+
+			require.NoError(t, err)
+			require.Equal(t, order, result)
+		})
+	}
+}
+
+func TestShouldNotPutServiceConfigOrderWhenOrderIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	order := &ServiceConfigOrder{ID: serviceConfigOrderID}
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewServiceConfigOrderRestResource(client).Update(context.Background(), order)
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnErrorWhenPutOfServiceConfigOrderFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	order := &ServiceConfigOrder{ID: serviceConfigOrderID, ServiceConfigIDs: []string{"id1"}}
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), ServiceConfigsResourcePath).Times(1).Return(nil, expectedError)
+	client.EXPECT().Get(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewServiceConfigOrderRestResource(client).Update(context.Background(), order)
+
+	require.Equal(t, expectedError, err)
+}
+
+func TestShouldNotCallInstanaAPIWhenDeletingServiceConfigOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	sut := NewServiceConfigOrderRestResource(client)
+
+	require.NoError(t, sut.Delete(context.Background(), &ServiceConfigOrder{ID: serviceConfigOrderID}))
+	require.NoError(t, sut.DeleteByID(context.Background(), serviceConfigOrderID))
+}
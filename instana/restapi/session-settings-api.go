@@ -0,0 +1,44 @@
+package restapi
+
+import "fmt"
+
+const (
+	//SessionSettingsPathElement path element of the session settings resource of the Instana RESTful API
+	SessionSettingsPathElement = "session"
+	//SessionSettingsResourcePath path to the session settings resource of the Instana RESTful API
+	SessionSettingsResourcePath = SettingsBasePath + "/" + SessionSettingsPathElement
+)
+
+const (
+	//MinSessionIdleTimeInMillis the minimum idle time of sessions in milliseconds (1 minute)
+	MinSessionIdleTimeInMillis = int64(60000)
+	//MaxSessionIdleTimeInMillis the maximum idle time of sessions in milliseconds (8 hours)
+	MaxSessionIdleTimeInMillis = int64(28800000)
+	//MinSessionTokenLifeTimeInMillis the minimum life time of session tokens in milliseconds (15 minutes)
+	MinSessionTokenLifeTimeInMillis = int64(900000)
+	//MaxSessionTokenLifeTimeInMillis the maximum life time of session tokens in milliseconds (7 days)
+	MaxSessionTokenLifeTimeInMillis = int64(604800000)
+)
+
+//SessionSettings data structure for the Instana API model for the tenant wide session settings. The settings exist
+//exactly once per tenant. Settings which are not provided are kept at their current value
+type SessionSettings struct {
+	IdleTimeInMillis      *int64 `json:"idleTimeInMillis,omitempty"`
+	TokenLifeTimeInMillis *int64 `json:"tokenLifeTimeInMillis,omitempty"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the path element of the session settings
+func (s *SessionSettings) GetIDForResourcePath() string {
+	return SessionSettingsPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct
+func (s *SessionSettings) Validate() error {
+	if s.IdleTimeInMillis != nil && (*s.IdleTimeInMillis < MinSessionIdleTimeInMillis || *s.IdleTimeInMillis > MaxSessionIdleTimeInMillis) {
+		return fmt.Errorf("idle time must be between %d and %d milliseconds", MinSessionIdleTimeInMillis, MaxSessionIdleTimeInMillis)
+	}
+	if s.TokenLifeTimeInMillis != nil && (*s.TokenLifeTimeInMillis < MinSessionTokenLifeTimeInMillis || *s.TokenLifeTimeInMillis > MaxSessionTokenLifeTimeInMillis) {
+		return fmt.Errorf("token life time must be between %d and %d milliseconds", MinSessionTokenLifeTimeInMillis, MaxSessionTokenLifeTimeInMillis)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldReturnPathElementAsIDOfSessionSettings(t *testing.T) {
+	settings := SessionSettings{}
+
+	require.Equal(t, SessionSettingsPathElement, settings.GetIDForResourcePath())
+	require.Equal(t, "/api/settings/session", SessionSettingsResourcePath)
+}
+
+func TestShouldSuccessfullyValidateSessionSettings(t *testing.T) {
+	for _, settings := range []SessionSettings{
+		{},
+		{IdleTimeInMillis: utils.Int64Ptr(MinSessionIdleTimeInMillis), TokenLifeTimeInMillis: utils.Int64Ptr(MinSessionTokenLifeTimeInMillis)},
+		{IdleTimeInMillis: utils.Int64Ptr(MaxSessionIdleTimeInMillis), TokenLifeTimeInMillis: utils.Int64Ptr(MaxSessionTokenLifeTimeInMillis)},
+	} {
+		require.NoError(t, settings.Validate())
+	}
+}
+
+func TestShouldFailToValidateSessionSettingsWhenIdleTimeIsOutOfRange(t *testing.T) {
+	for _, idleTime := range []int64{MinSessionIdleTimeInMillis - 1, MaxSessionIdleTimeInMillis + 1} {
+		settings := SessionSettings{IdleTimeInMillis: utils.Int64Ptr(idleTime)}
+
+		err := settings.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "idle time")
+	}
+}
+
+func TestShouldFailToValidateSessionSettingsWhenTokenLifeTimeIsOutOfRange(t *testing.T) {
+	for _, tokenLifeTime := range []int64{MinSessionTokenLifeTimeInMillis - 1, MaxSessionTokenLifeTimeInMillis + 1} {
+		settings := SessionSettings{TokenLifeTimeInMillis: utils.Int64Ptr(tokenLifeTime)}
+
+		err := settings.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "token life time")
+	}
+}
@@ -0,0 +1,88 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+)
+
+//NewSingletonRestResource creates a new REST resource for settings which exist exactly once per Instana tenant. The
+//settings are available at the given path element below the resource base path. Therefore, the InstanaDataObject must
+//return the path element as ID. Create and update are implemented as HTTP PUT. Delete resets the settings to the
+//defaults of Instana using HTTP DELETE.
+func NewSingletonRestResource(resourceBasePath string, pathElement string, unmarshaller JSONUnmarshaller, client RestClient) RestResource {
+	return &singletonRestResource{
+		resourceBasePath: resourceBasePath,
+		pathElement:      pathElement,
+		unmarshaller:     unmarshaller,
+		client:           client,
+	}
+}
+
+type singletonRestResource struct {
+	resourceBasePath string
+	pathElement      string
+	unmarshaller     JSONUnmarshaller
+	client           RestClient
+}
+
+func (r *singletonRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	object, err := r.GetOne(ctx, r.pathElement)
+	if err != nil {
+		return nil, err
+	}
+	return &[]InstanaDataObject{object}, nil
+}
+
+//GetOne returns the settings. The ID is ignored as the settings exist exactly once
+func (r *singletonRestResource) GetOne(ctx context.Context, _ string) (InstanaDataObject, error) {
+	data, err := r.client.GetOne(ctx, r.pathElement, r.resourceBasePath)
+	if err != nil {
+		return nil, err
+	}
+	return r.validateResponseAndConvertToStruct(data)
+}
+
+func (r *singletonRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return r.Update(ctx, data)
+}
+
+func (r *singletonRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	if err := data.Validate(); err != nil {
+		return data, err
+	}
+	if data.GetIDForResourcePath() != r.pathElement {
+		return data, errors.New("singleton object does not return the path element of the resource as ID")
+	}
+	response, err := r.client.Put(ctx, data, r.resourceBasePath)
+	if err != nil {
+		return data, err
+	}
+	if len(response) == 0 {
+		return r.GetOne(ctx, r.pathElement)
+	}
+	return r.validateResponseAndConvertToStruct(response)
+}
+
+func (r *singletonRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
+	object, err := r.unmarshaller.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dataObject, ok := object.(InstanaDataObject)
+	if !ok {
+		return nil, errors.New("unmarshalled object does not implement InstanaDataObject")
+	}
+	if err := dataObject.Validate(); err != nil {
+		return dataObject, err
+	}
+	return dataObject, nil
+}
+
+func (r *singletonRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
+}
+
+//DeleteByID resets the settings to the defaults of Instana. The ID is ignored as the settings exist exactly once
+func (r *singletonRestResource) DeleteByID(ctx context.Context, _ string) error {
+	return r.client.Delete(ctx, r.pathElement, r.resourceBasePath)
+}
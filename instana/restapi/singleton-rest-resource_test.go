@@ -0,0 +1,154 @@
+package restapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const singletonSessionSettingsResponse = `{"idleTimeInMillis":3600000,"tokenLifeTimeInMillis":86400000}`
+
+func createSessionSettingsForSingletonTest() *SessionSettings {
+	return &SessionSettings{IdleTimeInMillis: utils.Int64Ptr(3600000), TokenLifeTimeInMillis: utils.Int64Ptr(86400000)}
+}
+
+func createSingletonRestResourceForTest(client RestClient) RestResource {
+	return NewSingletonRestResource(SettingsBasePath, SessionSettingsPathElement, NewDefaultJSONUnmarshaller(&SessionSettings{}), client)
+}
+
+func TestShouldReturnSingletonObjectIgnoringTheProvidedID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return([]byte(singletonSessionSettingsResponse), nil)
+
+	result, err := createSingletonRestResourceForTest(client).GetOne(context.Background(), "other-id")
+
+	require.NoError(t, err)
+	require.Equal(t, createSessionSettingsForSingletonTest(), result)
+}
+
+func TestShouldReturnSingletonObjectAsOnlyElementOfAllObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return([]byte(singletonSessionSettingsResponse), nil)
+
+	result, err := createSingletonRestResourceForTest(client).GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{createSessionSettingsForSingletonTest()}, result)
+}
+
+func TestShouldFailToReturnSingletonObjectWhenErrorIsReturnedFromRestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().GetOne(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return(nil, expectedError)
+
+	_, err := createSingletonRestResourceForTest(client).GetAll(context.Background())
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldFailToReturnSingletonObjectWhenResponseIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().GetOne(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return([]byte(`{"idleTimeInMillis":1}`), nil)
+
+	_, err := createSingletonRestResourceForTest(client).GetOne(context.Background(), SessionSettingsPathElement)
+
+	require.Error(t, err)
+}
+
+func TestShouldCreateSingletonObjectUsingHttpPut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	settings := createSessionSettingsForSingletonTest()
+
+	client.EXPECT().Put(gomock.Any(), settings, SettingsBasePath).Times(1).Return([]byte(singletonSessionSettingsResponse), nil)
+
+	result, err := createSingletonRestResourceForTest(client).Create(context.Background(), settings)
+
+	require.NoError(t, err)
+	require.Equal(t, settings, result)
+}
+
+func TestShouldReadSingletonObjectAfterUpdateWhenResponseIsEmpty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	settings := createSessionSettingsForSingletonTest()
+
+	gomock.InOrder(
+		client.EXPECT().Put(gomock.Any(), settings, SettingsBasePath).Times(1).Return([]byte{}, nil),
+		client.EXPECT().GetOne(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return([]byte(singletonSessionSettingsResponse), nil),
+	)
+
+	result, err := createSingletonRestResourceForTest(client).Update(context.Background(), settings)
+
+	require.NoError(t, err)
+	require.Equal(t, settings, result)
+}
+
+func TestShouldFailToUpdateSingletonObjectWhenObjectIsNotValid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := createSingletonRestResourceForTest(client).Update(context.Background(), &SessionSettings{IdleTimeInMillis: utils.Int64Ptr(1)})
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUpdateSingletonObjectWhenObjectDoesNotReturnPathElementAsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := NewSingletonRestResource(SettingsBasePath, "other", NewDefaultJSONUnmarshaller(&SessionSettings{}), client).Update(context.Background(), createSessionSettingsForSingletonTest())
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUpdateSingletonObjectWhenErrorIsReturnedFromRestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	expectedError := errors.New("test")
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), SettingsBasePath).Times(1).Return(nil, expectedError)
+
+	_, err := createSingletonRestResourceForTest(client).Update(context.Background(), createSessionSettingsForSingletonTest())
+
+	require.ErrorIs(t, err, expectedError)
+}
+
+func TestShouldResetSingletonObjectUsingHttpDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), SessionSettingsPathElement, SettingsBasePath).Times(1).Return(nil)
+
+	err := createSingletonRestResourceForTest(client).Delete(context.Background(), createSessionSettingsForSingletonTest())
+
+	require.NoError(t, err)
+}
@@ -0,0 +1,179 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const (
+	//SliConfigV2ResourcePath path to sli config resource of version 2 of the Instana RESTful API
+	SliConfigV2ResourcePath = SettingsBasePath + "/v2/sli"
+)
+
+//SliType custom type for the type of an SLI entity
+type SliType string
+
+const (
+	//SliTypeApplication constant value for the time based application SLI type
+	SliTypeApplication = SliType("application")
+	//SliTypeAvailability constant value for the event based application SLI type
+	SliTypeAvailability = SliType("availability")
+	//SliTypeWebsiteEventBased constant value for the event based website SLI type
+	SliTypeWebsiteEventBased = SliType("websiteEventBased")
+	//SliTypeWebsiteTimeBased constant value for the time based website SLI type
+	SliTypeWebsiteTimeBased = SliType("websiteTimeBased")
+)
+
+//BeaconType custom type for the beacon type of website SLI entities
+type BeaconType string
+
+//BeaconTypes custom type for a slice of BeaconType
+type BeaconTypes []BeaconType
+
+//IsSupported check if the provided BeaconType is supported
+func (types BeaconTypes) IsSupported(beaconType BeaconType) bool {
+	for _, t := range types {
+		if t == beaconType {
+			return true
+		}
+	}
+	return false
+}
+
+//ToStringSlice Returns the corresponding string representations
+func (types BeaconTypes) ToStringSlice() []string {
+	result := make([]string, len(types))
+	for i, v := range types {
+		result[i] = string(v)
+	}
+	return result
+}
+
+const (
+	//BeaconTypePageLoad constant value for the beacon type PAGELOAD
+	BeaconTypePageLoad = BeaconType("PAGELOAD")
+	//BeaconTypeResourceLoad constant value for the beacon type RESOURCELOAD
+	BeaconTypeResourceLoad = BeaconType("RESOURCELOAD")
+	//BeaconTypeHTTPRequest constant value for the beacon type HTTPREQUEST
+	BeaconTypeHTTPRequest = BeaconType("HTTPREQUEST")
+	//BeaconTypeError constant value for the beacon type ERROR
+	BeaconTypeError = BeaconType("ERROR")
+	//BeaconTypeCustom constant value for the beacon type CUSTOM
+	BeaconTypeCustom = BeaconType("CUSTOM")
+	//BeaconTypePageChange constant value for the beacon type PAGE_CHANGE
+	BeaconTypePageChange = BeaconType("PAGE_CHANGE")
+)
+
+//SupportedBeaconTypes list of all supported BeaconTypes
+var SupportedBeaconTypes = BeaconTypes{BeaconTypePageLoad, BeaconTypeResourceLoad, BeaconTypeHTTPRequest, BeaconTypeError, BeaconTypeCustom, BeaconTypePageChange}
+
+//SliEntityV2 represents the nested object sli entity of the sli config REST resource of version 2 of the Instana API.
+//The sli type defines which of the fields are used.
+type SliEntityV2 struct {
+	Type                      SliType        `json:"sliType"`
+	ApplicationID             *string        `json:"applicationId,omitempty"`
+	ServiceID                 *string        `json:"serviceId,omitempty"`
+	EndpointID                *string        `json:"endpointId,omitempty"`
+	BoundaryScope             *BoundaryScope `json:"boundaryScope,omitempty"`
+	IncludeInternal           *bool          `json:"includeInternal,omitempty"`
+	IncludeSynthetic          *bool          `json:"includeSynthetic,omitempty"`
+	WebsiteID                 *string        `json:"websiteId,omitempty"`
+	BeaconType                *BeaconType    `json:"beaconType,omitempty"`
+	FilterExpression          interface{}    `json:"filterExpression,omitempty"`
+	GoodEventFilterExpression interface{}    `json:"goodEventFilterExpression,omitempty"`
+	BadEventFilterExpression  interface{}    `json:"badEventFilterExpression,omitempty"`
+}
+
+//Validate implemention of the interface InstanaDataObject for SliEntityV2
+func (s SliEntityV2) Validate() error {
+	switch s.Type {
+	case SliTypeApplication:
+		return s.validateApplicationEntity()
+	case SliTypeAvailability:
+		if err := s.validateApplicationEntity(); err != nil {
+			return err
+		}
+		return s.validateEventFilterExpressions()
+	case SliTypeWebsiteEventBased:
+		if err := s.validateWebsiteEntity(); err != nil {
+			return err
+		}
+		return s.validateEventFilterExpressions()
+	case SliTypeWebsiteTimeBased:
+		return s.validateWebsiteEntity()
+	default:
+		return fmt.Errorf("sli type %s is not supported", s.Type)
+	}
+}
+
+func (s SliEntityV2) validateApplicationEntity() error {
+	if s.ApplicationID == nil || utils.IsBlank(*s.ApplicationID) {
+		return errors.New("application id is missing")
+	}
+	if s.BoundaryScope == nil || !SupportedSliBoundaryScopes.IsSupported(*s.BoundaryScope) {
+		return errors.New("boundary scope is missing or not supported")
+	}
+	return nil
+}
+
+func (s SliEntityV2) validateWebsiteEntity() error {
+	if s.WebsiteID == nil || utils.IsBlank(*s.WebsiteID) {
+		return errors.New("website id is missing")
+	}
+	if s.BeaconType == nil || !SupportedBeaconTypes.IsSupported(*s.BeaconType) {
+		return errors.New("beacon type is missing or not supported")
+	}
+	return nil
+}
+
+func (s SliEntityV2) validateEventFilterExpressions() error {
+	if s.GoodEventFilterExpression == nil {
+		return errors.New("good event filter expression is missing")
+	}
+	if s.BadEventFilterExpression == nil {
+		return errors.New("bad event filter expression is missing")
+	}
+	return nil
+}
+
+//isTimeBased returns true when the SLI is calculated from a metric and therefore requires a metric configuration
+func (s SliEntityV2) isTimeBased() bool {
+	return s.Type == SliTypeApplication || s.Type == SliTypeWebsiteTimeBased
+}
+
+//SliConfigV2 represents the REST resource of sli configuration of version 2 of the Instana API. In contrast to version
+//1 the API supports event based and website SLIs. SLI configurations of version 2 cannot be updated.
+type SliConfigV2 struct {
+	ID                         string               `json:"id"`
+	Name                       string               `json:"sliName"`
+	InitialEvaluationTimestamp int                  `json:"initialEvaluationTimestamp"`
+	MetricConfiguration        *MetricConfiguration `json:"metricConfiguration,omitempty"`
+	SliEntity                  SliEntityV2          `json:"sliEntity"`
+}
+
+//GetIDForResourcePath implemention of the interface InstanaDataObject
+func (s *SliConfigV2) GetIDForResourcePath() string {
+	return s.ID
+}
+
+//Validate implemention of the interface InstanaDataObject for SliConfigV2
+func (s *SliConfigV2) Validate() error {
+	if utils.IsBlank(s.ID) {
+		return errors.New("id is missing")
+	}
+	if utils.IsBlank(s.Name) {
+		return errors.New("sli name is missing")
+	}
+	if err := s.SliEntity.Validate(); err != nil {
+		return err
+	}
+	if s.MetricConfiguration != nil {
+		return s.MetricConfiguration.Validate()
+	}
+	if s.SliEntity.isTimeBased() {
+		return fmt.Errorf("metric configuration is required for sli type %s", s.SliEntity.Type)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sliConfigV2ID            = "sli-config-v2-id"
+	sliConfigV2Name          = "sli-config-v2-name"
+	sliConfigV2ApplicationID = "application-id"
+	sliConfigV2WebsiteID     = "website-id"
+)
+
+func createValidApplicationSliConfigV2() *SliConfigV2 {
+	boundaryScope := BoundaryScopeInbound
+	return &SliConfigV2{
+		ID:   sliConfigV2ID,
+		Name: sliConfigV2Name,
+		MetricConfiguration: &MetricConfiguration{
+			Name:        "calls",
+			Aggregation: "SUM",
+			Threshold:   1.0,
+		},
+		SliEntity: SliEntityV2{
+			Type:          SliTypeApplication,
+			ApplicationID: utils.StringPtr(sliConfigV2ApplicationID),
+			ServiceID:     utils.StringPtr("service-id"),
+			BoundaryScope: &boundaryScope,
+		},
+	}
+}
+
+func createValidAvailabilitySliConfigV2() *SliConfigV2 {
+	boundaryScope := BoundaryScopeAll
+	return &SliConfigV2{
+		ID:   sliConfigV2ID,
+		Name: sliConfigV2Name,
+		SliEntity: SliEntityV2{
+			Type:                      SliTypeAvailability,
+			ApplicationID:             utils.StringPtr(sliConfigV2ApplicationID),
+			BoundaryScope:             &boundaryScope,
+			IncludeInternal:           utils.BoolPtr(true),
+			IncludeSynthetic:          utils.BoolPtr(false),
+			GoodEventFilterExpression: NewStringTagFilter(TagFilterEntityNotApplicable, "call.http.status", EqualsOperator, "200"),
+			BadEventFilterExpression:  NewStringTagFilter(TagFilterEntityNotApplicable, "call.http.status", EqualsOperator, "500"),
+		},
+	}
+}
+
+func createValidWebsiteEventBasedSliConfigV2() *SliConfigV2 {
+	beaconType := BeaconTypePageLoad
+	return &SliConfigV2{
+		ID:   sliConfigV2ID,
+		Name: sliConfigV2Name,
+		SliEntity: SliEntityV2{
+			Type:                      SliTypeWebsiteEventBased,
+			WebsiteID:                 utils.StringPtr(sliConfigV2WebsiteID),
+			BeaconType:                &beaconType,
+			GoodEventFilterExpression: NewUnaryTagFilter(TagFilterEntityNotApplicable, "beacon.error.message", IsEmptyOperator),
+			BadEventFilterExpression:  NewUnaryTagFilter(TagFilterEntityNotApplicable, "beacon.error.message", NotEmptyOperator),
+		},
+	}
+}
+
+func createValidWebsiteTimeBasedSliConfigV2() *SliConfigV2 {
+	beaconType := BeaconTypeHTTPRequest
+	return &SliConfigV2{
+		ID:   sliConfigV2ID,
+		Name: sliConfigV2Name,
+		MetricConfiguration: &MetricConfiguration{
+			Name:        "duration",
+			Aggregation: "P90",
+			Threshold:   500.0,
+		},
+		SliEntity: SliEntityV2{
+			Type:             SliTypeWebsiteTimeBased,
+			WebsiteID:        utils.StringPtr(sliConfigV2WebsiteID),
+			BeaconType:       &beaconType,
+			FilterExpression: NewStringTagFilter(TagFilterEntityNotApplicable, "beacon.page.name", EqualsOperator, "checkout"),
+		},
+	}
+}
+
+func TestShouldSuccessfullyValidateSliConfigV2OfAllSupportedTypes(t *testing.T) {
+	for name, config := range map[string]*SliConfigV2{
+		"application":       createValidApplicationSliConfigV2(),
+		"availability":      createValidAvailabilitySliConfigV2(),
+		"websiteEventBased": createValidWebsiteEventBasedSliConfigV2(),
+		"websiteTimeBased":  createValidWebsiteTimeBasedSliConfigV2(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, config.Validate())
+			require.Equal(t, sliConfigV2ID, config.GetIDForResourcePath())
+		})
+	}
+}
+
+func TestShouldFailToValidateSliConfigV2WhenIDIsMissing(t *testing.T) {
+	config := createValidApplicationSliConfigV2()
+	config.ID = ""
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "id")
+}
+
+func TestShouldFailToValidateSliConfigV2WhenNameIsMissing(t *testing.T) {
+	config := createValidApplicationSliConfigV2()
+	config.Name = " "
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sli name")
+}
+
+func TestShouldFailToValidateSliConfigV2WhenSliTypeIsNotSupported(t *testing.T) {
+	config := createValidApplicationSliConfigV2()
+	config.SliEntity.Type = SliType("custom")
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sli type custom is not supported")
+}
+
+func TestShouldFailToValidateSliConfigV2WhenTimeBasedSliHasNoMetricConfiguration(t *testing.T) {
+	for name, config := range map[string]*SliConfigV2{
+		"application":      createValidApplicationSliConfigV2(),
+		"websiteTimeBased": createValidWebsiteTimeBasedSliConfigV2(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			config.MetricConfiguration = nil
+
+			err := config.Validate()
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "metric configuration is required")
+		})
+	}
+}
+
+func TestShouldFailToValidateSliConfigV2WhenMetricConfigurationIsNotValid(t *testing.T) {
+	config := createValidApplicationSliConfigV2()
+	config.MetricConfiguration.Threshold = 0
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "threshold")
+}
+
+func TestShouldFailToValidateApplicationSliConfigV2WhenApplicationIDIsMissing(t *testing.T) {
+	config := createValidAvailabilitySliConfigV2()
+	config.SliEntity.ApplicationID = nil
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "application id")
+}
+
+func TestShouldFailToValidateApplicationSliConfigV2WhenBoundaryScopeIsNotSupported(t *testing.T) {
+	config := createValidApplicationSliConfigV2()
+	boundaryScope := BoundaryScopeDefault
+	config.SliEntity.BoundaryScope = &boundaryScope
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "boundary scope")
+}
+
+func TestShouldFailToValidateWebsiteSliConfigV2WhenWebsiteIDIsMissing(t *testing.T) {
+	config := createValidWebsiteTimeBasedSliConfigV2()
+	config.SliEntity.WebsiteID = utils.StringPtr("")
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "website id")
+}
+
+func TestShouldFailToValidateWebsiteSliConfigV2WhenBeaconTypeIsNotSupported(t *testing.T) {
+	config := createValidWebsiteEventBasedSliConfigV2()
+	beaconType := BeaconType("INVALID")
+	config.SliEntity.BeaconType = &beaconType
+
+	err := config.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "beacon type")
+}
+
+func TestShouldFailToValidateEventBasedSliConfigV2WhenEventFilterExpressionIsMissing(t *testing.T) {
+	t.Run("good event filter", func(t *testing.T) {
+		config := createValidWebsiteEventBasedSliConfigV2()
+		config.SliEntity.GoodEventFilterExpression = nil
+
+		err := config.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "good event filter expression")
+	})
+	t.Run("bad event filter", func(t *testing.T) {
+		config := createValidAvailabilitySliConfigV2()
+		config.SliEntity.BadEventFilterExpression = nil
+
+		err := config.Validate()
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bad event filter expression")
+	})
+}
+
+func TestShouldReturnSupportedBeaconTypesAsStringSlice(t *testing.T) {
+	require.Equal(t, []string{"PAGELOAD", "RESOURCELOAD", "HTTPREQUEST", "ERROR", "CUSTOM", "PAGE_CHANGE"}, SupportedBeaconTypes.ToStringSlice())
+}
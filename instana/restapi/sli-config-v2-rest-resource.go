@@ -0,0 +1,24 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+)
+
+//NewSliConfigV2RestResource creates a new REST resource for SLI configurations of version 2 of the Instana API.
+//SLI configurations are created via POST. The API does not provide an update operation; changes require a replacement
+//of the SLI configuration.
+func NewSliConfigV2RestResource(client RestClient) RestResource {
+	return &sliConfigV2RestResource{
+		RestResource: NewCreatePOSTUpdatePUTRestResource(SliConfigV2ResourcePath, NewSliConfigV2Unmarshaller(), client),
+	}
+}
+
+type sliConfigV2RestResource struct {
+	RestResource
+}
+
+//Update returns an error as SLI configurations of version 2 of the Instana API cannot be updated
+func (r *sliConfigV2RestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
+	return data, errors.New("sli configurations of the v2 API cannot be updated; changes require a replacement of the sli configuration")
+}
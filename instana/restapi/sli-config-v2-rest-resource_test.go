@@ -0,0 +1,78 @@
+package restapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	mocks "github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldCreateSliConfigV2ViaPostRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidAvailabilitySliConfigV2()
+	response, _ := json.Marshal(config)
+
+	client.EXPECT().Post(gomock.Any(), config, SliConfigV2ResourcePath).Times(1).Return(response, nil)
+
+	sut := NewSliConfigV2RestResource(client)
+
+	result, err := sut.Create(context.Background(), config)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldGetSliConfigV2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidWebsiteEventBasedSliConfigV2()
+	response, _ := json.Marshal(config)
+
+	client.EXPECT().GetOne(gomock.Any(), sliConfigV2ID, SliConfigV2ResourcePath).Times(1).Return(response, nil)
+
+	sut := NewSliConfigV2RestResource(client)
+
+	result, err := sut.GetOne(context.Background(), sliConfigV2ID)
+
+	require.NoError(t, err)
+	require.Equal(t, config, result)
+}
+
+func TestShouldReturnErrorWhenUpdatingSliConfigV2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	config := createValidApplicationSliConfigV2()
+
+	client.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	client.EXPECT().Post(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	sut := NewSliConfigV2RestResource(client)
+
+	result, err := sut.Update(context.Background(), config)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be updated")
+	require.Equal(t, config, result)
+}
+
+func TestShouldDeleteSliConfigV2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+
+	client.EXPECT().Delete(gomock.Any(), sliConfigV2ID, SliConfigV2ResourcePath).Times(1).Return(nil)
+
+	sut := NewSliConfigV2RestResource(client)
+
+	err := sut.DeleteByID(context.Background(), sliConfigV2ID)
+
+	require.NoError(t, err)
+}
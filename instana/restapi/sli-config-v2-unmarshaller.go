@@ -0,0 +1,53 @@
+package restapi
+
+import (
+	"encoding/json"
+)
+
+//NewSliConfigV2Unmarshaller creates a new Unmarshaller instance for SliConfigV2
+func NewSliConfigV2Unmarshaller() JSONUnmarshaller {
+	return &sliConfigV2Unmarshaller{
+		tagFilterUnmarshaller: NewTagFilterUnmarshaller(),
+	}
+}
+
+type sliConfigV2Unmarshaller struct {
+	tagFilterUnmarshaller TagFilterUnmarshaller
+}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *sliConfigV2Unmarshaller) Unmarshal(data []byte) (interface{}, error) {
+	var rawFilterExpression json.RawMessage
+	var rawGoodEventFilterExpression json.RawMessage
+	var rawBadEventFilterExpression json.RawMessage
+	temp := &SliConfigV2{
+		SliEntity: SliEntityV2{
+			FilterExpression:          &rawFilterExpression,
+			GoodEventFilterExpression: &rawGoodEventFilterExpression,
+			BadEventFilterExpression:  &rawBadEventFilterExpression,
+		},
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return &SliConfigV2{}, err
+	}
+
+	var err error
+	if temp.SliEntity.FilterExpression, err = u.unmarshalTagFilter(rawFilterExpression); err != nil {
+		return &SliConfigV2{}, err
+	}
+	if temp.SliEntity.GoodEventFilterExpression, err = u.unmarshalTagFilter(rawGoodEventFilterExpression); err != nil {
+		return &SliConfigV2{}, err
+	}
+	if temp.SliEntity.BadEventFilterExpression, err = u.unmarshalTagFilter(rawBadEventFilterExpression); err != nil {
+		return &SliConfigV2{}, err
+	}
+	return temp, nil
+}
+
+//unmarshalTagFilter converts the given raw tag filter expression. Missing and null expressions are mapped to nil
+func (u *sliConfigV2Unmarshaller) unmarshalTagFilter(raw json.RawMessage) (TagFilterExpressionElement, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	return u.tagFilterUnmarshaller.Unmarshal(raw)
+}
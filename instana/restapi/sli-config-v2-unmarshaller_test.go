@@ -0,0 +1,73 @@
+package restapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldSuccessfullyUnmarshalSliConfigV2(t *testing.T) {
+	for name, config := range map[string]*SliConfigV2{
+		"application":       createValidApplicationSliConfigV2(),
+		"availability":      createValidAvailabilitySliConfigV2(),
+		"websiteEventBased": createValidWebsiteEventBasedSliConfigV2(),
+		"websiteTimeBased":  createValidWebsiteTimeBasedSliConfigV2(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			serializedJSON, _ := json.Marshal(config)
+
+			result, err := NewSliConfigV2Unmarshaller().Unmarshal(serializedJSON)
+
+			require.NoError(t, err)
+			require.Equal(t, config, result)
+		})
+	}
+}
+
+func TestShouldUnmarshalSliConfigV2WithNullTagFilterExpressions(t *testing.T) {
+	response := `{"id":"id","sliName":"name","sliEntity":{"sliType":"application","filterExpression":null,"goodEventFilterExpression":null,"badEventFilterExpression":null}}`
+
+	result, err := NewSliConfigV2Unmarshaller().Unmarshal([]byte(response))
+
+	require.NoError(t, err)
+	require.Equal(t, &SliConfigV2{ID: "id", Name: "name", SliEntity: SliEntityV2{Type: SliTypeApplication}}, result)
+}
+
+func TestShouldFailToUnmarshalSliConfigV2WhenTagFilterExpressionIsNotValid(t *testing.T) {
+	for _, field := range []string{"filterExpression", "goodEventFilterExpression", "badEventFilterExpression"} {
+		t.Run(field, func(t *testing.T) {
+			response := `{"id":"id","sliEntity":{"sliType":"availability","` + field + `":{"type":"INVALID"}}}`
+
+			_, err := NewSliConfigV2Unmarshaller().Unmarshal([]byte(response))
+
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestShouldFailToUnmarshalSliConfigV2WhenResponseIsAJsonArray(t *testing.T) {
+	response := `["foo","bar"]`
+
+	_, err := NewSliConfigV2Unmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+}
+
+func TestShouldReturnEmptySliConfigV2WhenNoFieldOfResponseMatchesToModel(t *testing.T) {
+	response := `{"foo" : "bar"}`
+
+	result, err := NewSliConfigV2Unmarshaller().Unmarshal([]byte(response))
+
+	require.NoError(t, err)
+	require.Equal(t, &SliConfigV2{}, result)
+}
+
+func TestShouldFailToUnmarshalSliConfigV2WhenResponseIsNotAValidJson(t *testing.T) {
+	response := `Invalid Data`
+
+	_, err := NewSliConfigV2Unmarshaller().Unmarshal([]byte(response))
+
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+//SliReportResourcePath path to the SLI report resource of Instana RESTful API
+const SliReportResourcePath = InstanaAPIBasePath + "/sli/report"
+
+//SliReportQuery the parameters of a SLI report request
+type SliReportQuery struct {
+	SliID string
+	//Slo the service level objective (error budget target), e.g. 0.99 for 99 %
+	Slo float64
+	//From the start of the time window as unix timestamp in milliseconds
+	From int64
+	//To the end of the time window as unix timestamp in milliseconds
+	To int64
+}
+
+//Validate verifies that the query can be sent to the Instana API
+func (q SliReportQuery) Validate() error {
+	if utils.IsBlank(q.SliID) {
+		return errors.New("sli id is missing")
+	}
+	if q.Slo <= 0 || q.Slo >= 1 {
+		return errors.New("slo must be higher than 0.0 and lower than 1.0")
+	}
+	if q.From <= 0 || q.To <= q.From {
+		return errors.New("from must be a positive timestamp and to must be after from")
+	}
+	return nil
+}
+
+func (q SliReportQuery) toQueryParameters() map[string]string {
+	return map[string]string{
+		"slo":  strconv.FormatFloat(q.Slo, 'f', -1, 64),
+		"from": strconv.FormatInt(q.From, 10),
+		"to":   strconv.FormatInt(q.To, 10),
+	}
+}
+
+//SliReport data structure of the SLI report of the Instana API which describes the compliance of a SLI in a time window
+type SliReport struct {
+	Sli                   float64          `json:"sli"`
+	Slo                   float64          `json:"slo"`
+	TotalErrorBudget      int32            `json:"totalErrorBudget"`
+	ErrorBudgetRemaining  int32            `json:"errorBudgetRemaining"`
+	FromTimestamp         int64            `json:"fromTimestamp"`
+	ToTimestamp           int64            `json:"toTimestamp"`
+	ViolationDistribution map[string]int32 `json:"violationDistribution"`
+}
+
+//SliReport implementation of InstanaAPI interface. The Instana API responds with a list of reports; the first report
+//is returned.
+func (api *baseInstanaAPI) SliReport(ctx context.Context, query SliReportQuery) (*SliReport, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	data, err := api.client.GetByQuery(ctx, SliReportResourcePath+"/"+query.SliID, query.toQueryParameters())
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*SliReport, 0)
+	if err := json.Unmarshal(data, &reports); err != nil {
+		return nil, fmt.Errorf("failed to parse json; %s", err)
+	}
+	if len(reports) == 0 || reports[0] == nil {
+		return nil, fmt.Errorf("no sli report returned for sli %s", query.SliID)
+	}
+	return reports[0], nil
+}
@@ -0,0 +1,124 @@
+package restapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const sliReportTestSliID = "sli-id"
+
+func TestShouldSuccessfullyValidateSliReportQuery(t *testing.T) {
+	query := SliReportQuery{SliID: sliReportTestSliID, Slo: 0.99, From: 1000, To: 2000}
+
+	require.NoError(t, query.Validate())
+}
+
+func TestShouldFailToValidateSliReportQueryWhenSliIDIsMissing(t *testing.T) {
+	query := SliReportQuery{SliID: " ", Slo: 0.99, From: 1000, To: 2000}
+
+	err := query.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sli id")
+}
+
+func TestShouldFailToValidateSliReportQueryWhenSloIsOutOfRange(t *testing.T) {
+	for _, slo := range []float64{0, 1, -0.5, 1.5} {
+		t.Run(fmt.Sprintf("TestShouldFailToValidateSliReportQueryWhenSloIs%f", slo), func(t *testing.T) {
+			query := SliReportQuery{SliID: sliReportTestSliID, Slo: slo, From: 1000, To: 2000}
+
+			err := query.Validate()
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "slo")
+		})
+	}
+}
+
+func TestShouldFailToValidateSliReportQueryWhenTimeWindowIsInvalid(t *testing.T) {
+	for _, query := range []SliReportQuery{
+		{SliID: sliReportTestSliID, Slo: 0.99, From: 0, To: 2000},
+		{SliID: sliReportTestSliID, Slo: 0.99, From: 2000, To: 2000},
+		{SliID: sliReportTestSliID, Slo: 0.99, From: 2000, To: 1000},
+	} {
+		t.Run(fmt.Sprintf("TestShouldFailToValidateSliReportQueryWhenTimeWindowIsFrom%dTo%d", query.From, query.To), func(t *testing.T) {
+			err := query.Validate()
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "from")
+		})
+	}
+}
+
+func TestShouldRequestSliReport(t *testing.T) {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, SliReportResourcePath+"/"+sliReportTestSliID, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("slo") != "0.99" || query.Get("from") != "1000" || query.Get("to") != "2000" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[{"sli":0.995,"slo":0.99,"totalErrorBudget":100,"errorBudgetRemaining":50,"fromTimestamp":1000,"toTimestamp":2000,"violationDistribution":{"1000":2}}]`))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()), createTestClientConfig())
+	require.NoError(t, err)
+	result, err := api.SliReport(context.Background(), SliReportQuery{SliID: sliReportTestSliID, Slo: 0.99, From: 1000, To: 2000})
+
+	require.NoError(t, err)
+	require.Equal(t, &SliReport{
+		Sli:                   0.995,
+		Slo:                   0.99,
+		TotalErrorBudget:      100,
+		ErrorBudgetRemaining:  50,
+		FromTimestamp:         1000,
+		ToTimestamp:           2000,
+		ViolationDistribution: map[string]int32{"1000": 2},
+	}, result)
+}
+
+func TestShouldFailToRequestSliReportWhenResponseIsInvalid(t *testing.T) {
+	executeFailingSliReportRequest(t, `invalid`)
+}
+
+func TestShouldFailToRequestSliReportWhenResponseIsEmpty(t *testing.T) {
+	err := executeFailingSliReportRequest(t, `[]`)
+
+	require.Contains(t, err.Error(), "no sli report returned")
+}
+
+func TestShouldFailToRequestSliReportWhenQueryIsInvalid(t *testing.T) {
+	api, err := NewInstanaAPI("api-token", "localhost:1", createTestClientConfig())
+	require.NoError(t, err)
+
+	_, err = api.SliReport(context.Background(), SliReportQuery{SliID: sliReportTestSliID, Slo: 1.5, From: 1000, To: 2000})
+
+	require.Error(t, err)
+}
+
+func executeFailingSliReportRequest(t *testing.T, response string) error {
+	httpServer := testutils.NewTestHTTPServer()
+	httpServer.AddRoute(http.MethodGet, SliReportResourcePath+"/"+sliReportTestSliID, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(response))
+	})
+	httpServer.Start()
+	defer httpServer.Close()
+
+	api, err := NewInstanaAPI("api-token", fmt.Sprintf("localhost:%d", httpServer.GetPort()), createTestClientConfig())
+	require.NoError(t, err)
+	_, err = api.SliReport(context.Background(), SliReportQuery{SliID: sliReportTestSliID, Slo: 0.99, From: 1000, To: 2000})
+
+	require.Error(t, err)
+	return err
+}
@@ -0,0 +1,70 @@
+package restapi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+)
+
+const (
+	//SyntheticCallsSettingsPathElement path element of the synthetic calls settings resource of the Instana RESTful API
+	SyntheticCallsSettingsPathElement = "synthetic-calls"
+	//SyntheticCallsSettingsResourcePath path to the synthetic calls settings resource of the Instana RESTful API
+	SyntheticCallsSettingsResourcePath = SettingsBasePath + "/" + SyntheticCallsSettingsPathElement
+	//MaxSyntheticCallCustomRules the maximum number of custom rules of the synthetic calls settings
+	MaxSyntheticCallCustomRules = 500
+)
+
+//SyntheticCallRule data structure for the Instana API model of a rule which defines which calls are considered as synthetic
+type SyntheticCallRule struct {
+	Name               string          `json:"name"`
+	Description        *string         `json:"description,omitempty"`
+	Enabled            bool            `json:"enabled"`
+	MatchSpecification MatchExpression `json:"matchSpecification"`
+}
+
+//Validate validates the synthetic call rule
+func (r *SyntheticCallRule) Validate() error {
+	if utils.IsBlank(r.Name) {
+		return errors.New("name of synthetic call rule is missing")
+	}
+	if len(r.Name) > 128 {
+		return fmt.Errorf("name of synthetic call rule %s must not be longer than 128 characters", r.Name)
+	}
+	if r.Description != nil && len(*r.Description) > 2048 {
+		return fmt.Errorf("description of synthetic call rule %s must not be longer than 2048 characters", r.Name)
+	}
+	if r.MatchSpecification == nil {
+		return fmt.Errorf("match specification of synthetic call rule %s is missing", r.Name)
+	}
+	return r.MatchSpecification.Validate()
+}
+
+//SyntheticCallsSettings data structure for the Instana API model for the tenant wide settings of synthetic calls. The
+//settings exist exactly once per tenant. The default rules are provided by Instana and are only returned when the
+//settings are read
+type SyntheticCallsSettings struct {
+	CustomRules         []SyntheticCallRule `json:"customRules"`
+	DefaultRulesEnabled bool                `json:"defaultRulesEnabled"`
+	DefaultRules        []SyntheticCallRule `json:"defaultRules,omitempty"`
+}
+
+//GetIDForResourcePath implementation of the interface InstanaDataObject. Returns the path element of the synthetic calls settings
+func (s *SyntheticCallsSettings) GetIDForResourcePath() string {
+	return SyntheticCallsSettingsPathElement
+}
+
+//Validate implementation of the interface InstanaDataObject to verify if data object is correct. Only the custom rules
+//are validated as the default rules are managed by Instana
+func (s *SyntheticCallsSettings) Validate() error {
+	if len(s.CustomRules) > MaxSyntheticCallCustomRules {
+		return fmt.Errorf("at most %d custom rules are supported", MaxSyntheticCallCustomRules)
+	}
+	for i := range s.CustomRules {
+		if err := s.CustomRules[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package restapi_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidSyntheticCallRule() SyntheticCallRule {
+	return SyntheticCallRule{
+		Name:               "health checks",
+		Description:        utils.StringPtr("description"),
+		Enabled:            true,
+		MatchSpecification: NewComparisonExpression("call.http.path", MatcherExpressionEntityNotApplicable, StartsWithOperator, "/health"),
+	}
+}
+
+func TestShouldReturnPathElementAsIDOfSyntheticCallsSettings(t *testing.T) {
+	settings := SyntheticCallsSettings{}
+
+	require.Equal(t, SyntheticCallsSettingsPathElement, settings.GetIDForResourcePath())
+	require.Equal(t, "/api/settings/synthetic-calls", SyntheticCallsSettingsResourcePath)
+}
+
+func TestShouldSuccessfullyValidateSyntheticCallsSettings(t *testing.T) {
+	for _, settings := range []SyntheticCallsSettings{
+		{CustomRules: []SyntheticCallRule{}, DefaultRulesEnabled: true},
+		{CustomRules: []SyntheticCallRule{createValidSyntheticCallRule()}},
+		{CustomRules: []SyntheticCallRule{createValidSyntheticCallRule()}, DefaultRules: []SyntheticCallRule{{Name: "default"}}},
+	} {
+		require.NoError(t, settings.Validate())
+	}
+}
+
+func TestShouldFailToValidateSyntheticCallsSettingsWhenTooManyCustomRulesAreProvided(t *testing.T) {
+	rules := make([]SyntheticCallRule, MaxSyntheticCallCustomRules+1)
+	for i := range rules {
+		rules[i] = createValidSyntheticCallRule()
+	}
+	settings := SyntheticCallsSettings{CustomRules: rules}
+
+	err := settings.Validate()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at most 500 custom rules")
+}
+
+func TestShouldFailToValidateSyntheticCallsSettingsWhenCustomRuleIsNotValid(t *testing.T) {
+	testCases := map[string]func(rule *SyntheticCallRule){
+		"name":                func(rule *SyntheticCallRule) { rule.Name = " " },
+		"name is too long":    func(rule *SyntheticCallRule) { rule.Name = strings.Repeat("a", 129) },
+		"description":         func(rule *SyntheticCallRule) { rule.Description = utils.StringPtr(strings.Repeat("a", 2049)) },
+		"match specification": func(rule *SyntheticCallRule) { rule.MatchSpecification = nil },
+		"match expression": func(rule *SyntheticCallRule) {
+			rule.MatchSpecification = NewComparisonExpression("", MatcherExpressionEntityNotApplicable, EqualsOperator, "value")
+		},
+	}
+	for name, modifier := range testCases {
+		t.Run("Should fail when "+name+" is not valid", func(t *testing.T) {
+			rule := createValidSyntheticCallRule()
+			modifier(&rule)
+			settings := SyntheticCallsSettings{CustomRules: []SyntheticCallRule{rule}}
+
+			require.Error(t, settings.Validate())
+		})
+	}
+}
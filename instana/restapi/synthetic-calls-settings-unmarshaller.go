@@ -0,0 +1,68 @@
+package restapi
+
+import (
+	"encoding/json"
+)
+
+//NewSyntheticCallsSettingsUnmarshaller creates a new Unmarshaller instance for the synthetic calls settings
+func NewSyntheticCallsSettingsUnmarshaller() JSONUnmarshaller {
+	return &syntheticCallsSettingsUnmarshaller{
+		matchExpressionUnmarshaller: NewMatchExpressionUnmarshaller(),
+	}
+}
+
+type syntheticCallsSettingsUnmarshaller struct {
+	matchExpressionUnmarshaller MatchExpressionUnmarshaller
+}
+
+type rawSyntheticCallRule struct {
+	Name               string          `json:"name"`
+	Description        *string         `json:"description"`
+	Enabled            bool            `json:"enabled"`
+	MatchSpecification json.RawMessage `json:"matchSpecification"`
+}
+
+//Unmarshal Unmarshaller interface implementation
+func (u *syntheticCallsSettingsUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
+	temp := struct {
+		CustomRules         []rawSyntheticCallRule `json:"customRules"`
+		DefaultRulesEnabled bool                   `json:"defaultRulesEnabled"`
+		DefaultRules        []rawSyntheticCallRule `json:"defaultRules"`
+	}{}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return &SyntheticCallsSettings{}, err
+	}
+	customRules, err := u.unmarshalRules(temp.CustomRules)
+	if err != nil {
+		return &SyntheticCallsSettings{}, err
+	}
+	defaultRules, err := u.unmarshalRules(temp.DefaultRules)
+	if err != nil {
+		return &SyntheticCallsSettings{}, err
+	}
+	return &SyntheticCallsSettings{
+		CustomRules:         customRules,
+		DefaultRulesEnabled: temp.DefaultRulesEnabled,
+		DefaultRules:        defaultRules,
+	}, nil
+}
+
+func (u *syntheticCallsSettingsUnmarshaller) unmarshalRules(rawRules []rawSyntheticCallRule) ([]SyntheticCallRule, error) {
+	if rawRules == nil {
+		return nil, nil
+	}
+	result := make([]SyntheticCallRule, len(rawRules))
+	for i, rawRule := range rawRules {
+		matchSpecification, err := u.matchExpressionUnmarshaller.Unmarshal(rawRule.MatchSpecification)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = SyntheticCallRule{
+			Name:               rawRule.Name,
+			Description:        rawRule.Description,
+			Enabled:            rawRule.Enabled,
+			MatchSpecification: matchSpecification,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package restapi_test
+
+import (
+	"testing"
+
+	. "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const syntheticCallsSettingsJSON = `{
+	"customRules": [
+		{
+			"name": "health checks",
+			"description": "description",
+			"enabled": true,
+			"matchSpecification": {
+				"type": "BINARY_OP",
+				"conjunction": "AND",
+				"left": { "type": "LEAF", "key": "call.http.path", "entity": "NOT_APPLICABLE", "operator": "STARTS_WITH", "value": "/health" },
+				"right": { "type": "LEAF", "key": "call.http.method", "entity": "NOT_APPLICABLE", "operator": "EQUALS", "value": "GET" }
+			}
+		}
+	],
+	"defaultRulesEnabled": true,
+	"defaultRules": [
+		{
+			"name": "default",
+			"enabled": true,
+			"matchSpecification": { "type": "LEAF", "key": "call.http.header", "entity": "NOT_APPLICABLE", "operator": "NOT_EMPTY" }
+		}
+	]
+}`
+
+func TestShouldSuccessfullyUnmarshalSyntheticCallsSettings(t *testing.T) {
+	result, err := NewSyntheticCallsSettingsUnmarshaller().Unmarshal([]byte(syntheticCallsSettingsJSON))
+
+	require.NoError(t, err)
+	require.Equal(t, &SyntheticCallsSettings{
+		CustomRules: []SyntheticCallRule{
+			{
+				Name:        "health checks",
+				Description: utils.StringPtr("description"),
+				Enabled:     true,
+				MatchSpecification: NewBinaryOperator(
+					NewComparisonExpression("call.http.path", MatcherExpressionEntityNotApplicable, StartsWithOperator, "/health"),
+					LogicalAnd,
+					NewComparisonExpression("call.http.method", MatcherExpressionEntityNotApplicable, EqualsOperator, "GET"),
+				),
+			},
+		},
+		DefaultRulesEnabled: true,
+		DefaultRules: []SyntheticCallRule{
+			{
+				Name:               "default",
+				Enabled:            true,
+				MatchSpecification: NewUnaryOperationExpression("call.http.header", MatcherExpressionEntityNotApplicable, NotEmptyOperator),
+			},
+		},
+	}, result)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallsSettingsWhenResponseIsNotValidJSON(t *testing.T) {
+	_, err := NewSyntheticCallsSettingsUnmarshaller().Unmarshal([]byte("invalid"))
+
+	require.Error(t, err)
+}
+
+func TestShouldFailToUnmarshalSyntheticCallsSettingsWhenMatchSpecificationIsNotValid(t *testing.T) {
+	for _, rulesField := range []string{"customRules", "defaultRules"} {
+		data := `{"` + rulesField + `":[{"name":"rule","enabled":true,"matchSpecification":{"type":"INVALID"}}]}`
+
+		_, err := NewSyntheticCallsSettingsUnmarshaller().Unmarshal([]byte(data))
+
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,11 @@
+package restapi
+
+//UsersResourcePath path to User resource of Instana RESTful API
+const UsersResourcePath = SettingsBasePath + "/users"
+
+//User data structure for the Instana API model for users of the tenant
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+}
@@ -1,53 +1,70 @@
 package restapi
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 //NewWebsiteMonitoringConfigRestResource creates a new REST for the website monitoring config
 func NewWebsiteMonitoringConfigRestResource(unmarshaller JSONUnmarshaller, client RestClient) RestResource {
-	return &websiteMonitoringConfigRestResource{
+	return &monitoringConfigRestResource{
 		resourcePath: WebsiteMonitoringConfigResourcePath,
 		unmarshaller: unmarshaller,
 		client:       client,
+		nameOf: func(data InstanaDataObject) string {
+			return data.(*WebsiteMonitoringConfig).Name
+		},
 	}
 }
 
-type websiteMonitoringConfigRestResource struct {
+//monitoringConfigRestResource REST resource for monitoring configurations (websites and mobile apps) which are created
+//and updated by providing the name as query parameter instead of a request body
+type monitoringConfigRestResource struct {
 	resourcePath string
 	unmarshaller JSONUnmarshaller
 	client       RestClient
+	nameOf       func(data InstanaDataObject) string
 }
 
-func (r *websiteMonitoringConfigRestResource) GetOne(id string) (InstanaDataObject, error) {
-	data, err := r.client.GetOne(id, r.resourcePath)
+func (r *monitoringConfigRestResource) GetAll(ctx context.Context) (*[]InstanaDataObject, error) {
+	data, err := r.client.Get(ctx, r.resourcePath)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalArray(data, r.unmarshaller)
+}
+
+func (r *monitoringConfigRestResource) GetOne(ctx context.Context, id string) (InstanaDataObject, error) {
+	data, err := r.client.GetOne(ctx, id, r.resourcePath)
 	if err != nil {
 		return nil, err
 	}
 	return r.validateResponseAndConvertToStruct(data)
 }
 
-func (r *websiteMonitoringConfigRestResource) Create(data InstanaDataObject) (InstanaDataObject, error) {
+func (r *monitoringConfigRestResource) Create(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
 	if err := data.Validate(); err != nil {
 		return data, err
 	}
-	response, err := r.client.PostByQuery(r.resourcePath, map[string]string{"name": data.(*WebsiteMonitoringConfig).Name})
+	response, err := r.client.PostByQuery(ctx, r.resourcePath, map[string]string{"name": r.nameOf(data)})
 	if err != nil {
 		return data, err
 	}
 	return r.validateResponseAndConvertToStruct(response)
 }
 
-func (r *websiteMonitoringConfigRestResource) Update(data InstanaDataObject) (InstanaDataObject, error) {
+func (r *monitoringConfigRestResource) Update(ctx context.Context, data InstanaDataObject) (InstanaDataObject, error) {
 	if err := data.Validate(); err != nil {
 		return data, err
 	}
-	response, err := r.client.PutByQuery(r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": data.(*WebsiteMonitoringConfig).Name})
+	response, err := r.client.PutByQuery(ctx, r.resourcePath, data.GetIDForResourcePath(), map[string]string{"name": r.nameOf(data)})
 	if err != nil {
 		return data, err
 	}
 	return r.validateResponseAndConvertToStruct(response)
 }
 
-func (r *websiteMonitoringConfigRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
+func (r *monitoringConfigRestResource) validateResponseAndConvertToStruct(data []byte) (InstanaDataObject, error) {
 	object, err := r.unmarshaller.Unmarshal(data)
 	if err != nil {
 		return nil, err
@@ -63,10 +80,10 @@ func (r *websiteMonitoringConfigRestResource) validateResponseAndConvertToStruct
 	return dataObject, nil
 }
 
-func (r *websiteMonitoringConfigRestResource) Delete(data InstanaDataObject) error {
-	return r.DeleteByID(data.GetIDForResourcePath())
+func (r *monitoringConfigRestResource) Delete(ctx context.Context, data InstanaDataObject) error {
+	return r.DeleteByID(ctx, data.GetIDForResourcePath())
 }
 
-func (r *websiteMonitoringConfigRestResource) DeleteByID(id string) error {
-	return r.client.Delete(id, r.resourcePath)
+func (r *monitoringConfigRestResource) DeleteByID(ctx context.Context, id string) error {
+	return r.client.Delete(ctx, id, r.resourcePath)
 }
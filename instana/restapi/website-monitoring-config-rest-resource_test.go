@@ -1,6 +1,8 @@
 package restapi_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -23,6 +25,46 @@ func makeTestWebsiteMonitoringConfig() *WebsiteMonitoringConfig {
 	}
 }
 
+// ########################################################
+// GET ALL Operation Tests
+// ########################################################
+
+func TestShouldSuccessfullyExecuteGetAllOperationOfWebsiteMonitoringConfigRestResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
+	serializedElement := []byte("{\"id\":\"" + websiteMonitoringConfigID + "\"}")
+
+	client.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return([]byte("["+string(serializedElement)+"]"), nil)
+	unmarshaller.EXPECT().Unmarshal(json.RawMessage(serializedElement)).Times(1).Return(websiteMonitoringConfig, nil)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	result, err := sut.GetAll(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, &[]InstanaDataObject{websiteMonitoringConfig}, result)
+}
+
+func TestShouldReturnErrorWhenExecutingGetAllOperationOfWebsiteMonitoringConfigRestResourceAndGetOperationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRestClient(ctrl)
+	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
+	expectedError := errors.New("Error")
+
+	client.EXPECT().Get(gomock.Any(), WebsiteMonitoringConfigResourcePath).Times(1).Return(nil, expectedError)
+	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
+
+	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
+
+	_, err := sut.GetAll(context.Background())
+
+	require.Equal(t, expectedError, err)
+}
+
 // ########################################################
 // GET Operation Tests
 // ########################################################
@@ -34,12 +76,12 @@ func TestShouldSuccessfullyExecuteGetOperationOfWebsiteMonitoringConfigRestResou
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.GetOne(websiteMonitoringConfigID)
+	result, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
@@ -52,12 +94,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -70,12 +112,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -89,12 +131,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	client := mocks.NewMockRestClient(ctrl)
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&InvalidWebsiteMonitoringConfig{}, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Unmarshalled object does not implement InstanaDataObject")
@@ -106,12 +148,12 @@ func TestShouldReturnErrorWhenExecutingGetOperationOfWebsiteMonitoringConfigRest
 	client := mocks.NewMockRestClient(ctrl)
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 
-	client.EXPECT().GetOne(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().GetOne(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.GetOne(websiteMonitoringConfigID)
+	_, err := sut.GetOne(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Contains(t, nameIsMissingError, err.Error())
@@ -128,12 +170,12 @@ func TestShouldSuccessfullyExecuteCreateOperationOfWebsiteMonitoringConfigRestRe
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.Create(websiteMonitoringConfig)
+	result, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
@@ -146,12 +188,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := &WebsiteMonitoringConfig{}
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(0)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(0)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Contains(t, nameIsMissingError, err.Error())
@@ -165,12 +207,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("Error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -184,12 +226,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("Error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -202,12 +244,12 @@ func TestShouldReturnErrorWhenExecutingCreateOperationOfWebsiteMonitoringConfigR
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PostByQuery(WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PostByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Create(websiteMonitoringConfig)
+	_, err := sut.Create(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Contains(t, nameIsMissingError, err.Error())
@@ -224,12 +266,12 @@ func TestShouldSuccessfullyExecuteUpdateOperationOfWebsiteMonitoringConfigRestRe
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(websiteMonitoringConfig, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	result, err := sut.Update(websiteMonitoringConfig)
+	result, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 	require.Equal(t, websiteMonitoringConfig, result)
@@ -242,12 +284,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := &WebsiteMonitoringConfig{}
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(0)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(0)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Contains(t, nameIsMissingError, err.Error())
@@ -261,12 +303,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("Error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, expectedError)
 	unmarshaller.EXPECT().Unmarshal(gomock.Any()).Times(0)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -280,12 +322,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	expectedError := errors.New("Error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -298,12 +340,12 @@ func TestShouldReturnErrorWhenExecutingUpdateOperationOfWebsiteMonitoringConfigR
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().PutByQuery(WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
+	client.EXPECT().PutByQuery(gomock.Any(), WebsiteMonitoringConfigResourcePath, websiteMonitoringConfigID, nameQueryParameter).Times(1).Return(websiteMonitoringConfigSerialized, nil)
 	unmarshaller.EXPECT().Unmarshal(websiteMonitoringConfigSerialized).Times(1).Return(&WebsiteMonitoringConfig{}, nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	_, err := sut.Update(websiteMonitoringConfig)
+	_, err := sut.Update(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Contains(t, nameIsMissingError, err.Error())
@@ -320,11 +362,11 @@ func TestShouldSuccessfullyExecuteDeleteByObjectOperationOfWebsiteMonitoringConf
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.Delete(websiteMonitoringConfig)
+	err := sut.Delete(context.Background(), websiteMonitoringConfig)
 
 	require.NoError(t, err)
 }
@@ -337,11 +379,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByObjectOperationOfWebsiteMonitorin
 	expectedError := errors.New("Error")
 	websiteMonitoringConfig := makeTestWebsiteMonitoringConfig()
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.Delete(websiteMonitoringConfig)
+	err := sut.Delete(context.Background(), websiteMonitoringConfig)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -352,11 +394,11 @@ func TestShouldSuccessfullyExecuteDeleteByIdOperationOfWebsiteMonitoringConfigRe
 	client := mocks.NewMockRestClient(ctrl)
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(nil)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(websiteMonitoringConfigID)
+	err := sut.DeleteByID(context.Background(), websiteMonitoringConfigID)
 
 	require.NoError(t, err)
 }
@@ -368,11 +410,11 @@ func TestShouldReturnErrorWhenExecutingDeleteByIdOperationOfWebsiteMonitoringCon
 	unmarshaller := mocks.NewMockJSONUnmarshaller(ctrl)
 	expectedError := errors.New("Error")
 
-	client.EXPECT().Delete(websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
+	client.EXPECT().Delete(gomock.Any(), websiteMonitoringConfigID, WebsiteMonitoringConfigResourcePath).Times(1).Return(expectedError)
 
 	sut := NewWebsiteMonitoringConfigRestResource(unmarshaller, client)
 
-	err := sut.DeleteByID(websiteMonitoringConfigID)
+	err := sut.DeleteByID(context.Background(), websiteMonitoringConfigID)
 
 	require.Error(t, err)
 	require.Equal(t, expectedError, err)
@@ -2,10 +2,13 @@ package instana
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -16,6 +19,11 @@ type ResourceMetaData struct {
 	SchemaVersion    int
 	SkipIDGeneration bool
 	ResourceIDField  *string
+	//MinimumInstanaVersion the minimum version of the Instana backend which supports the resource. Nil when the resource is supported by all versions
+	MinimumInstanaVersion *version.Version
+	//SingletonID the fixed ID of resources which exist exactly once per Instana tenant (e.g. tenant wide settings). Create
+	//and import use this ID and delete resets the settings of the resource. Empty for all other resources
+	SingletonID string
 }
 
 //ResourceHandle resource specific implementation which provides meta data and maps data from/to terraform state. Together with TerraformResource terraform schema resources can be created
@@ -44,10 +52,10 @@ func NewTerraformResource(handle ResourceHandle) TerraformResource {
 
 //TerraformResource internal simplified representation of a Terraform resource
 type TerraformResource interface {
-	Create(d *schema.ResourceData, meta interface{}) error
-	Read(d *schema.ResourceData, meta interface{}) error
-	Update(d *schema.ResourceData, meta interface{}) error
-	Delete(d *schema.ResourceData, meta interface{}) error
+	Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
 	ToSchemaResource() *schema.Resource
 }
 
@@ -56,44 +64,54 @@ type terraformResourceImpl struct {
 }
 
 //Create defines the create operation for the terraform resource
-func (r *terraformResourceImpl) Create(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
-	if !r.resourceHandle.MetaData().SkipIDGeneration {
+	if r.isSingleton() {
+		d.SetId(r.resourceHandle.MetaData().SingletonID)
+	} else if !r.resourceHandle.MetaData().SkipIDGeneration {
 		d.SetId(RandomID())
 	}
 	r.resourceHandle.SetComputedFields(d)
 
 	createRequest, err := r.resourceHandle.MapStateToDataObject(d, providerMeta.ResourceNameFormatter)
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("create", d, err)
 	}
-	createdObject, err := r.resourceHandle.GetRestResource(instanaAPI).Create(createRequest)
+	createdObject, err := r.resourceHandle.GetRestResource(instanaAPI).Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("create", d, err)
+	}
+	if err := r.resourceHandle.UpdateState(d, createdObject, providerMeta.ResourceNameFormatter); err != nil {
+		return r.newErrorDiagnostics("update state of created", d, err)
 	}
-	r.resourceHandle.UpdateState(d, createdObject, providerMeta.ResourceNameFormatter)
 	return nil
 }
 
 //Read defines the read operation for the terraform resource
-func (r *terraformResourceImpl) Read(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 	resourceID := r.getResourceID(d)
 	if len(resourceID) == 0 {
-		return fmt.Errorf("resource ID of %s is missing", r.resourceHandle.MetaData().ResourceName)
+		return diag.Errorf("resource ID of %s is missing", r.resourceHandle.MetaData().ResourceName)
 	}
-	obj, err := r.resourceHandle.GetRestResource(instanaAPI).GetOne(resourceID)
+	obj, err := r.resourceHandle.GetRestResource(instanaAPI).GetOne(ctx, resourceID)
 	if err != nil {
-		if err == restapi.ErrEntityNotFound {
+		if errors.Is(err, restapi.ErrEntityNotFound) {
 			d.SetId("")
-			return nil
+			return r.newWarningDiagnostics(
+				fmt.Sprintf("%s with ID %s not found", r.resourceHandle.MetaData().ResourceName, resourceID),
+				"The resource does not exist in Instana anymore and is removed from the Terraform state. It will be recreated when it is still defined in the configuration.",
+			)
 		}
-		return err
+		return r.newErrorDiagnostics("read", d, err)
+	}
+	if err := r.resourceHandle.UpdateState(d, obj, providerMeta.ResourceNameFormatter); err != nil {
+		return r.newErrorDiagnostics("update state of", d, err)
 	}
-	return r.resourceHandle.UpdateState(d, obj, providerMeta.ResourceNameFormatter)
+	return nil
 }
 
 func (r *terraformResourceImpl) getResourceID(d *schema.ResourceData) string {
@@ -104,55 +122,125 @@ func (r *terraformResourceImpl) getResourceID(d *schema.ResourceData) string {
 }
 
 //Update defines the update operation for the terraform resource
-func (r *terraformResourceImpl) Update(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	obj, err := r.resourceHandle.MapStateToDataObject(d, providerMeta.ResourceNameFormatter)
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("update", d, err)
 	}
-	updatedObject, err := r.resourceHandle.GetRestResource(instanaAPI).Update(obj)
+	updatedObject, err := r.resourceHandle.GetRestResource(instanaAPI).Update(ctx, obj)
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("update", d, err)
+	}
+	if err := r.resourceHandle.UpdateState(d, updatedObject, providerMeta.ResourceNameFormatter); err != nil {
+		return r.newErrorDiagnostics("update state of updated", d, err)
 	}
-	return r.resourceHandle.UpdateState(d, updatedObject, providerMeta.ResourceNameFormatter)
+	return nil
 }
 
 //Delete defines the delete operation for the terraform resource
-func (r *terraformResourceImpl) Delete(d *schema.ResourceData, meta interface{}) error {
+func (r *terraformResourceImpl) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerMeta := meta.(*ProviderMeta)
 	instanaAPI := providerMeta.InstanaAPI
 
 	object, err := r.resourceHandle.MapStateToDataObject(d, providerMeta.ResourceNameFormatter)
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("delete", d, err)
+	}
+	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(ctx, object.GetIDForResourcePath())
+	if errors.Is(err, restapi.ErrEntityNotFound) {
+		d.SetId("")
+		return r.newWarningDiagnostics(
+			fmt.Sprintf("%s with ID %s was already deleted", r.resourceHandle.MetaData().ResourceName, object.GetIDForResourcePath()),
+			"The resource does not exist in Instana anymore. It is removed from the Terraform state.",
+		)
 	}
-	err = r.resourceHandle.GetRestResource(instanaAPI).DeleteByID(object.GetIDForResourcePath())
 	if err != nil {
-		return err
+		return r.newErrorDiagnostics("delete", d, err)
 	}
 	d.SetId("")
 	return nil
 }
 
+func (r *terraformResourceImpl) newErrorDiagnostics(operation string, d *schema.ResourceData, err error) diag.Diagnostics {
+	summary := fmt.Sprintf("failed to %s %s", operation, r.resourceHandle.MetaData().ResourceName)
+	if resourceID := r.getResourceID(d); len(resourceID) > 0 {
+		summary = fmt.Sprintf("%s with ID %s", summary, resourceID)
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		},
+	}
+}
+
+func (r *terraformResourceImpl) newWarningDiagnostics(summary string, detail string) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   detail,
+		},
+	}
+}
+
 func (r *terraformResourceImpl) ToSchemaResource() *schema.Resource {
 	metaData := r.resourceHandle.MetaData()
-	return &schema.Resource{
-		Create: r.Create,
-		Read:   r.Read,
+	resource := &schema.Resource{
+		CreateContext: r.Create,
+		ReadContext:   r.Read,
 		Importer: &schema.ResourceImporter{
 			StateContext: r.importState,
 		},
-		Update:         r.Update,
-		Delete:         r.Delete,
+		DeleteContext:  r.Delete,
 		Schema:         metaData.Schema,
 		SchemaVersion:  metaData.SchemaVersion,
 		StateUpgraders: r.resourceHandle.StateUpgraders(),
+		CustomizeDiff:  r.verifyInstanaVersion,
+	}
+	if r.hasUpdatableFields(metaData.Schema) {
+		resource.UpdateContext = r.Update
+	}
+	return resource
+}
+
+//hasUpdatableFields checks if at least one field of the schema can be updated in place. Terraform does not allow an
+//update operation for resources where all fields are either ForceNew or computed only
+func (r *terraformResourceImpl) hasUpdatableFields(resourceSchema map[string]*schema.Schema) bool {
+	for _, field := range resourceSchema {
+		if !field.ForceNew && (field.Optional || field.Required) {
+			return true
+		}
+	}
+	return false
+}
+
+//verifyInstanaVersion ensures at plan time that the Instana backend supports the resource. The verification is skipped
+//when the resource does not define a minimum version or when the version of the Instana backend is unknown
+func (r *terraformResourceImpl) verifyInstanaVersion(_ context.Context, _ *schema.ResourceDiff, meta interface{}) error {
+	minimumVersion := r.resourceHandle.MetaData().MinimumInstanaVersion
+	providerMeta, ok := meta.(*ProviderMeta)
+	if minimumVersion == nil || !ok || providerMeta.InstanaVersion == nil {
+		return nil
 	}
+	if providerMeta.InstanaVersion.LessThan(minimumVersion) {
+		return fmt.Errorf("%s requires Instana backend version %s or higher; the configured Instana backend has version %s", r.resourceHandle.MetaData().ResourceName, minimumVersion, providerMeta.InstanaVersion)
+	}
+	return nil
+}
+
+func (r *terraformResourceImpl) isSingleton() bool {
+	return len(r.resourceHandle.MetaData().SingletonID) > 0
 }
 
 func (r *terraformResourceImpl) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if r.isSingleton() && d.Id() != r.resourceHandle.MetaData().SingletonID {
+		return nil, fmt.Errorf("%s exists exactly once and can only be imported with ID %s", r.resourceHandle.MetaData().ResourceName, r.resourceHandle.MetaData().SingletonID)
+	}
 	if r.resourceHandle.MetaData().ResourceIDField != nil {
 		d.Set(*r.resourceHandle.MetaData().ResourceIDField, d.Id())
 	}
@@ -1,13 +1,17 @@
 package instana_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	. "github.com/gessnerfl/terraform-provider-instana/instana"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	"github.com/gessnerfl/terraform-provider-instana/mocks"
+	"github.com/gessnerfl/terraform-provider-instana/utils"
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,13 +28,13 @@ func TestShouldSuccessfullyReadTestObjectFromInstanaAPIWhenBaseDataIsReturned(t
 		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(expectedModel, nil).Times(1)
 		mockResourceNameFormatter.EXPECT().UndoFormat(expectedModel.Name).Return(resourceNameWithoutPrefixAndSuffix).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
-		assert.Nil(t, err)
+		assert.Nil(t, diags)
 		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
 	})
 }
@@ -41,14 +45,14 @@ func TestShouldFailToReadTestObjectFromInstanaAPIWhenResourceIDIsMissing(t *test
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
-		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "ID of instana_alerting_channel_email")
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "ID of instana_alerting_channel_email")
 	})
 }
 
-func TestShouldFailToReadTestObjectFromInstanaAPIAndDeleteResourceWhenRoleDoesNotExist(t *testing.T) {
+func TestShouldRemoveTestObjectFromStateWithWarningWhenTestObjectDoesNotExistInInstanaAPI(t *testing.T) {
 	testHelper := NewTestHelper(t)
 	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
 		resourceData := createEmptyAlertingChannelEmailResourceData(t)
@@ -56,12 +60,15 @@ func TestShouldFailToReadTestObjectFromInstanaAPIAndDeleteResourceWhenRoleDoesNo
 		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, restapi.ErrEntityNotFound).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
-		assert.Nil(t, err)
+		assert.False(t, diags.HasError())
+		assert.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "instana_alerting_channel_email with ID id not found", diags[0].Summary)
 		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
 	})
 }
@@ -75,12 +82,14 @@ func TestShouldFailToReadTestObjectFromInstanaAPIAndReturnErrorWhenAPICallFails(
 		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
-		mockTestObjectApi.EXPECT().GetOne(gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().GetOne(gomock.Any(), gomock.Eq(alertingChannelEmailID)).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Read(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Read(context.Background(), resourceData, providerMeta)
 
-		assert.Equal(t, expectedError, err)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "failed to read instana_alerting_channel_email with ID id", diags[0].Summary)
+		assert.Equal(t, expectedError.Error(), diags[0].Detail)
 		assert.NotEqual(t, 0, len(resourceData.Id()))
 	})
 }
@@ -96,12 +105,12 @@ func TestShouldCreateTestObjectThroughInstanaAPI(t *testing.T) {
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
 		mockResourceNameFormatter.EXPECT().UndoFormat(expectedModel.Name).Return(resourceNameWithoutPrefixAndSuffix).Times(1)
-		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Create(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
 
-		assert.Nil(t, err)
+		assert.Nil(t, diags)
 		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
 	})
 }
@@ -116,12 +125,14 @@ func TestShouldReturnErrorWhenCreateTestObjectFailsThroughInstanaAPI(t *testing.
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().Create(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Create(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Create(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
 
-		assert.Equal(t, expectedError, err)
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "failed to create instana_alerting_channel_email with ID ")
+		assert.Equal(t, expectedError.Error(), diags[0].Detail)
 	})
 }
 
@@ -136,12 +147,12 @@ func TestShouldUpdateTestObjectThroughInstanaAPI(t *testing.T) {
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
 		mockResourceNameFormatter.EXPECT().UndoFormat(expectedModel.Name).Return(resourceNameWithoutPrefixAndSuffix).Times(1)
-		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
+		mockTestObjectApi.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(expectedModel, nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Update(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Update(context.Background(), resourceData, providerMeta)
 
-		assert.Nil(t, err)
+		assert.Nil(t, diags)
 		verifyTestObjectModelAppliedToResource(expectedModel, resourceData, t)
 	})
 }
@@ -156,12 +167,14 @@ func TestShouldReturnErrorWhenUpdateTestObjectFailsThroughInstanaAPI(t *testing.
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().Update(gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
+		mockTestObjectApi.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&restapi.AlertingChannel{})).Return(&restapi.AlertingChannel{}, expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Update(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Update(context.Background(), resourceData, providerMeta)
 
-		assert.Equal(t, expectedError, err)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "failed to update instana_alerting_channel_email", diags[0].Summary)
+		assert.Equal(t, expectedError.Error(), diags[0].Detail)
 	})
 }
 
@@ -176,12 +189,12 @@ func TestShouldDeleteTestObjectThroughInstanaAPI(t *testing.T) {
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(nil).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Any(), gomock.Eq(id)).Return(nil).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Delete(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Delete(context.Background(), resourceData, providerMeta)
 
-		assert.Nil(t, err)
+		assert.Nil(t, diags)
 		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
 	})
 }
@@ -198,16 +211,130 @@ func TestShouldReturnErrorWhenDeleteTestObjectFailsThroughInstanaAPI(t *testing.
 
 		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
 		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
-		mockTestObjectApi.EXPECT().DeleteByID(gomock.Eq(id)).Return(expectedError).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Any(), gomock.Eq(id)).Return(expectedError).Times(1)
 
 		resourceHandle := NewAlertingChannelEmailResourceHandle()
-		err := NewTerraformResource(resourceHandle).Delete(resourceData, providerMeta)
+		diags := NewTerraformResource(resourceHandle).Delete(context.Background(), resourceData, providerMeta)
 
-		assert.Equal(t, expectedError, err)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "failed to delete instana_alerting_channel_email with ID test-id", diags[0].Summary)
+		assert.Equal(t, expectedError.Error(), diags[0].Detail)
 		assert.NotEqual(t, 0, len(resourceData.Id()))
 	})
 }
 
+func TestShouldRemoveTestObjectFromStateWithWarningWhenTestObjectIsAlreadyDeletedInInstanaAPI(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		id := "test-id"
+		data := createTestAlertingChannelEmailData()
+		resourceData := createAlertingChannelEmailResourceData(data, t)
+		resourceData.SetId(id)
+		mockTestObjectApi := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().AlertingChannels().Return(mockTestObjectApi).Times(1)
+		mockResourceNameFormatter.EXPECT().Format(data[AlertingChannelFieldName]).Return(data[AlertingChannelFieldName]).Times(1)
+		mockTestObjectApi.EXPECT().DeleteByID(gomock.Any(), gomock.Eq(id)).Return(restapi.ErrEntityNotFound).Times(1)
+
+		resourceHandle := NewAlertingChannelEmailResourceHandle()
+		diags := NewTerraformResource(resourceHandle).Delete(context.Background(), resourceData, providerMeta)
+
+		assert.False(t, diags.HasError())
+		assert.Len(t, diags, 1)
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "instana_alerting_channel_email with ID test-id was already deleted", diags[0].Summary)
+		assert.GreaterOrEqual(t, 0, len(resourceData.Id()))
+	})
+}
+
+func TestShouldFailAtPlanTimeWhenInstanaVersionIsLowerThanMinimumVersionOfResource(t *testing.T) {
+	resource := NewTerraformResource(newResourceHandleWithMinimumVersion("3.229.0")).ToSchemaResource()
+
+	err := resource.CustomizeDiff(context.Background(), nil, &ProviderMeta{InstanaVersion: version.Must(version.NewVersion("3.228.5"))})
+
+	assert.Error(t, err)
+	assert.Equal(t, "instana_alerting_channel_email requires Instana backend version 3.229.0 or higher; the configured Instana backend has version 3.228.5", err.Error())
+}
+
+func TestShouldSucceedAtPlanTimeWhenInstanaVersionIsEqualOrHigherThanMinimumVersionOfResource(t *testing.T) {
+	resource := NewTerraformResource(newResourceHandleWithMinimumVersion("3.229.0")).ToSchemaResource()
+
+	for _, instanaVersion := range []string{"3.229.0", "3.229.318", "4.0.0"} {
+		err := resource.CustomizeDiff(context.Background(), nil, &ProviderMeta{InstanaVersion: version.Must(version.NewVersion(instanaVersion))})
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestShouldSkipVerificationOfInstanaVersionWhenVersionIsUnknownOrNoMinimumVersionIsDefined(t *testing.T) {
+	resource := NewTerraformResource(newResourceHandleWithMinimumVersion("3.229.0")).ToSchemaResource()
+	assert.NoError(t, resource.CustomizeDiff(context.Background(), nil, &ProviderMeta{}))
+	assert.NoError(t, resource.CustomizeDiff(context.Background(), nil, nil))
+
+	resource = NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource()
+	assert.NoError(t, resource.CustomizeDiff(context.Background(), nil, &ProviderMeta{InstanaVersion: version.Must(version.NewVersion("1.0.0"))}))
+}
+
+func TestShouldOnlyProvideUpdateOperationWhenResourceHasFieldsWhichCanBeUpdatedInPlace(t *testing.T) {
+	assert.NotNil(t, NewTerraformResource(NewAlertingChannelEmailResourceHandle()).ToSchemaResource().UpdateContext)
+	assert.Nil(t, NewTerraformResource(NewGroupMembershipResourceHandle()).ToSchemaResource().UpdateContext)
+}
+
+func TestShouldUseSingletonIDWhenSingletonResourceIsCreated(t *testing.T) {
+	testHelper := NewTestHelper(t)
+	testHelper.WithMocking(t, func(ctrl *gomock.Controller, providerMeta *ProviderMeta, mockInstanaAPI *mocks.MockInstanaAPI, mockResourceNameFormatter *mocks.MockResourceNameFormatter) {
+		resourceHandle := NewSessionSettingsResourceHandle()
+		resourceData := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, map[string]interface{}{SessionSettingsFieldIdleTimeInMillis: 3600000})
+		settings := &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(3600000), TokenLifeTimeInMillis: utils.Int64Ptr(86400000)}
+		mockRestResource := mocks.NewMockRestResource(ctrl)
+
+		mockInstanaAPI.EXPECT().SessionSettings().Return(mockRestResource).Times(1)
+		mockRestResource.EXPECT().Create(gomock.Any(), &restapi.SessionSettings{IdleTimeInMillis: utils.Int64Ptr(3600000)}).Return(settings, nil).Times(1)
+
+		diags := NewTerraformResource(resourceHandle).Create(context.Background(), resourceData, providerMeta)
+
+		assert.Nil(t, diags)
+		assert.Equal(t, restapi.SessionSettingsPathElement, resourceData.Id())
+		assert.Equal(t, 86400000, resourceData.Get(SessionSettingsFieldTokenLifeTimeInMillis))
+	})
+}
+
+func TestShouldOnlyImportSingletonResourceWithSingletonID(t *testing.T) {
+	resourceHandle := NewSessionSettingsResourceHandle()
+	resource := NewTerraformResource(resourceHandle).ToSchemaResource()
+
+	resourceData := schema.TestResourceDataRaw(t, resourceHandle.MetaData().Schema, map[string]interface{}{})
+	resourceData.SetId(restapi.SessionSettingsPathElement)
+	result, err := resource.Importer.StateContext(context.Background(), resourceData, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	resourceData.SetId("other-id")
+	_, err = resource.Importer.StateContext(context.Background(), resourceData, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, "instana_session_settings exists exactly once and can only be imported with ID session", err.Error())
+}
+
+type resourceHandleWithMinimumVersion struct {
+	ResourceHandle
+	minimumVersion *version.Version
+}
+
+func (h *resourceHandleWithMinimumVersion) MetaData() *ResourceMetaData {
+	metaData := *h.ResourceHandle.MetaData()
+	metaData.MinimumInstanaVersion = h.minimumVersion
+	return &metaData
+}
+
+func newResourceHandleWithMinimumVersion(minimumVersion string) ResourceHandle {
+	return &resourceHandleWithMinimumVersion{
+		ResourceHandle: NewAlertingChannelEmailResourceHandle(),
+		minimumVersion: version.Must(version.NewVersion(minimumVersion)),
+	}
+}
+
 func verifyTestObjectModelAppliedToResource(model *restapi.AlertingChannel, resourceData *schema.ResourceData, t *testing.T) {
 	assert.Equal(t, model.ID, resourceData.Id())
 	assert.Equal(t, resourceNameWithoutPrefixAndSuffix, resourceData.Get(AlertingChannelFieldName))
@@ -3,7 +3,9 @@ package instana
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/gessnerfl/terraform-provider-instana/instana/filterexpression"
 	"github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -86,6 +88,16 @@ func GetInt32PointerFromResourceData(d *schema.ResourceData, key string) *int32
 	return nil
 }
 
+//GetInt64PointerFromResourceData gets a int64 value from the resource data and either returns a pointer to the value or nil if the value is not defined
+func GetInt64PointerFromResourceData(d *schema.ResourceData, key string) *int64 {
+	val, ok := d.GetOk(key)
+	if ok {
+		intValue := int64(val.(int))
+		return &intValue
+	}
+	return nil
+}
+
 //GetFloat64PointerFromResourceData gets a float64 value from the resource data and either returns a pointer to the value or nil if the value is not defined
 func GetFloat64PointerFromResourceData(d *schema.ResourceData, key string) *float64 {
 	val, ok := d.GetOk(key)
@@ -151,3 +163,45 @@ func NormalizeJSONString(jsonString string) string {
 	}
 	return string(bytes)
 }
+
+//suppressDiffOfEqualRFC3339Timestamps suppresses the diff of two RFC 3339 timestamps which represent the same instant in different time zones
+func suppressDiffOfEqualRFC3339Timestamps(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+//newMatchExpressionSchema completes the given schema to a string field which holds a match expression. The match
+//expression is validated, stored in its normalized form and diffs of equivalent expressions are suppressed
+func newMatchExpressionSchema(s *schema.Schema) *schema.Schema {
+	s.Type = schema.TypeString
+	s.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		normalized, err := filterexpression.Normalize(new)
+		if err == nil {
+			return normalized == old
+		}
+		return old == new
+	}
+	s.StateFunc = func(val interface{}) string {
+		normalized, err := filterexpression.Normalize(val.(string))
+		if err == nil {
+			return normalized
+		}
+		return val.(string)
+	}
+	s.ValidateFunc = func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		if _, err := filterexpression.NewParser().Parse(v); err != nil {
+			errs = append(errs, fmt.Errorf("%q is not a valid match expression; %s", key, err))
+		}
+
+		return
+	}
+	return s
+}
@@ -167,6 +167,36 @@ func TestShouldReturnNilWhenInt32PointerIsRequestedButNotSetInResource(t *testin
 	require.Nil(t, GetInt32PointerFromResourceData(resourceData, "test"))
 }
 
+func TestShouldReturnInt64PointerFromResource(t *testing.T) {
+	resourceSchema := make(map[string]*schema.Schema)
+	resourceSchema["test"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Required: true,
+	}
+
+	value := int64(3600000)
+	data := make(map[string]interface{})
+	data["test"] = int(value)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSchema, data)
+
+	require.Equal(t, &value, GetInt64PointerFromResourceData(resourceData, "test"))
+}
+
+func TestShouldReturnNilWhenInt64PointerIsRequestedButNotSetInResource(t *testing.T) {
+	resourceSchema := make(map[string]*schema.Schema)
+	resourceSchema["test"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Required: true,
+	}
+
+	data := make(map[string]interface{})
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSchema, data)
+
+	require.Nil(t, GetInt64PointerFromResourceData(resourceData, "test"))
+}
+
 func TestShouldReturnFloat64PointerFromResource(t *testing.T) {
 	resourceSchema := make(map[string]*schema.Schema)
 	resourceSchema["test"] = &schema.Schema{
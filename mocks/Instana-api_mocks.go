@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -104,6 +105,20 @@ func (mr *MockInstanaAPIMockRecorder) ApplicationConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationConfigs))
 }
 
+// ApplicationHTTPEndpointConfigs mocks base method.
+func (m *MockInstanaAPI) ApplicationHTTPEndpointConfigs() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationHTTPEndpointConfigs")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// ApplicationHTTPEndpointConfigs indicates an expected call of ApplicationHTTPEndpointConfigs.
+func (mr *MockInstanaAPIMockRecorder) ApplicationHTTPEndpointConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationHTTPEndpointConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ApplicationHTTPEndpointConfigs))
+}
+
 // BuiltinEventSpecifications mocks base method.
 func (m *MockInstanaAPI) BuiltinEventSpecifications() restapi.ReadOnlyRestResource {
 	m.ctrl.T.Helper()
@@ -160,6 +175,48 @@ func (mr *MockInstanaAPIMockRecorder) GlobalApplicationAlertConfigs() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalApplicationAlertConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalApplicationAlertConfigs))
 }
 
+// GlobalCustomPayloadConfiguration mocks base method.
+func (m *MockInstanaAPI) GlobalCustomPayloadConfiguration() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GlobalCustomPayloadConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// GlobalCustomPayloadConfiguration indicates an expected call of GlobalCustomPayloadConfiguration.
+func (mr *MockInstanaAPIMockRecorder) GlobalCustomPayloadConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalCustomPayloadConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).GlobalCustomPayloadConfiguration))
+}
+
+// GroupMappings mocks base method.
+func (m *MockInstanaAPI) GroupMappings() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMappings")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// GroupMappings indicates an expected call of GroupMappings.
+func (mr *MockInstanaAPIMockRecorder) GroupMappings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMappings", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMappings))
+}
+
+// GroupMemberships mocks base method.
+func (m *MockInstanaAPI) GroupMemberships() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMemberships")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// GroupMemberships indicates an expected call of GroupMemberships.
+func (mr *MockInstanaAPIMockRecorder) GroupMemberships() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMemberships", reflect.TypeOf((*MockInstanaAPI)(nil).GroupMemberships))
+}
+
 // Groups mocks base method.
 func (m *MockInstanaAPI) Groups() restapi.RestResource {
 	m.ctrl.T.Helper()
@@ -174,6 +231,175 @@ func (mr *MockInstanaAPIMockRecorder) Groups() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Groups", reflect.TypeOf((*MockInstanaAPI)(nil).Groups))
 }
 
+// InstanaVersion mocks base method.
+func (m *MockInstanaAPI) InstanaVersion(ctx context.Context) (*restapi.InstanaVersionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanaVersion", ctx)
+	ret0, _ := ret[0].(*restapi.InstanaVersionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanaVersion indicates an expected call of InstanaVersion.
+func (mr *MockInstanaAPIMockRecorder) InstanaVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanaVersion", reflect.TypeOf((*MockInstanaAPI)(nil).InstanaVersion), ctx)
+}
+
+// Invitations mocks base method.
+func (m *MockInstanaAPI) Invitations() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invitations")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// Invitations indicates an expected call of Invitations.
+func (mr *MockInstanaAPIMockRecorder) Invitations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invitations", reflect.TypeOf((*MockInstanaAPI)(nil).Invitations))
+}
+
+// MaintenanceWindowConfigs mocks base method.
+func (m *MockInstanaAPI) MaintenanceWindowConfigs() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceWindowConfigs")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MaintenanceWindowConfigs indicates an expected call of MaintenanceWindowConfigs.
+func (mr *MockInstanaAPIMockRecorder) MaintenanceWindowConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceWindowConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).MaintenanceWindowConfigs))
+}
+
+// ManualServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ManualServiceConfigs() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManualServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// ManualServiceConfigs indicates an expected call of ManualServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ManualServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManualServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ManualServiceConfigs))
+}
+
+// MobileAppConfig mocks base method.
+func (m *MockInstanaAPI) MobileAppConfig() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppConfig")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MobileAppConfig indicates an expected call of MobileAppConfig.
+func (mr *MockInstanaAPIMockRecorder) MobileAppConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppConfig", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppConfig))
+}
+
+// MobileAppGeoLocationConfiguration mocks base method.
+func (m *MockInstanaAPI) MobileAppGeoLocationConfiguration() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppGeoLocationConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MobileAppGeoLocationConfiguration indicates an expected call of MobileAppGeoLocationConfiguration.
+func (mr *MockInstanaAPIMockRecorder) MobileAppGeoLocationConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppGeoLocationConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppGeoLocationConfiguration))
+}
+
+// MobileAppGeoMappingRules mocks base method.
+func (m *MockInstanaAPI) MobileAppGeoMappingRules() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppGeoMappingRules")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MobileAppGeoMappingRules indicates an expected call of MobileAppGeoMappingRules.
+func (mr *MockInstanaAPIMockRecorder) MobileAppGeoMappingRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppGeoMappingRules", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppGeoMappingRules))
+}
+
+// MobileAppIPMaskingConfiguration mocks base method.
+func (m *MockInstanaAPI) MobileAppIPMaskingConfiguration() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MobileAppIPMaskingConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// MobileAppIPMaskingConfiguration indicates an expected call of MobileAppIPMaskingConfiguration.
+func (mr *MockInstanaAPIMockRecorder) MobileAppIPMaskingConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MobileAppIPMaskingConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).MobileAppIPMaskingConfiguration))
+}
+
+// Releases mocks base method.
+func (m *MockInstanaAPI) Releases() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Releases")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// Releases indicates an expected call of Releases.
+func (mr *MockInstanaAPIMockRecorder) Releases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Releases", reflect.TypeOf((*MockInstanaAPI)(nil).Releases))
+}
+
+// ServiceConfigOrder mocks base method.
+func (m *MockInstanaAPI) ServiceConfigOrder() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigOrder")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// ServiceConfigOrder indicates an expected call of ServiceConfigOrder.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigOrder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigOrder", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigOrder))
+}
+
+// ServiceConfigs mocks base method.
+func (m *MockInstanaAPI) ServiceConfigs() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceConfigs")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// ServiceConfigs indicates an expected call of ServiceConfigs.
+func (mr *MockInstanaAPIMockRecorder) ServiceConfigs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).ServiceConfigs))
+}
+
+// SessionSettings mocks base method.
+func (m *MockInstanaAPI) SessionSettings() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionSettings")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// SessionSettings indicates an expected call of SessionSettings.
+func (mr *MockInstanaAPIMockRecorder) SessionSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SessionSettings))
+}
+
 // SliConfigs mocks base method.
 func (m *MockInstanaAPI) SliConfigs() restapi.RestResource {
 	m.ctrl.T.Helper()
@@ -188,6 +414,49 @@ func (mr *MockInstanaAPIMockRecorder) SliConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliConfigs", reflect.TypeOf((*MockInstanaAPI)(nil).SliConfigs))
 }
 
+// SliConfigsV2 mocks base method.
+func (m *MockInstanaAPI) SliConfigsV2() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SliConfigsV2")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// SliConfigsV2 indicates an expected call of SliConfigsV2.
+func (mr *MockInstanaAPIMockRecorder) SliConfigsV2() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliConfigsV2", reflect.TypeOf((*MockInstanaAPI)(nil).SliConfigsV2))
+}
+
+// SliReport mocks base method.
+func (m *MockInstanaAPI) SliReport(ctx context.Context, query restapi.SliReportQuery) (*restapi.SliReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SliReport", ctx, query)
+	ret0, _ := ret[0].(*restapi.SliReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SliReport indicates an expected call of SliReport.
+func (mr *MockInstanaAPIMockRecorder) SliReport(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SliReport", reflect.TypeOf((*MockInstanaAPI)(nil).SliReport), ctx, query)
+}
+
+// SyntheticCallsSettings mocks base method.
+func (m *MockInstanaAPI) SyntheticCallsSettings() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyntheticCallsSettings")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// SyntheticCallsSettings indicates an expected call of SyntheticCallsSettings.
+func (mr *MockInstanaAPIMockRecorder) SyntheticCallsSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyntheticCallsSettings", reflect.TypeOf((*MockInstanaAPI)(nil).SyntheticCallsSettings))
+}
+
 // WebsiteAlertConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteAlertConfig() restapi.RestResource {
 	m.ctrl.T.Helper()
@@ -202,6 +471,48 @@ func (mr *MockInstanaAPIMockRecorder) WebsiteAlertConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteAlertConfig", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteAlertConfig))
 }
 
+// WebsiteGeoLocationConfiguration mocks base method.
+func (m *MockInstanaAPI) WebsiteGeoLocationConfiguration() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteGeoLocationConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// WebsiteGeoLocationConfiguration indicates an expected call of WebsiteGeoLocationConfiguration.
+func (mr *MockInstanaAPIMockRecorder) WebsiteGeoLocationConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteGeoLocationConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteGeoLocationConfiguration))
+}
+
+// WebsiteGeoMappingRules mocks base method.
+func (m *MockInstanaAPI) WebsiteGeoMappingRules() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteGeoMappingRules")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// WebsiteGeoMappingRules indicates an expected call of WebsiteGeoMappingRules.
+func (mr *MockInstanaAPIMockRecorder) WebsiteGeoMappingRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteGeoMappingRules", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteGeoMappingRules))
+}
+
+// WebsiteIPMaskingConfiguration mocks base method.
+func (m *MockInstanaAPI) WebsiteIPMaskingConfiguration() restapi.RestResource {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebsiteIPMaskingConfiguration")
+	ret0, _ := ret[0].(restapi.RestResource)
+	return ret0
+}
+
+// WebsiteIPMaskingConfiguration indicates an expected call of WebsiteIPMaskingConfiguration.
+func (mr *MockInstanaAPIMockRecorder) WebsiteIPMaskingConfiguration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebsiteIPMaskingConfiguration", reflect.TypeOf((*MockInstanaAPI)(nil).WebsiteIPMaskingConfiguration))
+}
+
 // WebsiteMonitoringConfig mocks base method.
 func (m *MockInstanaAPI) WebsiteMonitoringConfig() restapi.RestResource {
 	m.ctrl.T.Helper()
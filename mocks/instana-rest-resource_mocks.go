@@ -5,35 +5,37 @@
 package mocks
 
 import (
+	context "context"
+	reflect "reflect"
+
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
 	gomock "github.com/golang/mock/gomock"
-	reflect "reflect"
 )
 
-// MockInstanaDataObject is a mock of InstanaDataObject interface
+// MockInstanaDataObject is a mock of InstanaDataObject interface.
 type MockInstanaDataObject struct {
 	ctrl     *gomock.Controller
 	recorder *MockInstanaDataObjectMockRecorder
 }
 
-// MockInstanaDataObjectMockRecorder is the mock recorder for MockInstanaDataObject
+// MockInstanaDataObjectMockRecorder is the mock recorder for MockInstanaDataObject.
 type MockInstanaDataObjectMockRecorder struct {
 	mock *MockInstanaDataObject
 }
 
-// NewMockInstanaDataObject creates a new mock instance
+// NewMockInstanaDataObject creates a new mock instance.
 func NewMockInstanaDataObject(ctrl *gomock.Controller) *MockInstanaDataObject {
 	mock := &MockInstanaDataObject{ctrl: ctrl}
 	mock.recorder = &MockInstanaDataObjectMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockInstanaDataObject) EXPECT() *MockInstanaDataObjectMockRecorder {
 	return m.recorder
 }
 
-// GetIDForResourcePath mocks base method
+// GetIDForResourcePath mocks base method.
 func (m *MockInstanaDataObject) GetIDForResourcePath() string {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetIDForResourcePath")
@@ -41,13 +43,13 @@ func (m *MockInstanaDataObject) GetIDForResourcePath() string {
 	return ret0
 }
 
-// GetIDForResourcePath indicates an expected call of GetIDForResourcePath
+// GetIDForResourcePath indicates an expected call of GetIDForResourcePath.
 func (mr *MockInstanaDataObjectMockRecorder) GetIDForResourcePath() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDForResourcePath", reflect.TypeOf((*MockInstanaDataObject)(nil).GetIDForResourcePath))
 }
 
-// Validate mocks base method
+// Validate mocks base method.
 func (m *MockInstanaDataObject) Validate() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validate")
@@ -55,185 +57,200 @@ func (m *MockInstanaDataObject) Validate() error {
 	return ret0
 }
 
-// Validate indicates an expected call of Validate
+// Validate indicates an expected call of Validate.
 func (mr *MockInstanaDataObjectMockRecorder) Validate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockInstanaDataObject)(nil).Validate))
 }
 
-// MockRestResource is a mock of RestResource interface
+// MockRestResource is a mock of RestResource interface.
 type MockRestResource struct {
 	ctrl     *gomock.Controller
 	recorder *MockRestResourceMockRecorder
 }
 
-// MockRestResourceMockRecorder is the mock recorder for MockRestResource
+// MockRestResourceMockRecorder is the mock recorder for MockRestResource.
 type MockRestResourceMockRecorder struct {
 	mock *MockRestResource
 }
 
-// NewMockRestResource creates a new mock instance
+// NewMockRestResource creates a new mock instance.
 func NewMockRestResource(ctrl *gomock.Controller) *MockRestResource {
 	mock := &MockRestResource{ctrl: ctrl}
 	mock.recorder = &MockRestResourceMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockRestResource) EXPECT() *MockRestResourceMockRecorder {
 	return m.recorder
 }
 
-// GetOne mocks base method
-func (m *MockRestResource) GetOne(id string) (restapi.InstanaDataObject, error) {
+// Create mocks base method.
+func (m *MockRestResource) Create(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id)
+	ret := m.ctrl.Call(m, "Create", ctx, data)
 	ret0, _ := ret[0].(restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOne indicates an expected call of GetOne
-func (mr *MockRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockRestResourceMockRecorder) Create(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource)(nil).GetOne), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRestResource)(nil).Create), ctx, data)
 }
 
-// Create mocks base method
-func (m *MockRestResource) Create(data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+// Delete mocks base method.
+func (m *MockRestResource) Delete(ctx context.Context, data restapi.InstanaDataObject) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", data)
-	ret0, _ := ret[0].(restapi.InstanaDataObject)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Delete", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Create indicates an expected call of Create
-func (mr *MockRestResourceMockRecorder) Create(data interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockRestResourceMockRecorder) Delete(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRestResource)(nil).Create), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource)(nil).Delete), ctx, data)
 }
 
-// Update mocks base method
-func (m *MockRestResource) Update(data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
+// DeleteByID mocks base method.
+func (m *MockRestResource) DeleteByID(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", data)
-	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockRestResourceMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource)(nil).DeleteByID), ctx, id)
+}
+
+// GetAll mocks base method.
+func (m *MockRestResource) GetAll(ctx context.Context) (*[]restapi.InstanaDataObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].(*[]restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Update indicates an expected call of Update
-func (mr *MockRestResourceMockRecorder) Update(data interface{}) *gomock.Call {
+// GetAll indicates an expected call of GetAll.
+func (mr *MockRestResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRestResource)(nil).Update), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockRestResource)(nil).GetAll), ctx)
 }
 
-// Delete mocks base method
-func (m *MockRestResource) Delete(data restapi.InstanaDataObject) error {
+// GetOne mocks base method.
+func (m *MockRestResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", data)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete
-func (mr *MockRestResourceMockRecorder) Delete(data interface{}) *gomock.Call {
+// GetOne indicates an expected call of GetOne.
+func (mr *MockRestResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestResource)(nil).Delete), data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestResource)(nil).GetOne), ctx, id)
 }
 
-// DeleteByID mocks base method
-func (m *MockRestResource) DeleteByID(id string) error {
+// Update mocks base method.
+func (m *MockRestResource) Update(ctx context.Context, data restapi.InstanaDataObject) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Update", ctx, data)
+	ret0, _ := ret[0].(restapi.InstanaDataObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteByID indicates an expected call of DeleteByID
-func (mr *MockRestResourceMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockRestResourceMockRecorder) Update(ctx, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRestResource)(nil).DeleteByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRestResource)(nil).Update), ctx, data)
 }
 
-// MockReadOnlyRestResource is a mock of ReadOnlyRestResource interface
+// MockReadOnlyRestResource is a mock of ReadOnlyRestResource interface.
 type MockReadOnlyRestResource struct {
 	ctrl     *gomock.Controller
 	recorder *MockReadOnlyRestResourceMockRecorder
 }
 
-// MockReadOnlyRestResourceMockRecorder is the mock recorder for MockReadOnlyRestResource
+// MockReadOnlyRestResourceMockRecorder is the mock recorder for MockReadOnlyRestResource.
 type MockReadOnlyRestResourceMockRecorder struct {
 	mock *MockReadOnlyRestResource
 }
 
-// NewMockReadOnlyRestResource creates a new mock instance
+// NewMockReadOnlyRestResource creates a new mock instance.
 func NewMockReadOnlyRestResource(ctrl *gomock.Controller) *MockReadOnlyRestResource {
 	mock := &MockReadOnlyRestResource{ctrl: ctrl}
 	mock.recorder = &MockReadOnlyRestResourceMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockReadOnlyRestResource) EXPECT() *MockReadOnlyRestResourceMockRecorder {
 	return m.recorder
 }
 
-// GetAll mocks base method
-func (m *MockReadOnlyRestResource) GetAll() (*[]restapi.InstanaDataObject, error) {
+// GetAll mocks base method.
+func (m *MockReadOnlyRestResource) GetAll(ctx context.Context) (*[]restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll")
+	ret := m.ctrl.Call(m, "GetAll", ctx)
 	ret0, _ := ret[0].(*[]restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll
-func (mr *MockReadOnlyRestResourceMockRecorder) GetAll() *gomock.Call {
+// GetAll indicates an expected call of GetAll.
+func (mr *MockReadOnlyRestResourceMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReadOnlyRestResource)(nil).GetAll))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockReadOnlyRestResource)(nil).GetAll), ctx)
 }
 
-// GetOne mocks base method
-func (m *MockReadOnlyRestResource) GetOne(id string) (restapi.InstanaDataObject, error) {
+// GetOne mocks base method.
+func (m *MockReadOnlyRestResource) GetOne(ctx context.Context, id string) (restapi.InstanaDataObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id)
 	ret0, _ := ret[0].(restapi.InstanaDataObject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOne indicates an expected call of GetOne
-func (mr *MockReadOnlyRestResourceMockRecorder) GetOne(id interface{}) *gomock.Call {
+// GetOne indicates an expected call of GetOne.
+func (mr *MockReadOnlyRestResourceMockRecorder) GetOne(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource)(nil).GetOne), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockReadOnlyRestResource)(nil).GetOne), ctx, id)
 }
 
-// MockJSONUnmarshaller is a mock of JSONUnmarshaller interface
+// MockJSONUnmarshaller is a mock of JSONUnmarshaller interface.
 type MockJSONUnmarshaller struct {
 	ctrl     *gomock.Controller
 	recorder *MockJSONUnmarshallerMockRecorder
 }
 
-// MockJSONUnmarshallerMockRecorder is the mock recorder for MockJSONUnmarshaller
+// MockJSONUnmarshallerMockRecorder is the mock recorder for MockJSONUnmarshaller.
 type MockJSONUnmarshallerMockRecorder struct {
 	mock *MockJSONUnmarshaller
 }
 
-// NewMockJSONUnmarshaller creates a new mock instance
+// NewMockJSONUnmarshaller creates a new mock instance.
 func NewMockJSONUnmarshaller(ctrl *gomock.Controller) *MockJSONUnmarshaller {
 	mock := &MockJSONUnmarshaller{ctrl: ctrl}
 	mock.recorder = &MockJSONUnmarshallerMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockJSONUnmarshaller) EXPECT() *MockJSONUnmarshallerMockRecorder {
 	return m.recorder
 }
 
-// Unmarshal mocks base method
+// Unmarshal mocks base method.
 func (m *MockJSONUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Unmarshal", data)
@@ -242,7 +259,7 @@ func (m *MockJSONUnmarshaller) Unmarshal(data []byte) (interface{}, error) {
 	return ret0, ret1
 }
 
-// Unmarshal indicates an expected call of Unmarshal
+// Unmarshal indicates an expected call of Unmarshal.
 func (mr *MockJSONUnmarshallerMockRecorder) Unmarshal(data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmarshal", reflect.TypeOf((*MockJSONUnmarshaller)(nil).Unmarshal), data)
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	restapi "github.com/gessnerfl/terraform-provider-instana/instana/restapi"
@@ -35,120 +36,194 @@ func (m *MockRestClient) EXPECT() *MockRestClientMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockRestClient) Delete(resourceID, resourceBasePath string) error {
+func (m *MockRestClient) Delete(ctx context.Context, resourceID, resourceBasePath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", resourceID, resourceBasePath)
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceID, resourceBasePath)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRestClientMockRecorder) Delete(resourceID, resourceBasePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Delete(ctx, resourceID, resourceBasePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), resourceID, resourceBasePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRestClient)(nil).Delete), ctx, resourceID, resourceBasePath)
+}
+
+// DeleteByQuery mocks base method.
+func (m *MockRestClient) DeleteByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByQuery", ctx, resourcePath, queryParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByQuery indicates an expected call of DeleteByQuery.
+func (mr *MockRestClientMockRecorder) DeleteByQuery(ctx, resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByQuery", reflect.TypeOf((*MockRestClient)(nil).DeleteByQuery), ctx, resourcePath, queryParams)
 }
 
 // Get mocks base method.
-func (m *MockRestClient) Get(resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Get(ctx context.Context, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", resourcePath)
+	ret := m.ctrl.Call(m, "Get", ctx, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockRestClientMockRecorder) Get(resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Get(ctx, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), ctx, resourcePath)
+}
+
+// GetByQuery mocks base method.
+func (m *MockRestClient) GetByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByQuery", ctx, resourcePath, queryParams)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByQuery indicates an expected call of GetByQuery.
+func (mr *MockRestClientMockRecorder) GetByQuery(ctx, resourcePath, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByQuery", reflect.TypeOf((*MockRestClient)(nil).GetByQuery), ctx, resourcePath, queryParams)
+}
+
+// GetCSV mocks base method.
+func (m *MockRestClient) GetCSV(ctx context.Context, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCSV", ctx, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCSV indicates an expected call of GetCSV.
+func (mr *MockRestClientMockRecorder) GetCSV(ctx, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRestClient)(nil).Get), resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSV", reflect.TypeOf((*MockRestClient)(nil).GetCSV), ctx, resourcePath)
 }
 
 // GetOne mocks base method.
-func (m *MockRestClient) GetOne(id, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) GetOne(ctx context.Context, id, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOne", id, resourcePath)
+	ret := m.ctrl.Call(m, "GetOne", ctx, id, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOne indicates an expected call of GetOne.
-func (mr *MockRestClientMockRecorder) GetOne(id, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) GetOne(ctx, id, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), id, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockRestClient)(nil).GetOne), ctx, id, resourcePath)
 }
 
 // Post mocks base method.
-func (m *MockRestClient) Post(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Post(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Post", data, resourcePath)
+	ret := m.ctrl.Call(m, "Post", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Post indicates an expected call of Post.
-func (mr *MockRestClientMockRecorder) Post(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Post(ctx, data, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockRestClient)(nil).Post), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockRestClient)(nil).Post), ctx, data, resourcePath)
 }
 
 // PostByQuery mocks base method.
-func (m *MockRestClient) PostByQuery(resourcePath string, queryParams map[string]string) ([]byte, error) {
+func (m *MockRestClient) PostByQuery(ctx context.Context, resourcePath string, queryParams map[string]string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostByQuery", resourcePath, queryParams)
+	ret := m.ctrl.Call(m, "PostByQuery", ctx, resourcePath, queryParams)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PostByQuery indicates an expected call of PostByQuery.
-func (mr *MockRestClientMockRecorder) PostByQuery(resourcePath, queryParams interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PostByQuery(ctx, resourcePath, queryParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), resourcePath, queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostByQuery", reflect.TypeOf((*MockRestClient)(nil).PostByQuery), ctx, resourcePath, queryParams)
 }
 
 // PostWithID mocks base method.
-func (m *MockRestClient) PostWithID(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) PostWithID(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostWithID", data, resourcePath)
+	ret := m.ctrl.Call(m, "PostWithID", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PostWithID indicates an expected call of PostWithID.
-func (mr *MockRestClientMockRecorder) PostWithID(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PostWithID(ctx, data, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithID", reflect.TypeOf((*MockRestClient)(nil).PostWithID), ctx, data, resourcePath)
 }
 
 // Put mocks base method.
-func (m *MockRestClient) Put(data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+func (m *MockRestClient) Put(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", data, resourcePath)
+	ret := m.ctrl.Call(m, "Put", ctx, data, resourcePath)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put.
-func (mr *MockRestClientMockRecorder) Put(data, resourcePath interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) Put(ctx, data, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), data, resourcePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockRestClient)(nil).Put), ctx, data, resourcePath)
 }
 
 // PutByQuery mocks base method.
-func (m *MockRestClient) PutByQuery(resourcePath, is string, queryParams map[string]string) ([]byte, error) {
+func (m *MockRestClient) PutByQuery(ctx context.Context, resourcePath, is string, queryParams map[string]string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutByQuery", resourcePath, is, queryParams)
+	ret := m.ctrl.Call(m, "PutByQuery", ctx, resourcePath, is, queryParams)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PutByQuery indicates an expected call of PutByQuery.
-func (mr *MockRestClientMockRecorder) PutByQuery(resourcePath, is, queryParams interface{}) *gomock.Call {
+func (mr *MockRestClientMockRecorder) PutByQuery(ctx, resourcePath, is, queryParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), ctx, resourcePath, is, queryParams)
+}
+
+// PutCSV mocks base method.
+func (m *MockRestClient) PutCSV(ctx context.Context, data []byte, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutCSV", ctx, data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutCSV indicates an expected call of PutCSV.
+func (mr *MockRestClientMockRecorder) PutCSV(ctx, data, resourcePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCSV", reflect.TypeOf((*MockRestClient)(nil).PutCSV), ctx, data, resourcePath)
+}
+
+// PutWithoutID mocks base method.
+func (m *MockRestClient) PutWithoutID(ctx context.Context, data restapi.InstanaDataObject, resourcePath string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithoutID", ctx, data, resourcePath)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWithoutID indicates an expected call of PutWithoutID.
+func (mr *MockRestClientMockRecorder) PutWithoutID(ctx, data, resourcePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutByQuery", reflect.TypeOf((*MockRestClient)(nil).PutByQuery), resourcePath, is, queryParams)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithoutID", reflect.TypeOf((*MockRestClient)(nil).PutWithoutID), ctx, data, resourcePath)
 }
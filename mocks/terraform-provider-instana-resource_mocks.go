@@ -5,100 +5,103 @@
 package mocks
 
 import (
+	context "context"
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
+	diag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	reflect "reflect"
 )
 
-// MockTerraformResource is a mock of TerraformResource interface
+// MockTerraformResource is a mock of TerraformResource interface.
 type MockTerraformResource struct {
 	ctrl     *gomock.Controller
 	recorder *MockTerraformResourceMockRecorder
 }
 
-// MockTerraformResourceMockRecorder is the mock recorder for MockTerraformResource
+// MockTerraformResourceMockRecorder is the mock recorder for MockTerraformResource.
 type MockTerraformResourceMockRecorder struct {
 	mock *MockTerraformResource
 }
 
-// NewMockTerraformResource creates a new mock instance
+// NewMockTerraformResource creates a new mock instance.
 func NewMockTerraformResource(ctrl *gomock.Controller) *MockTerraformResource {
 	mock := &MockTerraformResource{ctrl: ctrl}
 	mock.recorder = &MockTerraformResourceMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockTerraformResource) EXPECT() *MockTerraformResourceMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method
-func (m *MockTerraformResource) Create(d *schema.ResourceData, meta interface{}) error {
+// Create mocks base method.
+func (m *MockTerraformResource) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", d, meta)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Create", ctx, d, meta)
+	ret0, _ := ret[0].(diag.Diagnostics)
 	return ret0
 }
 
-// Create indicates an expected call of Create
-func (mr *MockTerraformResourceMockRecorder) Create(d, meta interface{}) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockTerraformResourceMockRecorder) Create(ctx, d, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTerraformResource)(nil).Create), d, meta)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTerraformResource)(nil).Create), ctx, d, meta)
 }
 
-// Read mocks base method
-func (m *MockTerraformResource) Read(d *schema.ResourceData, meta interface{}) error {
+// Delete mocks base method.
+func (m *MockTerraformResource) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", d, meta)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Delete", ctx, d, meta)
+	ret0, _ := ret[0].(diag.Diagnostics)
 	return ret0
 }
 
-// Read indicates an expected call of Read
-func (mr *MockTerraformResourceMockRecorder) Read(d, meta interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockTerraformResourceMockRecorder) Delete(ctx, d, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTerraformResource)(nil).Read), d, meta)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTerraformResource)(nil).Delete), ctx, d, meta)
 }
 
-// Update mocks base method
-func (m *MockTerraformResource) Update(d *schema.ResourceData, meta interface{}) error {
+// Read mocks base method.
+func (m *MockTerraformResource) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", d, meta)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Read", ctx, d, meta)
+	ret0, _ := ret[0].(diag.Diagnostics)
 	return ret0
 }
 
-// Update indicates an expected call of Update
-func (mr *MockTerraformResourceMockRecorder) Update(d, meta interface{}) *gomock.Call {
+// Read indicates an expected call of Read.
+func (mr *MockTerraformResourceMockRecorder) Read(ctx, d, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTerraformResource)(nil).Update), d, meta)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTerraformResource)(nil).Read), ctx, d, meta)
 }
 
-// Delete mocks base method
-func (m *MockTerraformResource) Delete(d *schema.ResourceData, meta interface{}) error {
+// ToSchemaResource mocks base method.
+func (m *MockTerraformResource) ToSchemaResource() *schema.Resource {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", d, meta)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "ToSchemaResource")
+	ret0, _ := ret[0].(*schema.Resource)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete
-func (mr *MockTerraformResourceMockRecorder) Delete(d, meta interface{}) *gomock.Call {
+// ToSchemaResource indicates an expected call of ToSchemaResource.
+func (mr *MockTerraformResourceMockRecorder) ToSchemaResource() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTerraformResource)(nil).Delete), d, meta)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSchemaResource", reflect.TypeOf((*MockTerraformResource)(nil).ToSchemaResource))
 }
 
-// ToSchemaResource mocks base method
-func (m *MockTerraformResource) ToSchemaResource() *schema.Resource {
+// Update mocks base method.
+func (m *MockTerraformResource) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToSchemaResource")
-	ret0, _ := ret[0].(*schema.Resource)
+	ret := m.ctrl.Call(m, "Update", ctx, d, meta)
+	ret0, _ := ret[0].(diag.Diagnostics)
 	return ret0
 }
 
-// ToSchemaResource indicates an expected call of ToSchemaResource
-func (mr *MockTerraformResourceMockRecorder) ToSchemaResource() *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockTerraformResourceMockRecorder) Update(ctx, d, meta interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSchemaResource", reflect.TypeOf((*MockTerraformResource)(nil).ToSchemaResource))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTerraformResource)(nil).Update), ctx, d, meta)
 }
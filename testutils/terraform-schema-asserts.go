@@ -42,6 +42,8 @@ type TerraformSchemaAssert interface {
 	AssertSchemaIsComputedAndOfTypeString(fieldName string)
 	//AssertSchemaIsComputedAndOfTypeInt checks if the given schema field is computed and of type int
 	AssertSchemaIsComputedAndOfTypeInt(fieldName string)
+	//AssertSchemaIsComputedAndOfTypeFloat checks if the given schema field is computed and of type float
+	AssertSchemaIsComputedAndOfTypeFloat(fieldName string)
 	//AssertSchemaIsComputedAndOfTypeBool checks if the given schema field is computed and of type bool
 	AssertSchemaIsComputedAndOfTypeBool(fieldName string)
 }
@@ -177,6 +179,14 @@ func (inst *terraformSchemaAssertImpl) AssertSchemaIsComputedAndOfTypeInt(schema
 	require.True(inst.t, s.Computed)
 }
 
+func (inst *terraformSchemaAssertImpl) AssertSchemaIsComputedAndOfTypeFloat(schemaField string) {
+	s := inst.schemaMap[schemaField]
+
+	require.NotNil(inst.t, s)
+	inst.assertSchemaIsOfType(s, schema.TypeFloat)
+	require.True(inst.t, s.Computed)
+}
+
 func (inst *terraformSchemaAssertImpl) AssertSchemaIsComputedAndOfTypeBool(schemaField string) {
 	s := inst.schemaMap[schemaField]
 
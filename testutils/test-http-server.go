@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"log"
 	"math/rand"
+	"net"
 	"net/http"
 	"time"
 
@@ -112,9 +113,8 @@ func (server *testHTTPServerImpl) wrapHandlerFunc(handlerFunc http.HandlerFunc)
 
 //Start starts the http service with the configured routes
 func (server *testHTTPServerImpl) Start() {
-	binding := fmt.Sprintf(":%d", server.port)
+	listener := server.listenOnRandomPort()
 	srv := &http.Server{
-		Addr:    binding,
 		Handler: server.router,
 	}
 	go func() {
@@ -125,8 +125,8 @@ func (server *testHTTPServerImpl) Start() {
 		}
 		certFile := fmt.Sprintf("%s/testutils/test-server.pem", rootFolder)
 		keyFile := fmt.Sprintf("%s/testutils/test-server.key", rootFolder)
-		if err := srv.ListenAndServeTLS(certFile, keyFile); err != http.ErrServerClosed {
-			log.Fatalf("ListenAndServeTLS(): %s", err)
+		if err := srv.ServeTLS(listener, certFile, keyFile); err != http.ErrServerClosed {
+			log.Fatalf("ServeTLS(): %s", err)
 		}
 
 	}()
@@ -135,6 +135,21 @@ func (server *testHTTPServerImpl) Start() {
 	server.waitForServerAlive()
 }
 
+//listenOnRandomPort binds the server synchronously. As the random port might already be in use a new random port is
+//chosen when the binding fails
+func (server *testHTTPServerImpl) listenOnRandomPort() net.Listener {
+	var err error
+	for i := 0; i < 10; i++ {
+		var listener net.Listener
+		if listener, err = net.Listen("tcp", fmt.Sprintf(":%d", server.port)); err == nil {
+			return listener
+		}
+		server.port = RandomPort()
+	}
+	log.Fatalf("Failed to bind test http server: %s", err)
+	return nil
+}
+
 func (server *testHTTPServerImpl) waitForServerAlive() {
 	url := fmt.Sprintf("https://localhost:%d/health", server.GetPort())
 